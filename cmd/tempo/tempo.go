@@ -5,14 +5,18 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/theme/themes"
 	"github.com/atterpac/jig/util"
+	"github.com/galaxy-io/tempo/internal/commands/describe"
 	"github.com/galaxy-io/tempo/internal/commands/isbroken"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/galaxy-io/tempo/internal/view"
@@ -22,7 +26,7 @@ import (
 
 // CLI flags
 var (
-	profileName   = flag.String("profile", "", "Connection profile name (from config)")
+	profileName   = flag.String("profile", "", "Connection profile name (from config, or a Temporal CLI profile)")
 	address       = flag.String("address", "", "Temporal server address (overrides profile)")
 	namespace     = flag.String("namespace", "", "Default namespace (overrides profile)")
 	tlsCert       = flag.String("tls-cert", "", "Path to TLS certificate (overrides profile)")
@@ -30,11 +34,48 @@ var (
 	tlsCA         = flag.String("tls-ca", "", "Path to CA certificate (overrides profile)")
 	tlsServerName = flag.String("tls-server-name", "", "Server name for TLS verification (overrides profile)")
 	tlsSkipVerify = flag.Bool("tls-skip-verify", false, "Skip TLS verification (insecure)")
+	apiKey        = flag.String("api-key", "", "API key for Temporal Cloud (overrides profile and $TEMPORAL_API_KEY)")
 	themeNameFlag = flag.String("theme", "", "Theme name (overrides config file)")
+	asciiIcons    = flag.Bool("ascii", false, "Use ASCII icons instead of Nerd Font glyphs")
+	eventView     = flag.String("event-view", "", "Default event history view: list, tree, or timeline (overrides config file)")
+	pageSize      = flag.Int("page-size", 0, "Rows fetched per list request, up to 1000 (overrides config file)")
 	devMode       = flag.Bool("dev", false, "Development mode: test splash screen with theme cycling")
 	versionFlag   = flag.Bool("version", false, "Print version information and exit")
+	workflowID    = flag.String("workflow-id", "", "Workflow ID to print instead of starting the UI (requires --output)")
+	runID         = flag.String("run-id", "", "Run ID for --workflow-id (defaults to the latest run)")
+	outputFormat  = flag.String("output", "", "Print --workflow-id in this format and exit: json")
 )
 
+// headers collects the repeatable --header flag.
+var headers = headerFlag{}
+
+func init() {
+	flag.Var(headers, "header", "gRPC header sent with every request, as key=value (repeatable, overrides profile grpc_meta)")
+}
+
+// headerFlag is a flag.Value collecting key=value pairs into gRPC metadata.
+type headerFlag map[string]string
+
+func (h headerFlag) String() string {
+	pairs := make([]string, 0, len(h))
+	for k, v := range h {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set adds one key=value header. gRPC metadata keys are lowercase.
+func (h headerFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	key = strings.ToLower(strings.TrimSpace(key))
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	h[key] = val
+	return nil
+}
+
 const (
 	maxRetries     = 5
 	initialBackoff = 1 * time.Second
@@ -77,16 +118,45 @@ func main() {
 	}
 	theme.SetProvider(selectedTheme)
 
+	// Swap Nerd Font glyphs for ASCII before any UI is built
+	if *asciiIcons || cfg.UseASCIIIcons() {
+		temporal.UseIconSet(icons.ASCII)
+	}
+
+	// CLI flag overrides the configured event view for this session; the
+	// override is not saved with the config
+	if *eventView != "" {
+		if config.IsValidEventView(*eventView) {
+			cfg.EventViewOverride = *eventView
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: event view %q not recognized, expected one of %v\n", *eventView, config.EventViewModes)
+		}
+	}
+
+	// CLI flag overrides the configured page size for this session; the
+	// override is not saved with the config
+	n := cfg.PageSize
+	if *pageSize != 0 {
+		cfg.PageSizeOverride = *pageSize
+		n = *pageSize
+	}
+	if n != 0 && !config.IsValidPageSize(n) {
+		fmt.Fprintf(os.Stderr, "Warning: page size %d is out of range %d-%d, using %d\n",
+			n, config.MinPageSize, config.MaxPageSize, config.ClampPageSize(n))
+	}
+
 	// Determine which profile to use
 	activeProfileName := cfg.ActiveProfile
 	if *profileName != "" {
-		// CLI flag overrides active profile
-		if !cfg.ProfileExists(*profileName) {
+		// CLI flag overrides active profile; Temporal CLI profiles can be
+		// named without their import: prefix
+		resolved, ok := cfg.ResolveProfile(*profileName)
+		if !ok {
 			fmt.Fprintf(os.Stderr, "Error: profile %q not found\n", *profileName)
 			fmt.Fprintf(os.Stderr, "Available profiles: %v\n", cfg.ListProfiles())
 			os.Exit(1)
 		}
-		activeProfileName = *profileName
+		activeProfileName = resolved
 		cfg.ActiveProfile = activeProfileName
 	}
 
@@ -105,6 +175,17 @@ func main() {
 		TLSSkipVerify: profileConfig.TLS.SkipVerify,
 		APIKey:        profileConfig.APIKey,
 		GRPCMeta:      profileConfig.GRPCMeta,
+		CodecEndpoint: profileConfig.CodecEndpoint,
+		CodecAuth:     profileConfig.CodecAuth,
+	}
+	if auth := profileConfig.AuthToken; auth != nil {
+		connConfig.AuthHeader = auth.Header
+		connConfig.AuthScheme = auth.Scheme
+		token, err := auth.InitialToken()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v; set a token with :token\n", err)
+		}
+		connConfig.AuthToken = token
 	}
 
 	// CLI flags override profile settings
@@ -129,6 +210,31 @@ func main() {
 	if *tlsSkipVerify {
 		connConfig.TLSSkipVerify = true
 	}
+	if len(headers) > 0 {
+		meta := make(map[string]string, len(connConfig.GRPCMeta)+len(headers))
+		for k, v := range connConfig.GRPCMeta {
+			meta[k] = v
+		}
+		for k, v := range headers {
+			meta[k] = v
+		}
+		connConfig.GRPCMeta = meta
+	}
+	if *apiKey != "" {
+		connConfig.APIKey = *apiKey
+	} else if key := os.Getenv("TEMPORAL_API_KEY"); key != "" && connConfig.APIKey == "" {
+		// Same variable the temporal CLI reads; a profile's own key wins
+		connConfig.APIKey = key
+	}
+
+	// Non-interactive mode: print the workflow and exit
+	if *workflowID != "" || *outputFormat != "" {
+		if *outputFormat == "" {
+			fmt.Fprintln(os.Stderr, "Error: --workflow-id requires --output")
+			os.Exit(describe.ExitUsage)
+		}
+		os.Exit(describe.Run(connConfig, *workflowID, *runID, *outputFormat, os.Stdout, os.Stderr))
+	}
 
 	// Run connection with UI
 	provider, err := connectWithUI(connConfig)
@@ -238,7 +344,7 @@ func connectWithUI(config temporal.ConnectionConfig) (temporal.Provider, error)
 	sponsorText.SetBackgroundColor(theme.Bg())
 	sponsorText.SetText(fmt.Sprintf(
 		"[%s]Made with %s  by getgalaxy.io[-]",
-		theme.TagFgDim(), theme.IconHeart,
+		theme.TagFgDim(), icons.Heart,
 	))
 
 	// Build layout
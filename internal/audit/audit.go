@@ -0,0 +1,156 @@
+// Package audit records mutating operations performed from tempo to a local
+// JSON-lines file, so users can review what they changed and when.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// DefaultMaxBytes is the size at which the audit file is rotated.
+const DefaultMaxBytes = 1 << 20 // 1 MiB
+
+// Outcomes recorded for an entry.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+)
+
+// Entry is a single recorded operation.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Action    string    `json:"action"` // e.g. "CancelWorkflow"
+	Namespace string    `json:"namespace,omitempty"`
+	Target    string    `json:"target"`           // Workflow ID, schedule ID, or namespace name
+	RunID     string    `json:"run_id,omitempty"` // Set for workflow operations
+	Detail    string    `json:"detail,omitempty"` // Reason, signal name, etc.
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Log appends entries to a JSON-lines file. When the file grows past
+// maxBytes it is renamed to "<path>.1", replacing any previous backup,
+// so at most about twice maxBytes is kept on disk.
+type Log struct {
+	mu       sync.Mutex
+	path     string
+	maxBytes int64
+}
+
+// New creates a log writing to path.
+func New(path string, maxBytes int64) *Log {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	return &Log{path: path, maxBytes: maxBytes}
+}
+
+// DefaultPath returns the audit file location under the config directory.
+func DefaultPath() string {
+	return filepath.Join(config.ConfigDir(), "audit.log")
+}
+
+// Path returns the file the log writes to.
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Record appends an entry, rotating the file first if it is too large.
+// The outcome is derived from err.
+func (l *Log) Record(e Entry, err error) error {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Outcome = OutcomeSuccess
+	if err != nil {
+		e.Outcome = OutcomeError
+		e.Error = err.Error()
+	}
+
+	data, mErr := json.Marshal(e)
+	if mErr != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", mErr)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("failed to create audit directory: %w", err)
+	}
+	if info, err := os.Stat(l.path); err == nil && info.Size()+int64(len(data))+1 > l.maxBytes {
+		if err := os.Rename(l.path, l.path+".1"); err != nil {
+			return fmt.Errorf("failed to rotate audit log: %w", err)
+		}
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// Recent returns up to limit entries, newest first, including the rotated backup.
+// A limit of 0 or less returns all entries.
+func (l *Log) Recent(limit int) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var entries []Entry
+	for _, path := range []string{l.path + ".1", l.path} {
+		fileEntries, err := readEntries(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	// Reverse to newest first
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
+
+// readEntries reads all entries from a file, skipping malformed lines.
+func readEntries(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,251 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// Provider wraps a temporal.Provider and records every mutating call to a Log.
+// Read-only calls pass straight through to the embedded provider.
+type Provider struct {
+	temporal.Provider
+	log *Log
+}
+
+// Wrap returns p with its mutating operations recorded to log.
+func Wrap(p temporal.Provider, log *Log) *Provider {
+	return &Provider{Provider: p, log: log}
+}
+
+// Log returns the audit log the provider records to.
+func (p *Provider) Log() *Log {
+	return p.log
+}
+
+// record writes an entry, ignoring audit write failures so they never block the operation itself.
+func (p *Provider) record(e Entry, err error) {
+	_ = p.log.Record(e, err)
+}
+
+func (p *Provider) CreateNamespace(ctx context.Context, req temporal.NamespaceCreateRequest) error {
+	err := p.Provider.CreateNamespace(ctx, req)
+	p.record(Entry{Action: "CreateNamespace", Target: req.Name,
+		Detail: fmt.Sprintf("retention=%dd", req.RetentionDays)}, err)
+	return err
+}
+
+func (p *Provider) UpdateNamespace(ctx context.Context, req temporal.NamespaceUpdateRequest) error {
+	err := p.Provider.UpdateNamespace(ctx, req)
+	detail := ""
+	if req.RetentionDays > 0 {
+		detail = fmt.Sprintf("retention=%dd", req.RetentionDays)
+	}
+	p.record(Entry{Action: "UpdateNamespace", Target: req.Name, Detail: detail}, err)
+	return err
+}
+
+func (p *Provider) DeprecateNamespace(ctx context.Context, name string) error {
+	err := p.Provider.DeprecateNamespace(ctx, name)
+	p.record(Entry{Action: "DeprecateNamespace", Target: name}, err)
+	return err
+}
+
+func (p *Provider) DeleteNamespace(ctx context.Context, name string) error {
+	err := p.Provider.DeleteNamespace(ctx, name)
+	p.record(Entry{Action: "DeleteNamespace", Target: name}, err)
+	return err
+}
+
+func (p *Provider) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	err := p.Provider.CancelWorkflow(ctx, namespace, workflowID, runID, reason)
+	p.record(Entry{Action: "CancelWorkflow", Namespace: namespace, Target: workflowID, RunID: runID, Detail: reason}, err)
+	return err
+}
+
+func (p *Provider) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	err := p.Provider.TerminateWorkflow(ctx, namespace, workflowID, runID, reason)
+	p.record(Entry{Action: "TerminateWorkflow", Namespace: namespace, Target: workflowID, RunID: runID, Detail: reason}, err)
+	return err
+}
+
+func (p *Provider) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
+	err := p.Provider.SignalWorkflow(ctx, namespace, workflowID, runID, signalName, input)
+	p.record(Entry{Action: "SignalWorkflow", Namespace: namespace, Target: workflowID, RunID: runID, Detail: signalName}, err)
+	return err
+}
+
+func (p *Provider) UpdateWorkflow(ctx context.Context, namespace, workflowID, runID, updateName string, args []byte) (*temporal.UpdateResult, error) {
+	res, err := p.Provider.UpdateWorkflow(ctx, namespace, workflowID, runID, updateName, args)
+	// Record a rejected or failed update as a failed action
+	outcome := err
+	if err == nil && res.Failure != "" {
+		outcome = errors.New(res.Failure)
+	}
+	p.record(Entry{Action: "UpdateWorkflow", Namespace: namespace, Target: workflowID, RunID: runID, Detail: updateName}, outcome)
+	return res, err
+}
+
+func (p *Provider) StartWorkflow(ctx context.Context, namespace string, req temporal.StartWorkflowRequest) (string, error) {
+	runID, err := p.Provider.StartWorkflow(ctx, namespace, req)
+	p.record(Entry{Action: "StartWorkflow", Namespace: namespace, Target: req.WorkflowID, RunID: runID, Detail: req.WorkflowType}, err)
+	return runID, err
+}
+
+func (p *Provider) SignalWithStartWorkflow(ctx context.Context, namespace string, req temporal.SignalWithStartRequest) (string, error) {
+	runID, err := p.Provider.SignalWithStartWorkflow(ctx, namespace, req)
+	p.record(Entry{Action: "SignalWithStartWorkflow", Namespace: namespace, Target: req.WorkflowID, RunID: runID, Detail: req.SignalName}, err)
+	return runID, err
+}
+
+func (p *Provider) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
+	err := p.Provider.DeleteWorkflow(ctx, namespace, workflowID, runID)
+	p.record(Entry{Action: "DeleteWorkflow", Namespace: namespace, Target: workflowID, RunID: runID}, err)
+	return err
+}
+
+func (p *Provider) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string, exclude []temporal.ResetReapplyExclude) (string, error) {
+	newRunID, err := p.Provider.ResetWorkflow(ctx, namespace, workflowID, runID, eventID, reason, exclude)
+	detail := fmt.Sprintf("event=%d", eventID)
+	for _, kind := range exclude {
+		detail += " exclude=" + string(kind)
+	}
+	if newRunID != "" {
+		detail += " new_run=" + newRunID
+	}
+	if reason != "" {
+		detail += " reason=" + reason
+	}
+	p.record(Entry{Action: "ResetWorkflow", Namespace: namespace, Target: workflowID, RunID: runID, Detail: detail}, err)
+	return newRunID, err
+}
+
+func (p *Provider) RequestActivityCancel(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error {
+	err := p.Provider.RequestActivityCancel(ctx, namespace, workflowID, runID, activityID, reason)
+	p.record(Entry{Action: "RequestActivityCancel", Namespace: namespace, Target: workflowID, RunID: runID,
+		Detail: "activity=" + activityID}, err)
+	return err
+}
+
+func (p *Provider) CancelWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier) ([]temporal.BatchResult, error) {
+	results, err := p.Provider.CancelWorkflows(ctx, namespace, workflows)
+	p.recordBatch("BatchCancelWorkflow", namespace, "", workflows, results, err)
+	return results, err
+}
+
+func (p *Provider) TerminateWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier, reason string) ([]temporal.BatchResult, error) {
+	results, err := p.Provider.TerminateWorkflows(ctx, namespace, workflows, reason)
+	p.recordBatch("BatchTerminateWorkflow", namespace, reason, workflows, results, err)
+	return results, err
+}
+
+func (p *Provider) SignalWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier, signalName string, input []byte) ([]temporal.BatchResult, error) {
+	results, err := p.Provider.SignalWorkflows(ctx, namespace, workflows, signalName, input)
+	p.recordBatch("BatchSignalWorkflow", namespace, signalName, workflows, results, err)
+	return results, err
+}
+
+func (p *Provider) StartBatchOperation(ctx context.Context, namespace string, req temporal.BatchOperationRequest) (string, error) {
+	jobID, err := p.Provider.StartBatchOperation(ctx, namespace, req)
+	detail := fmt.Sprintf("operation=%s query=%s", req.Operation, req.Query)
+	if req.SignalName != "" {
+		detail += " signal=" + req.SignalName
+	}
+	if req.Reason != "" {
+		detail += " reason=" + req.Reason
+	}
+	p.record(Entry{Action: "StartBatchOperation", Namespace: namespace, Target: jobID, Detail: detail}, err)
+	return jobID, err
+}
+
+func (p *Provider) StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error {
+	err := p.Provider.StopBatchOperation(ctx, namespace, jobID, reason)
+	p.record(Entry{Action: "StopBatchOperation", Namespace: namespace, Target: jobID, Detail: reason}, err)
+	return err
+}
+
+// recordBatch writes one entry per workflow in a batch operation. If the batch
+// failed as a whole, every requested workflow is recorded with that error.
+func (p *Provider) recordBatch(action, namespace, detail string, workflows []temporal.WorkflowIdentifier, results []temporal.BatchResult, err error) {
+	if err != nil {
+		for _, wf := range workflows {
+			p.record(Entry{Action: action, Namespace: namespace, Target: wf.WorkflowID, RunID: wf.RunID, Detail: detail}, err)
+		}
+		return
+	}
+	for _, r := range results {
+		var rErr error
+		if !r.Success {
+			rErr = errors.New(r.Error)
+		}
+		p.record(Entry{Action: action, Namespace: namespace, Target: r.WorkflowID, RunID: r.RunID, Detail: detail}, rErr)
+	}
+}
+
+func (p *Provider) PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	err := p.Provider.PauseSchedule(ctx, namespace, scheduleID, reason)
+	p.record(Entry{Action: "PauseSchedule", Namespace: namespace, Target: scheduleID, Detail: reason}, err)
+	return err
+}
+
+func (p *Provider) UnpauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	err := p.Provider.UnpauseSchedule(ctx, namespace, scheduleID, reason)
+	p.record(Entry{Action: "UnpauseSchedule", Namespace: namespace, Target: scheduleID, Detail: reason}, err)
+	return err
+}
+
+func (p *Provider) TriggerSchedule(ctx context.Context, namespace, scheduleID string) error {
+	err := p.Provider.TriggerSchedule(ctx, namespace, scheduleID)
+	p.record(Entry{Action: "TriggerSchedule", Namespace: namespace, Target: scheduleID}, err)
+	return err
+}
+
+func (p *Provider) DeleteSchedule(ctx context.Context, namespace, scheduleID string) error {
+	err := p.Provider.DeleteSchedule(ctx, namespace, scheduleID)
+	p.record(Entry{Action: "DeleteSchedule", Namespace: namespace, Target: scheduleID}, err)
+	return err
+}
+
+func (p *Provider) CreateSchedule(ctx context.Context, namespace string, req temporal.ScheduleCreateRequest) error {
+	err := p.Provider.CreateSchedule(ctx, namespace, req)
+	p.record(Entry{Action: "CreateSchedule", Namespace: namespace, Target: req.ScheduleID,
+		Detail: fmt.Sprintf("%s %s", req.WorkflowType, scheduleSpecDetail(req.Cron, req.Interval))}, err)
+	return err
+}
+
+func (p *Provider) UpdateSchedule(ctx context.Context, namespace, scheduleID string, req temporal.ScheduleUpdateRequest) error {
+	err := p.Provider.UpdateSchedule(ctx, namespace, scheduleID, req)
+	detail := fmt.Sprintf("paused=%t", req.Paused)
+	if spec := scheduleSpecDetail(req.Cron, req.Interval); spec != "" {
+		detail = spec + " " + detail
+	}
+	p.record(Entry{Action: "UpdateSchedule", Namespace: namespace, Target: scheduleID, Detail: detail}, err)
+	return err
+}
+
+// scheduleSpecDetail describes a schedule spec for the log, or "" if unset.
+func scheduleSpecDetail(cron string, interval time.Duration) string {
+	switch {
+	case cron != "":
+		return fmt.Sprintf("cron=%q", cron)
+	case interval > 0:
+		return fmt.Sprintf("every=%s", interval)
+	}
+	return ""
+}
+
+func (p *Provider) SetTaskQueueRateLimit(ctx context.Context, namespace, taskQueue string, rps *float32, reason string) (*temporal.TaskQueueRateLimit, error) {
+	limit, err := p.Provider.SetTaskQueueRateLimit(ctx, namespace, taskQueue, rps, reason)
+	detail := "rps=unset"
+	if rps != nil {
+		detail = fmt.Sprintf("rps=%g", *rps)
+	}
+	if reason != "" {
+		detail += " reason=" + reason
+	}
+	p.record(Entry{Action: "SetTaskQueueRateLimit", Namespace: namespace, Target: taskQueue, Detail: detail}, err)
+	return limit, err
+}
@@ -277,6 +277,8 @@ func normalizeStatus(s string) string {
 		return "Terminated"
 	case "TIMED_OUT":
 		return "TimedOut"
+	case "CONTINUED_AS_NEW":
+		return "ContinuedAsNew"
 	default:
 		if s != "" {
 			// Title case the first letter
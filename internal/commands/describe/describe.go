@@ -0,0 +1,218 @@
+// Package describe prints a single workflow execution as structured output
+// for scripts, without starting the TUI:
+//
+//	tempo --workflow-id order-123 --output json | jq .status
+package describe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// Exit codes returned by Run.
+const (
+	ExitOK       = 0 // Workflow found and printed
+	ExitError    = 1 // Connection or server error
+	ExitUsage    = 2 // Invalid flags
+	ExitNotFound = 3 // No such workflow or run
+)
+
+// Formats lists the supported --output values.
+var Formats = []string{"json"}
+
+// Workflow is the JSON document printed for a workflow execution.
+type Workflow struct {
+	WorkflowID        string            `json:"workflowId"`
+	RunID             string            `json:"runId"`
+	Type              string            `json:"type"`
+	Namespace         string            `json:"namespace"`
+	Status            string            `json:"status"`
+	TaskQueue         string            `json:"taskQueue"`
+	StartTime         time.Time         `json:"startTime"`
+	CloseTime         *time.Time        `json:"closeTime,omitempty"`
+	ParentWorkflowID  string            `json:"parentWorkflowId,omitempty"`
+	SearchAttributes  map[string]string `json:"searchAttributes,omitempty"`
+	Input             any               `json:"input,omitempty"`
+	Result            any               `json:"result,omitempty"`
+	Failure           string            `json:"failure,omitempty"`
+	PendingActivities []PendingActivity `json:"pendingActivities"`
+	PendingChildren   []PendingChild    `json:"pendingChildren"`
+	HistoryLength     int               `json:"historyLength"`
+	Events            []Event           `json:"events"`
+}
+
+// PendingActivity is an activity that has been scheduled but not completed.
+type PendingActivity struct {
+	ActivityID      string     `json:"activityId"`
+	ActivityType    string     `json:"activityType"`
+	State           string     `json:"state"`
+	Attempt         int32      `json:"attempt"`
+	MaximumAttempts int32      `json:"maximumAttempts,omitempty"`
+	ScheduledTime   time.Time  `json:"scheduledTime"`
+	LastStartedTime *time.Time `json:"lastStartedTime,omitempty"`
+	NextAttemptTime *time.Time `json:"nextAttemptTime,omitempty"`
+	LastFailure     string     `json:"lastFailure,omitempty"`
+	LastWorker      string     `json:"lastWorkerIdentity,omitempty"`
+	LastHeartbeat   *time.Time `json:"lastHeartbeatTime,omitempty"`
+	Heartbeat       string     `json:"heartbeatDetails,omitempty"`
+}
+
+// PendingChild is a child workflow that has been started but not closed.
+type PendingChild struct {
+	WorkflowID       string `json:"workflowId"`
+	RunID            string `json:"runId"`
+	WorkflowType     string `json:"workflowType"`
+	InitiatedEventID int64  `json:"initiatedEventId"`
+}
+
+// Event is a one-line summary of a history event.
+type Event struct {
+	ID       int64     `json:"id"`
+	Type     string    `json:"type"`
+	Time     time.Time `json:"time"`
+	Name     string    `json:"name,omitempty"` // Activity type, timer ID, child workflow type, or signal name
+	Attempt  int32     `json:"attempt,omitempty"`
+	Identity string    `json:"identity,omitempty"`
+	Failure  string    `json:"failure,omitempty"`
+}
+
+// Run connects with connConfig, loads the workflow, and writes it to out in
+// format. Errors are written to errOut. It returns the process exit code.
+func Run(connConfig temporal.ConnectionConfig, workflowID, runID, format string, out, errOut io.Writer) int {
+	if workflowID == "" {
+		fmt.Fprintln(errOut, "Error: --output requires --workflow-id")
+		return ExitUsage
+	}
+	if format != "json" {
+		fmt.Fprintf(errOut, "Error: unsupported output %q, expected one of %v\n", format, Formats)
+		return ExitUsage
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	client, err := temporal.NewClient(ctx, connConfig)
+	cancel()
+	if err != nil {
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		return ExitError
+	}
+	defer client.Close()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	wf, err := client.GetWorkflow(ctx, connConfig.Namespace, workflowID, runID)
+	cancel()
+	if err != nil {
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		if temporal.IsNotFound(err) {
+			return ExitNotFound
+		}
+		return ExitError
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 60*time.Second)
+	events, err := client.GetEnhancedWorkflowHistory(ctx, connConfig.Namespace, wf.ID, wf.RunID)
+	cancel()
+	if err != nil {
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		return ExitError
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(build(wf, events)); err != nil {
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		return ExitError
+	}
+	return ExitOK
+}
+
+// build combines the describe result and history into the output document.
+func build(wf *temporal.Workflow, events []temporal.EnhancedHistoryEvent) Workflow {
+	out := Workflow{
+		WorkflowID:        wf.ID,
+		RunID:             wf.RunID,
+		Type:              wf.Type,
+		Namespace:         wf.Namespace,
+		Status:            wf.Status,
+		TaskQueue:         wf.TaskQueue,
+		StartTime:         wf.StartTime,
+		CloseTime:         wf.EndTime,
+		SearchAttributes:  wf.SearchAttributes,
+		PendingActivities: make([]PendingActivity, 0, len(wf.PendingActivities)),
+		PendingChildren:   make([]PendingChild, 0, len(wf.PendingChildren)),
+		HistoryLength:     len(events),
+		Events:            make([]Event, 0, len(events)),
+	}
+	if wf.ParentID != nil {
+		out.ParentWorkflowID = *wf.ParentID
+	}
+	for _, pa := range wf.PendingActivities {
+		out.PendingActivities = append(out.PendingActivities, PendingActivity{
+			ActivityID:      pa.ActivityID,
+			ActivityType:    pa.ActivityType,
+			State:           pa.State,
+			Attempt:         pa.Attempt,
+			MaximumAttempts: pa.MaximumAttempts,
+			ScheduledTime:   pa.ScheduledTime,
+			LastStartedTime: pa.LastStartedTime,
+			NextAttemptTime: pa.NextAttemptTime,
+			LastFailure:     pa.LastFailure,
+			LastWorker:      pa.LastWorkerIdentity,
+			LastHeartbeat:   pa.LastHeartbeatTime,
+			Heartbeat:       pa.HeartbeatDetails,
+		})
+	}
+	for _, pc := range wf.PendingChildren {
+		out.PendingChildren = append(out.PendingChildren, PendingChild(pc))
+	}
+
+	for _, ev := range events {
+		switch ev.Type {
+		case "WorkflowExecutionStarted":
+			out.Input = payload(ev.Input)
+		case "WorkflowExecutionCompleted", "WorkflowExecutionCanceled":
+			out.Result = payload(ev.Result)
+		case "WorkflowExecutionFailed", "WorkflowExecutionTerminated", "WorkflowExecutionTimedOut":
+			out.Failure = ev.Failure
+		}
+		out.Events = append(out.Events, Event{
+			ID:       ev.ID,
+			Type:     ev.Type,
+			Time:     ev.Time,
+			Name:     eventName(ev),
+			Attempt:  ev.Attempt,
+			Identity: ev.Identity,
+			Failure:  ev.Failure,
+		})
+	}
+	return out
+}
+
+// eventName returns what an event acts on, if anything.
+func eventName(ev temporal.EnhancedHistoryEvent) string {
+	switch {
+	case ev.ActivityType != "":
+		return ev.ActivityType
+	case ev.TimerID != "":
+		return ev.TimerID
+	case ev.ChildWorkflowType != "":
+		return ev.ChildWorkflowType
+	}
+	return ev.SignalName
+}
+
+// payload embeds a JSON-formatted payload as JSON, or as a string when it is
+// not valid JSON. Empty payloads are omitted.
+func payload(s string) any {
+	if s == "" {
+		return nil
+	}
+	if json.Valid([]byte(s)) {
+		return json.RawMessage(s)
+	}
+	return s
+}
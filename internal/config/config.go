@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -37,6 +38,42 @@ type CommandConfig struct {
 	Confirm     bool              `yaml:"confirm,omitempty"`
 }
 
+// AuthTokenConfig sends a per-user token in a gRPC header, for clusters with
+// header-based authorization. The token can be replaced at runtime with :token.
+type AuthTokenConfig struct {
+	Header string `yaml:"header,omitempty"` // Header name (default "authorization")
+	Scheme string `yaml:"scheme,omitempty"` // Prefix before the token (default "Bearer"; "none" sends the bare token)
+	Env    string `yaml:"env,omitempty"`    // Environment variable holding the initial token
+	File   string `yaml:"file,omitempty"`   // File holding the initial token, read when connecting
+}
+
+// InitialToken returns the token to connect with, read from File if set,
+// otherwise from Env. It returns "" when neither is configured.
+func (a *AuthTokenConfig) InitialToken() (string, error) {
+	if a == nil {
+		return "", nil
+	}
+	if a.File != "" {
+		path := a.File
+		if strings.HasPrefix(path, "~/") {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return "", fmt.Errorf("resolving home directory: %w", err)
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("reading auth token: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	if a.Env != "" {
+		return strings.TrimSpace(os.Getenv(a.Env)), nil
+	}
+	return "", nil
+}
+
 // ConnectionConfig holds Temporal connection settings.
 type ConnectionConfig struct {
 	Address   string                    `yaml:"address"`
@@ -45,6 +82,10 @@ type ConnectionConfig struct {
 	APIKey    string                    `yaml:"api_key,omitempty"` // For Temporal Cloud API key authentication
 	GRPCMeta  map[string]string         `yaml:"grpc_meta,omitempty"` // Custom gRPC metadata headers (KEY=VALUE pairs)
 	Commands  map[string]CommandConfig  `yaml:"commands,omitempty"`
+	WebUI     string                    `yaml:"web_ui,omitempty"` // Base URL of the Temporal Web UI, used for clickable links
+	AuthToken *AuthTokenConfig          `yaml:"auth_token,omitempty"` // Per-user token sent in a gRPC header
+	CodecEndpoint string `yaml:"codec_endpoint,omitempty"` // Remote codec server used to decode payloads
+	CodecAuth     string `yaml:"codec_auth,omitempty"`     // Authorization header sent to the codec server
 }
 
 // ExpandEnv expands environment variables in sensitive fields.
@@ -56,6 +97,11 @@ func (c ConnectionConfig) ExpandEnv() ConnectionConfig {
 		TLS:       c.TLS,
 		APIKey:    expandEnvVar(c.APIKey),
 		Commands:  c.Commands,
+		WebUI:     c.WebUI,
+		AuthToken: c.AuthToken,
+
+		CodecEndpoint: c.CodecEndpoint,
+		CodecAuth:     expandEnvVar(c.CodecAuth),
 	}
 	if len(c.GRPCMeta) > 0 {
 		expanded.GRPCMeta = make(map[string]string, len(c.GRPCMeta))
@@ -101,19 +147,79 @@ type SavedFilter struct {
 	IsDefault bool   `yaml:"is_default,omitempty"`
 }
 
+// PinnedQuery is a named visibility query shown as a tab above the workflow list.
+type PinnedQuery struct {
+	Name  string `yaml:"name"`
+	Query string `yaml:"query"`
+}
+
+// EventStyle overrides the icon and color used to render a history event type.
+type EventStyle struct {
+	Icon  string `yaml:"icon,omitempty"`
+	Color string `yaml:"color,omitempty"` // Hex (#rrggbb), color name, or theme role (success, error, ...)
+}
+
+// Recovery action kinds.
+const (
+	RecoveryActionSignal = "signal" // Send a signal to the current run
+	RecoveryActionRerun  = "rerun"  // Terminate if running, then start a new run with the same input
+)
+
+// RecoveryAction is a runbook step executed from workflow detail for a workflow type.
+type RecoveryAction struct {
+	Action      string `yaml:"action"`                // "signal" or "rerun"
+	Signal      string `yaml:"signal,omitempty"`      // Signal name, for "signal"
+	Input       string `yaml:"input,omitempty"`       // JSON signal input, for "signal"
+	Description string `yaml:"description,omitempty"` // Shown in the confirmation
+}
+
+// Validate checks that the action is runnable.
+func (r RecoveryAction) Validate() error {
+	switch r.Action {
+	case RecoveryActionSignal:
+		if r.Signal == "" {
+			return fmt.Errorf("recovery action %q requires a signal name", r.Action)
+		}
+	case RecoveryActionRerun:
+	default:
+		return fmt.Errorf("unknown recovery action %q (expected %q or %q)", r.Action, RecoveryActionSignal, RecoveryActionRerun)
+	}
+	return nil
+}
+
 // ExternalProfilePrefix is the prefix used for profiles imported from the Temporal CLI.
 const ExternalProfilePrefix = "import:"
 
 // Config represents the application configuration.
 type Config struct {
-	Theme            string                      `yaml:"theme"`
-	ActiveProfile    string                      `yaml:"active_profile,omitempty"`
-	Profiles         map[string]ConnectionConfig `yaml:"profiles,omitempty"`
-	ExternalProfiles map[string]ConnectionConfig `yaml:"-"`
-	SavedFilters     []SavedFilter               `yaml:"saved_filters,omitempty"`
-	CheckUpdates     *bool                       `yaml:"check_updates,omitempty"`
-	HelpStyle        string                      `yaml:"help_style,omitempty"` // "modal" (default) or "sheet"
-	Commands         map[string]CommandConfig    `yaml:"commands,omitempty"`
+	Theme                 string                      `yaml:"theme"`
+	ActiveProfile         string                      `yaml:"active_profile,omitempty"`
+	Profiles              map[string]ConnectionConfig `yaml:"profiles,omitempty"`
+	ExternalProfiles      map[string]ConnectionConfig `yaml:"-"`
+	SavedFilters          []SavedFilter               `yaml:"saved_filters,omitempty"`
+	PinnedQueries         []PinnedQuery               `yaml:"pinned_queries,omitempty"`
+	FavoriteTypes         []string                    `yaml:"favorite_types,omitempty"` // Workflow types starred and pinned to the top of the list
+	SearchAttributeColumns []string                   `yaml:"search_attribute_columns,omitempty"` // Search attributes shown as extra workflow list columns
+	DangerNamespaces      []string                    `yaml:"danger_namespaces,omitempty"` // Namespace globs where delete and terminate need the danger phrase
+	DangerPhrase          string                      `yaml:"danger_phrase,omitempty"`     // Typed to confirm in danger namespaces; {action} and {namespace} are substituted
+	EventStyles           map[string]EventStyle       `yaml:"event_styles,omitempty"` // Keyed by event type, e.g. ActivityTaskFailed
+	CheckUpdates          *bool                       `yaml:"check_updates,omitempty"`
+	HelpStyle             string                      `yaml:"help_style,omitempty"`               // "modal" (default) or "sheet"
+	Icons                 string                      `yaml:"icons,omitempty"`                    // "nerdfont" (default) or "ascii"
+	Density               string                      `yaml:"density,omitempty"`                  // "comfortable" (default) or "compact"
+	Footer                string                      `yaml:"footer,omitempty"`                   // "full" (default), "compact", or "off"
+	DefaultEventView      string                      `yaml:"default_event_view,omitempty"`       // "list", "tree" (default), or "timeline"
+	EventViewOverride     string                      `yaml:"-"`                                  // Set by --event-view for this session only
+	MaxPayloadRenderBytes int                         `yaml:"max_payload_render_bytes,omitempty"` // Payloads larger than this are truncated when rendered
+	LargeHistoryEvents    int                         `yaml:"large_history_events,omitempty"`     // Ask before loading histories with more events than this
+	ExplainEvents         bool                        `yaml:"explain_events,omitempty"`           // Describe each event type in plain language in event panels
+	ShowSystemWorkflows   bool                        `yaml:"show_system_workflows,omitempty"`    // List the temporal-system namespace, read-only
+	ReplayBinary          string                      `yaml:"replay_binary,omitempty"`            // Replayer run with a history file path to check determinism
+	PageSize              int                         `yaml:"page_size,omitempty"`                // Rows fetched per list request, 1-1000
+	PageSizeOverride      int                         `yaml:"-"`                                  // Set by --page-size for this session only
+	SlowDuration          string                      `yaml:"slow_duration,omitempty"`            // Activity and child durations at least this long are shown as slowest, e.g. "30s"
+	RecoveryActions       map[string]RecoveryAction   `yaml:"recovery_actions,omitempty"`         // Keyed by workflow type
+	Commands              map[string]CommandConfig    `yaml:"commands,omitempty"`
 }
 
 // IsExternalProfile returns true if the given profile name is an external
@@ -135,6 +241,134 @@ func (c *Config) GetHelpStyle() string {
 	return "modal"
 }
 
+// UseASCIIIcons returns true if the ASCII icon set is configured.
+// Defaults to Nerd Font glyphs.
+func (c *Config) UseASCIIIcons() bool {
+	return strings.EqualFold(c.Icons, "ascii")
+}
+
+// CompactDensity returns true if the compact list density is configured.
+// Defaults to comfortable.
+func (c *Config) CompactDensity() bool {
+	return strings.EqualFold(c.Density, "compact")
+}
+
+// FooterModes lists the accepted values for footer, in toggle order.
+var FooterModes = []string{"full", "compact", "off"}
+
+// GetFooter returns the configured key hint footer mode.
+// Defaults to "full".
+func (c *Config) GetFooter() string {
+	for _, mode := range FooterModes {
+		if strings.EqualFold(c.Footer, mode) {
+			return mode
+		}
+	}
+	return "full"
+}
+
+// EventViewModes lists the accepted values for default_event_view.
+var EventViewModes = []string{"list", "tree", "timeline"}
+
+// IsValidEventView returns true if name is one of EventViewModes.
+func IsValidEventView(name string) bool {
+	for _, mode := range EventViewModes {
+		if strings.EqualFold(name, mode) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDefaultEventView returns the mode the event history opens in, taking
+// EventViewOverride first. Defaults to "tree" if unset or unrecognized.
+func (c *Config) GetDefaultEventView() string {
+	if IsValidEventView(c.EventViewOverride) {
+		return strings.ToLower(c.EventViewOverride)
+	}
+	if IsValidEventView(c.DefaultEventView) {
+		return strings.ToLower(c.DefaultEventView)
+	}
+	return "tree"
+}
+
+// DefaultMaxPayloadRenderBytes is the render limit used when max_payload_render_bytes is unset.
+const DefaultMaxPayloadRenderBytes = 64 * 1024
+
+// GetMaxPayloadRenderBytes returns the size above which payloads are truncated when rendered.
+// Defaults to DefaultMaxPayloadRenderBytes if unset or not positive.
+func (c *Config) GetMaxPayloadRenderBytes() int {
+	if c.MaxPayloadRenderBytes > 0 {
+		return c.MaxPayloadRenderBytes
+	}
+	return DefaultMaxPayloadRenderBytes
+}
+
+// DefaultLargeHistoryEvents is the history length above which tempo asks
+// before loading a history, used when large_history_events is unset.
+const DefaultLargeHistoryEvents = 10000
+
+// GetLargeHistoryEvents returns the history length above which tempo asks
+// before loading a history. Defaults to DefaultLargeHistoryEvents if unset
+// or not positive.
+func (c *Config) GetLargeHistoryEvents() int {
+	if c.LargeHistoryEvents > 0 {
+		return c.LargeHistoryEvents
+	}
+	return DefaultLargeHistoryEvents
+}
+
+// Page size bounds for list requests. Temporal caps visibility pages at 1000.
+const (
+	DefaultPageSize = 100
+	MinPageSize     = 1
+	MaxPageSize     = 1000
+)
+
+// IsValidPageSize returns true if n is within MinPageSize and MaxPageSize.
+func IsValidPageSize(n int) bool {
+	return n >= MinPageSize && n <= MaxPageSize
+}
+
+// ClampPageSize limits n to MaxPageSize. Zero or a negative n means unset
+// and gives DefaultPageSize.
+func ClampPageSize(n int) int {
+	switch {
+	case n < MinPageSize:
+		return DefaultPageSize
+	case n > MaxPageSize:
+		return MaxPageSize
+	}
+	return n
+}
+
+// GetPageSize returns the number of rows fetched per list request, taking
+// PageSizeOverride first. Defaults to DefaultPageSize if unset, and is
+// clamped to MaxPageSize.
+func (c *Config) GetPageSize() int {
+	if c.PageSizeOverride != 0 {
+		return ClampPageSize(c.PageSizeOverride)
+	}
+	return ClampPageSize(c.PageSize)
+}
+
+// GetSlowDuration returns the duration at which activities and child
+// workflows are colored as slowest in the event tree, or 0 if slow_duration
+// is unset or invalid, in which case the scale comes from each workflow.
+func (c *Config) GetSlowDuration() time.Duration {
+	d, err := time.ParseDuration(c.SlowDuration)
+	if err != nil || d <= 0 {
+		return 0
+	}
+	return d
+}
+
+// RecoveryActionFor returns the recovery action configured for a workflow type.
+func (c *Config) RecoveryActionFor(workflowType string) (RecoveryAction, bool) {
+	action, ok := c.RecoveryActions[workflowType]
+	return action, ok
+}
+
 // ShouldCheckUpdates returns whether update checking is enabled.
 // Defaults to true if not explicitly set.
 func (c *Config) ShouldCheckUpdates() bool {
@@ -306,6 +540,19 @@ func (c *Config) GetProfile(name string) (ConnectionConfig, bool) {
 	return ConnectionConfig{}, false
 }
 
+// ResolveProfile returns the name a profile is stored under. A native
+// profile is used if one has the name; otherwise a Temporal CLI profile of
+// that name is, so "staging" finds "import:staging".
+func (c *Config) ResolveProfile(name string) (string, bool) {
+	if _, ok := c.GetProfile(name); ok {
+		return name, true
+	}
+	if _, ok := c.GetProfile(ExternalProfilePrefix + name); ok {
+		return ExternalProfilePrefix + name, true
+	}
+	return "", false
+}
+
 // GetActiveProfile returns the active profile name and its configuration.
 func (c *Config) GetActiveProfile() (string, ConnectionConfig) {
 	if c.ActiveProfile == "" {
@@ -473,6 +720,82 @@ func (c *Config) ClearDefaultFilter() {
 	}
 }
 
+// GetPinnedQueries returns the pinned queries that have both a name and a query.
+func (c *Config) GetPinnedQueries() []PinnedQuery {
+	var pinned []PinnedQuery
+	for _, p := range c.PinnedQueries {
+		if p.Name != "" && p.Query != "" {
+			pinned = append(pinned, p)
+		}
+	}
+	return pinned
+}
+
+// DefaultDangerPhrase is the confirmation phrase used in danger namespaces
+// when danger_phrase is not set, e.g. "terminate-prod".
+const DefaultDangerPhrase = "{action}-{namespace}"
+
+// IsDangerNamespace reports whether a namespace matches one of the
+// danger_namespaces globs.
+func (c *Config) IsDangerNamespace(namespace string) bool {
+	for _, pattern := range c.DangerNamespaces {
+		if ok, _ := filepath.Match(pattern, namespace); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DangerConfirmation returns the phrase that must be typed to confirm action
+// (e.g. "delete" or "terminate") in namespace, or "" if the namespace is not
+// a danger namespace.
+func (c *Config) DangerConfirmation(action, namespace string) string {
+	if !c.IsDangerNamespace(namespace) {
+		return ""
+	}
+	phrase := c.DangerPhrase
+	if phrase == "" {
+		phrase = DefaultDangerPhrase
+	}
+	return strings.NewReplacer("{action}", action, "{namespace}", namespace).Replace(phrase)
+}
+
+// IsFavoriteType reports whether a workflow type is starred.
+func (c *Config) IsFavoriteType(workflowType string) bool {
+	for _, t := range c.FavoriteTypes {
+		if t == workflowType {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleFavoriteType stars or unstars a workflow type and returns whether it
+// is now a favorite.
+func (c *Config) ToggleFavoriteType(workflowType string) bool {
+	for i, t := range c.FavoriteTypes {
+		if t == workflowType {
+			c.FavoriteTypes = append(c.FavoriteTypes[:i], c.FavoriteTypes[i+1:]...)
+			return false
+		}
+	}
+	c.FavoriteTypes = append(c.FavoriteTypes, workflowType)
+	return true
+}
+
+// ToggleSearchAttributeColumn adds or removes a search attribute column in
+// the workflow list and returns whether it is now shown.
+func (c *Config) ToggleSearchAttributeColumn(name string) bool {
+	for i, col := range c.SearchAttributeColumns {
+		if col == name {
+			c.SearchAttributeColumns = append(c.SearchAttributeColumns[:i], c.SearchAttributeColumns[i+1:]...)
+			return false
+		}
+	}
+	c.SearchAttributeColumns = append(c.SearchAttributeColumns, name)
+	return true
+}
+
 // loadThemeFile loads a theme from a YAML file.
 func loadThemeFile(path string) (*ParsedTheme, error) {
 	data, err := os.ReadFile(path)
@@ -29,8 +29,12 @@ func LoadTemporalCLIProfiles() map[string]ConnectionConfig {
 		}
 	}
 
-	// Load TOML profiles; YAML entries take precedence on conflict
+	// Load TOML profiles; YAML entries take precedence on conflict. Like the
+	// CLI, TEMPORAL_CONFIG_FILE points at a TOML file in another location.
 	tomlPath := filepath.Join(configDir, "temporal.toml")
+	if path := os.Getenv("TEMPORAL_CONFIG_FILE"); path != "" {
+		tomlPath = path
+	}
 	tomlProfiles, err := loadTemporalProfileTOML(tomlPath)
 	if err == nil {
 		for name, cfg := range tomlProfiles {
@@ -91,13 +95,22 @@ type temporalTOMLProfile struct {
 	Namespace string              `toml:"namespace"`
 	APIKey    string              `toml:"api_key"`
 	TLS       temporalTOMLTLS     `toml:"tls"`
+	GRPCMeta  map[string]string   `toml:"grpc_meta"`
+	Codec     temporalTOMLCodec   `toml:"codec"`
+}
+
+type temporalTOMLCodec struct {
+	Endpoint string `toml:"endpoint"`
+	Auth     string `toml:"auth"`
 }
 
 type temporalTOMLTLS struct {
-	ClientCertPath string `toml:"client_cert_path"`
-	ClientKeyPath  string `toml:"client_key_path"`
-	CAPath         string `toml:"ca_path"`
-	ServerName     string `toml:"server_name"`
+	ClientCertPath          string `toml:"client_cert_path"`
+	ClientKeyPath           string `toml:"client_key_path"`
+	ServerCACertPath        string `toml:"server_ca_cert_path"`
+	CAPath                  string `toml:"ca_path"` // Older name for server_ca_cert_path
+	ServerName              string `toml:"server_name"`
+	DisableHostVerification bool   `toml:"disable_host_verification"`
 }
 
 func loadTemporalProfileTOML(path string) (map[string]ConnectionConfig, error) {
@@ -108,16 +121,24 @@ func loadTemporalProfileTOML(path string) (map[string]ConnectionConfig, error) {
 
 	profiles := make(map[string]ConnectionConfig)
 	for name, p := range cfg.Profile {
+		ca := p.TLS.ServerCACertPath
+		if ca == "" {
+			ca = p.TLS.CAPath
+		}
 		conn := ConnectionConfig{
 			Address:   p.Address,
 			Namespace: p.Namespace,
 			TLS: TLSConfig{
 				Cert:       p.TLS.ClientCertPath,
 				Key:        p.TLS.ClientKeyPath,
-				CA:         p.TLS.CAPath,
+				CA:         ca,
 				ServerName: p.TLS.ServerName,
+				SkipVerify: p.TLS.DisableHostVerification,
 			},
-			APIKey: p.APIKey,
+			APIKey:        p.APIKey,
+			GRPCMeta:      p.GRPCMeta,
+			CodecEndpoint: p.Codec.Endpoint,
+			CodecAuth:     p.Codec.Auth,
 		}
 		profiles[name] = conn
 	}
@@ -0,0 +1,180 @@
+// Package icons provides the glyphs used throughout tempo's UI.
+//
+// The default set uses Nerd Font glyphs from jig's theme package. Terminals
+// without a Nerd Font render those as boxes, so an ASCII set is provided as an
+// explicit fallback. Call Use before building any views; glyphs already
+// rendered into titles or text are not updated retroactively. Glyphs drawn
+// internally by jig components (chevrons, tree lines) are not affected.
+package icons
+
+import "github.com/atterpac/jig/theme"
+
+// Set is a collection of UI glyphs.
+type Set struct {
+	Info         string
+	Error        string
+	Warning      string
+	Check        string
+	Pending      string
+	Running      string
+	Completed    string
+	Failed       string
+	Canceled     string
+	Terminated   string
+	TimedOut     string
+	Stop         string
+	Workflow     string
+	Activity     string
+	Event        string
+	Signal       string
+	Schedule     string
+	TaskQueue    string
+	Namespace    string
+	ArrowRight   string
+	ArrowLeft    string
+	Search       string
+	Delete       string
+	Grid         string
+	Heart        string
+	Star         string
+	Database     string
+	Server       string
+	Connected    string
+	Disconnected string
+}
+
+// NerdFont is the default icon set. It requires a Nerd Font patched terminal font.
+var NerdFont = Set{
+	Info:         theme.IconInfo,
+	Error:        theme.IconError,
+	Warning:      theme.IconWarning,
+	Check:        theme.IconCheck,
+	Pending:      theme.IconPending,
+	Running:      theme.IconRunning,
+	Completed:    theme.IconCompleted,
+	Failed:       theme.IconFailed,
+	Canceled:     theme.IconCanceled,
+	Terminated:   theme.IconTerminated,
+	TimedOut:     theme.IconTimedOut,
+	Stop:         theme.IconStop,
+	Workflow:     theme.IconWorkflow,
+	Activity:     theme.IconActivity,
+	Event:        theme.IconEvent,
+	Signal:       theme.IconSignal,
+	Schedule:     theme.IconSchedule,
+	TaskQueue:    theme.IconTaskQueue,
+	Namespace:    theme.IconNamespace,
+	ArrowRight:   theme.IconArrowRight,
+	ArrowLeft:    theme.IconArrowLeft,
+	Search:       theme.IconSearch,
+	Delete:       theme.IconDelete,
+	Grid:         theme.IconGrid,
+	Heart:        theme.IconHeart,
+	Star:         theme.IconStar,
+	Database:     theme.IconDatabase,
+	Server:       theme.IconServer,
+	Connected:    theme.IconConnected,
+	Disconnected: theme.IconDisconnected,
+}
+
+// ASCII is a fallback icon set that renders on any terminal font.
+var ASCII = Set{
+	Info:         "i",
+	Error:        "x",
+	Warning:      "!",
+	Check:        "+",
+	Pending:      "o",
+	Running:      ">",
+	Completed:    "+",
+	Failed:       "x",
+	Canceled:     "-",
+	Terminated:   "#",
+	TimedOut:     "~",
+	Stop:         "#",
+	Workflow:     "[WF]",
+	Activity:     "*",
+	Event:        "@",
+	Signal:       "^",
+	Schedule:     "[S]",
+	TaskQueue:    "[Q]",
+	Namespace:    "[NS]",
+	ArrowRight:   ">",
+	ArrowLeft:    "<",
+	Search:       "?",
+	Delete:       "x",
+	Grid:         "#",
+	Heart:        "<3",
+	Star:         "*",
+	Database:     "[DB]",
+	Server:       "[SV]",
+	Connected:    "[+]",
+	Disconnected: "[-]",
+}
+
+// Active glyphs. These are reassigned by Use.
+var (
+	Info         = NerdFont.Info
+	Error        = NerdFont.Error
+	Warning      = NerdFont.Warning
+	Check        = NerdFont.Check
+	Pending      = NerdFont.Pending
+	Running      = NerdFont.Running
+	Completed    = NerdFont.Completed
+	Failed       = NerdFont.Failed
+	Canceled     = NerdFont.Canceled
+	Terminated   = NerdFont.Terminated
+	TimedOut     = NerdFont.TimedOut
+	Stop         = NerdFont.Stop
+	Workflow     = NerdFont.Workflow
+	Activity     = NerdFont.Activity
+	Event        = NerdFont.Event
+	Signal       = NerdFont.Signal
+	Schedule     = NerdFont.Schedule
+	TaskQueue    = NerdFont.TaskQueue
+	Namespace    = NerdFont.Namespace
+	ArrowRight   = NerdFont.ArrowRight
+	ArrowLeft    = NerdFont.ArrowLeft
+	Search       = NerdFont.Search
+	Delete       = NerdFont.Delete
+	Grid         = NerdFont.Grid
+	Heart        = NerdFont.Heart
+	Star         = NerdFont.Star
+	Database     = NerdFont.Database
+	Server       = NerdFont.Server
+	Connected    = NerdFont.Connected
+	Disconnected = NerdFont.Disconnected
+)
+
+// Use makes s the active icon set.
+func Use(s Set) {
+	Info = s.Info
+	Error = s.Error
+	Warning = s.Warning
+	Check = s.Check
+	Pending = s.Pending
+	Running = s.Running
+	Completed = s.Completed
+	Failed = s.Failed
+	Canceled = s.Canceled
+	Terminated = s.Terminated
+	TimedOut = s.TimedOut
+	Stop = s.Stop
+	Workflow = s.Workflow
+	Activity = s.Activity
+	Event = s.Event
+	Signal = s.Signal
+	Schedule = s.Schedule
+	TaskQueue = s.TaskQueue
+	Namespace = s.Namespace
+	ArrowRight = s.ArrowRight
+	ArrowLeft = s.ArrowLeft
+	Search = s.Search
+	Delete = s.Delete
+	Grid = s.Grid
+	Heart = s.Heart
+	Star = s.Star
+	Database = s.Database
+	Server = s.Server
+	Connected = s.Connected
+	Disconnected = s.Disconnected
+}
@@ -0,0 +1,50 @@
+package temporal
+
+// actorVerbs lists the event types a client requests directly, such as an
+// operator terminating a workflow, with how to describe who did it.
+// Identities on worker events (workflow and activity tasks) name the worker
+// process and are not listed.
+var actorVerbs = map[string]string{
+	"WorkflowExecutionStarted":         "started",
+	"WorkflowExecutionSignaled":        "signaled",
+	"WorkflowExecutionCancelRequested": "cancel requested",
+	"WorkflowExecutionTerminated":      "terminated",
+	"WorkflowExecutionOptionsUpdated":  "options updated",
+	"WorkflowExecutionPaused":          "paused",
+	"WorkflowExecutionUnpaused":        "unpaused",
+	"WorkflowExecutionUpdateAdmitted":  "update requested",
+	"WorkflowExecutionUpdateAccepted":  "updated",
+	"WorkflowExecutionUpdateRejected":  "update requested",
+}
+
+// ActionBy describes who requested a client-initiated event, e.g.
+// "terminated by alice@laptop". It returns "" for other events and when
+// the requester left no identity.
+func ActionBy(ev *EnhancedHistoryEvent) string {
+	verb, ok := actorVerbs[ev.Type]
+	if !ok || ev.Identity == "" {
+		return ""
+	}
+	return verb + " by " + ev.Identity
+}
+
+// ClosedBy returns who terminated or cancelled a workflow, from the
+// terminate event or, for a cancelled workflow, the cancel request.
+// It returns "" when the history does not say.
+func ClosedBy(events []EnhancedHistoryEvent, status string) string {
+	want := ""
+	switch status {
+	case "Terminated":
+		want = "WorkflowExecutionTerminated"
+	case "Canceled":
+		want = "WorkflowExecutionCancelRequested"
+	default:
+		return ""
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == want {
+			return events[i].Identity
+		}
+	}
+	return ""
+}
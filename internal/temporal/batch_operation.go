@@ -0,0 +1,256 @@
+package temporal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchpb "go.temporal.io/api/batch/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// BatchOperationType is the action a server-side batch job applies.
+type BatchOperationType string
+
+const (
+	BatchCancel    BatchOperationType = "Cancel"
+	BatchTerminate BatchOperationType = "Terminate"
+	BatchSignal    BatchOperationType = "Signal"
+	BatchReset     BatchOperationType = "Reset"
+)
+
+// BatchResetTarget is the point a batch reset returns each workflow to.
+type BatchResetTarget string
+
+const (
+	BatchResetFirstWorkflowTask BatchResetTarget = "FirstWorkflowTask"
+	BatchResetLastWorkflowTask  BatchResetTarget = "LastWorkflowTask"
+)
+
+// BatchOperationRequest describes a batch job run by the server against
+// every workflow matching a visibility query.
+type BatchOperationRequest struct {
+	Operation   BatchOperationType
+	Query       string // Visibility query selecting the workflows
+	Reason      string
+	SignalName  string           // Signal only
+	SignalInput []byte           // Signal only, JSON
+	ResetTarget BatchResetTarget // Reset only
+}
+
+// BatchOperation is the state of a server-side batch job.
+type BatchOperation struct {
+	JobID          string
+	Operation      string
+	State          string // Running, Completed, or Failed
+	StartTime      time.Time
+	CloseTime      *time.Time
+	TotalCount     int64
+	CompletedCount int64
+	FailedCount    int64
+	Identity       string
+	Reason         string
+}
+
+// Done reports whether the job has finished.
+func (b *BatchOperation) Done() bool {
+	return b.State != "Running" && b.State != "Unspecified"
+}
+
+// StartBatchOperation starts a server-side batch job and returns its job ID.
+func (c *Client) StartBatchOperation(ctx context.Context, namespace string, req BatchOperationRequest) (string, error) {
+	if c.client == nil {
+		return "", fmt.Errorf("client not connected")
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		return "", fmt.Errorf("batch operations need a visibility query")
+	}
+
+	jobID := fmt.Sprintf("tempo-%s-%d", strings.ToLower(string(req.Operation)), time.Now().UnixNano())
+	request := &workflowservice.StartBatchOperationRequest{
+		Namespace:       namespace,
+		VisibilityQuery: req.Query,
+		JobId:           jobID,
+		Reason:          req.Reason,
+	}
+
+	switch req.Operation {
+	case BatchCancel:
+		request.Operation = &workflowservice.StartBatchOperationRequest_CancellationOperation{
+			CancellationOperation: &batchpb.BatchOperationCancellation{Identity: "tempo"},
+		}
+	case BatchTerminate:
+		request.Operation = &workflowservice.StartBatchOperationRequest_TerminationOperation{
+			TerminationOperation: &batchpb.BatchOperationTermination{Identity: "tempo"},
+		}
+	case BatchSignal:
+		if req.SignalName == "" {
+			return "", fmt.Errorf("batch signal needs a signal name")
+		}
+		signal := &batchpb.BatchOperationSignal{Signal: req.SignalName, Identity: "tempo"}
+		if len(req.SignalInput) > 0 {
+			input, err := converter.GetDefaultDataConverter().ToPayloads(json.RawMessage(req.SignalInput))
+			if err != nil {
+				return "", fmt.Errorf("failed to encode signal input: %w", err)
+			}
+			signal.Input = input
+		}
+		request.Operation = &workflowservice.StartBatchOperationRequest_SignalOperation{SignalOperation: signal}
+	case BatchReset:
+		options := &commonpb.ResetOptions{}
+		switch req.ResetTarget {
+		case BatchResetFirstWorkflowTask:
+			options.Target = &commonpb.ResetOptions_FirstWorkflowTask{FirstWorkflowTask: &emptypb.Empty{}}
+		case BatchResetLastWorkflowTask, "":
+			options.Target = &commonpb.ResetOptions_LastWorkflowTask{LastWorkflowTask: &emptypb.Empty{}}
+		default:
+			return "", fmt.Errorf("unknown reset target %q", req.ResetTarget)
+		}
+		request.Operation = &workflowservice.StartBatchOperationRequest_ResetOperation{
+			ResetOperation: &batchpb.BatchOperationReset{Identity: "tempo", Options: options},
+		}
+	default:
+		return "", fmt.Errorf("unknown batch operation %q", req.Operation)
+	}
+
+	if _, err := c.client.WorkflowService().StartBatchOperation(ctx, request); err != nil {
+		return "", fmt.Errorf("failed to start batch operation: %w", err)
+	}
+	return jobID, nil
+}
+
+// DescribeBatchOperation returns the progress of a batch job.
+func (c *Client) DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*BatchOperation, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe batch operation: %w", err)
+	}
+
+	op := &BatchOperation{
+		JobID:          resp.GetJobId(),
+		Operation:      batchOperationTypeName(resp.GetOperationType()),
+		State:          batchOperationStateName(resp.GetState()),
+		StartTime:      resp.GetStartTime().AsTime(),
+		TotalCount:     resp.GetTotalOperationCount(),
+		CompletedCount: resp.GetCompleteOperationCount(),
+		FailedCount:    resp.GetFailureOperationCount(),
+		Identity:       resp.GetIdentity(),
+		Reason:         resp.GetReason(),
+	}
+	if resp.GetCloseTime() != nil && !resp.GetCloseTime().AsTime().IsZero() {
+		t := resp.GetCloseTime().AsTime()
+		op.CloseTime = &t
+	}
+	return op, nil
+}
+
+// batchOperationListLimit caps how many batch jobs ListBatchOperations
+// describes, newest first.
+const batchOperationListLimit = 50
+
+// ListBatchOperations returns the namespace's recent batch jobs. The list
+// API only carries job IDs and state, so each job is also described for its
+// operation and counts; a job that cannot be described keeps the basics.
+func (c *Client) ListBatchOperations(ctx context.Context, namespace string) ([]BatchOperation, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().ListBatchOperations(ctx, &workflowservice.ListBatchOperationsRequest{
+		Namespace: namespace,
+		PageSize:  batchOperationListLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list batch operations: %w", err)
+	}
+
+	ops := make([]BatchOperation, 0, len(resp.GetOperationInfo()))
+	for _, info := range resp.GetOperationInfo() {
+		if op, err := c.DescribeBatchOperation(ctx, namespace, info.GetJobId()); err == nil {
+			ops = append(ops, *op)
+			continue
+		}
+		op := BatchOperation{
+			JobID:     info.GetJobId(),
+			Operation: "Unspecified",
+			State:     batchOperationStateName(info.GetState()),
+			StartTime: info.GetStartTime().AsTime(),
+		}
+		if info.GetCloseTime() != nil && !info.GetCloseTime().AsTime().IsZero() {
+			t := info.GetCloseTime().AsTime()
+			op.CloseTime = &t
+		}
+		ops = append(ops, op)
+	}
+
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].StartTime.After(ops[j].StartTime)
+	})
+	return ops, nil
+}
+
+// StopBatchOperation stops a running batch job. Workflows it already
+// processed are not rolled back.
+func (c *Client) StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+
+	_, err := c.client.WorkflowService().StopBatchOperation(ctx, &workflowservice.StopBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+		Reason:    reason,
+		Identity:  "tempo",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop batch operation: %w", err)
+	}
+	return nil
+}
+
+// batchOperationTypeName maps the server's operation type to its
+// BatchOperationType name.
+func batchOperationTypeName(t enums.BatchOperationType) string {
+	switch t {
+	case enums.BATCH_OPERATION_TYPE_CANCEL:
+		return string(BatchCancel)
+	case enums.BATCH_OPERATION_TYPE_TERMINATE:
+		return string(BatchTerminate)
+	case enums.BATCH_OPERATION_TYPE_SIGNAL:
+		return string(BatchSignal)
+	case enums.BATCH_OPERATION_TYPE_RESET:
+		return string(BatchReset)
+	case enums.BATCH_OPERATION_TYPE_DELETE:
+		return "Delete"
+	default:
+		return "Unspecified"
+	}
+}
+
+// batchOperationStateName maps the server's job state to a display name.
+func batchOperationStateName(s enums.BatchOperationState) string {
+	switch s {
+	case enums.BATCH_OPERATION_STATE_RUNNING:
+		return "Running"
+	case enums.BATCH_OPERATION_STATE_COMPLETED:
+		return "Completed"
+	case enums.BATCH_OPERATION_STATE_FAILED:
+		return "Failed"
+	default:
+		return "Unspecified"
+	}
+}
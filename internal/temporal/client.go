@@ -4,11 +4,14 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -21,8 +24,13 @@ import (
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/temporalproto"
+	updatepb "go.temporal.io/api/update/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -71,22 +79,93 @@ func initLogFile() {
 	sdkLogger = &fileLogger{logger: log.New(f, "", log.Ldate|log.Ltime)}
 }
 
-// staticHeadersProvider implements the Temporal SDK HeadersProvider interface,
-// returning a fixed set of gRPC metadata headers on every outgoing request.
-type staticHeadersProvider struct {
-	headers map[string]string
+// headersProvider implements the Temporal SDK HeadersProvider interface. It
+// attaches the configured gRPC metadata to every outgoing request, plus an
+// auth token header whose value can be replaced without reconnecting.
+type headersProvider struct {
+	mu         sync.RWMutex
+	static     map[string]string
+	authHeader string
+	authValue  string
 }
 
-func (p *staticHeadersProvider) GetHeaders(_ context.Context) (map[string]string, error) {
-	return p.headers, nil
+// newHeadersProvider builds the headers for a connection config.
+func newHeadersProvider(connConfig ConnectionConfig) *headersProvider {
+	p := &headersProvider{static: connConfig.GRPCMeta}
+	p.setAuthToken(connConfig)
+	return p
+}
+
+// setAuthToken updates the auth header from the config's AuthToken.
+func (p *headersProvider) setAuthToken(connConfig ConnectionConfig) {
+	header := connConfig.AuthHeader
+	if header == "" {
+		header = "authorization"
+	}
+	value := connConfig.AuthToken
+	if value != "" && connConfig.AuthScheme != "none" {
+		scheme := connConfig.AuthScheme
+		if scheme == "" {
+			scheme = "Bearer"
+		}
+		value = scheme + " " + value
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.authHeader = header
+	p.authValue = value
+}
+
+func (p *headersProvider) GetHeaders(_ context.Context) (map[string]string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.authValue == "" {
+		return p.static, nil
+	}
+	headers := make(map[string]string, len(p.static)+1)
+	for k, v := range p.static {
+		headers[k] = v
+	}
+	headers[p.authHeader] = p.authValue
+	return headers, nil
+}
+
+// configureAuth sets the credentials and TLS options for a connection.
+// An API key (Temporal Cloud) is sent as a bearer token over TLS, which
+// needs no client certificate but still honors a configured CA, server name,
+// or skip-verify. Otherwise TLS is used when any TLS setting is present.
+func configureAuth(opts *client.Options, connConfig ConnectionConfig) error {
+	hasTLS := connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" ||
+		connConfig.TLSServerName != "" || connConfig.TLSSkipVerify
+	if connConfig.APIKey != "" {
+		opts.Credentials = client.NewAPIKeyStaticCredentials(connConfig.APIKey)
+		if !hasTLS {
+			opts.ConnectionOptions.TLS = &tls.Config{}
+			return nil
+		}
+	} else if !hasTLS {
+		return nil
+	}
+
+	tlsConfig, err := buildTLSConfig(connConfig)
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+	opts.ConnectionOptions.TLS = tlsConfig
+	return nil
 }
 
 // Client implements the Provider interface using the Temporal SDK.
 type Client struct {
 	client    client.Client
 	config    ConnectionConfig
+	headers   *headersProvider
 	connected bool
 	mu        sync.RWMutex
+
+	historyMu sync.Mutex
+	histories map[string]*cachedHistory // Keyed by namespace/workflowID/runID
 }
 
 // NewClient creates a new Temporal SDK client with the given configuration.
@@ -100,26 +179,17 @@ func NewClient(ctx context.Context, connConfig ConnectionConfig) (*Client, error
 		Logger:    sdkLogger,
 	}
 
-	// Configure authentication
-	if connConfig.APIKey != "" {
-		// API Key authentication (Temporal Cloud)
-		opts.Credentials = client.NewAPIKeyStaticCredentials(connConfig.APIKey)
-		// API key auth requires TLS but doesn't need client certificates
-		opts.ConnectionOptions.TLS = &tls.Config{}
-	} else if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify {
-		// mTLS authentication
-		tlsConfig, err := buildTLSConfig(connConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to configure TLS: %w", err)
-		}
-		opts.ConnectionOptions.TLS = tlsConfig
+	if err := configureAuth(&opts, connConfig); err != nil {
+		return nil, err
 	}
-
-	// Attach custom gRPC metadata headers if configured
-	if len(connConfig.GRPCMeta) > 0 {
-		opts.HeadersProvider = &staticHeadersProvider{headers: connConfig.GRPCMeta}
+	if err := configureCodec(&opts, connConfig); err != nil {
+		return nil, err
 	}
 
+	// Attach custom gRPC metadata and the auth token header
+	headers := newHeadersProvider(connConfig)
+	opts.HeadersProvider = headers
+
 	c, err := client.DialContext(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Temporal server: %w", err)
@@ -128,6 +198,7 @@ func NewClient(ctx context.Context, connConfig ConnectionConfig) (*Client, error
 	return &Client{
 		client:    c,
 		config:    connConfig,
+		headers:   headers,
 		connected: true,
 	}, nil
 }
@@ -247,26 +318,17 @@ func (c *Client) reconnectWithConfig(ctx context.Context, connConfig ConnectionC
 		Logger:    sdkLogger,
 	}
 
-	// Configure authentication
-	if connConfig.APIKey != "" {
-		// API Key authentication (Temporal Cloud)
-		opts.Credentials = client.NewAPIKeyStaticCredentials(connConfig.APIKey)
-		// API key auth requires TLS but doesn't need client certificates
-		opts.ConnectionOptions.TLS = &tls.Config{}
-	} else if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify {
-		// mTLS authentication
-		tlsConfig, err := buildTLSConfig(connConfig)
-		if err != nil {
-			return fmt.Errorf("failed to configure TLS: %w", err)
-		}
-		opts.ConnectionOptions.TLS = tlsConfig
+	if err := configureAuth(&opts, connConfig); err != nil {
+		return err
 	}
-
-	// Attach custom gRPC metadata headers if configured
-	if len(connConfig.GRPCMeta) > 0 {
-		opts.HeadersProvider = &staticHeadersProvider{headers: connConfig.GRPCMeta}
+	if err := configureCodec(&opts, connConfig); err != nil {
+		return err
 	}
 
+	// Attach custom gRPC metadata and the auth token header
+	headers := newHeadersProvider(connConfig)
+	opts.HeadersProvider = headers
+
 	newClient, err := client.DialContext(ctx, opts)
 	if err != nil {
 		return fmt.Errorf("failed to reconnect: %w", err)
@@ -275,12 +337,30 @@ func (c *Client) reconnectWithConfig(ctx context.Context, connConfig ConnectionC
 	c.mu.Lock()
 	c.client = newClient
 	c.config = connConfig // Update stored config
+	c.headers = headers
 	c.connected = true
 	c.mu.Unlock()
 
+	// Cached histories belong to the previous server
+	c.historyMu.Lock()
+	c.histories = nil
+	c.historyMu.Unlock()
+
 	return nil
 }
 
+// SetAuthToken replaces the auth token sent with every request. It takes
+// effect on the next call, without reconnecting, and is kept across
+// reconnects to the same server.
+func (c *Client) SetAuthToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.config.AuthToken = token
+	if c.headers != nil {
+		c.headers.setAuthToken(c.config)
+	}
+}
+
 // Config returns the connection configuration used by this client.
 func (c *Client) Config() ConnectionConfig {
 	c.mu.RLock()
@@ -319,6 +399,7 @@ func (c *Client) ListNamespaces(ctx context.Context) ([]Namespace, error) {
 				Name:            info.GetName(),
 				State:           MapNamespaceState(info.GetState()),
 				RetentionPeriod: retention,
+				RetentionDays:   retentionDays(config.GetWorkflowExecutionRetentionTtl()),
 				Description:     info.GetDescription(),
 				OwnerEmail:      info.GetOwnerEmail(),
 			})
@@ -335,8 +416,8 @@ func (c *Client) ListNamespaces(ctx context.Context) ([]Namespace, error) {
 
 // CreateNamespace registers a new namespace with the Temporal server.
 func (c *Client) CreateNamespace(ctx context.Context, req NamespaceCreateRequest) error {
-	if req.RetentionDays < 1 {
-		return fmt.Errorf("retention period must be at least 1 day")
+	if err := ValidateRetentionDays(req.RetentionDays); err != nil {
+		return err
 	}
 
 	retention := durationpb.New(time.Duration(req.RetentionDays) * 24 * time.Hour)
@@ -381,11 +462,23 @@ func (c *Client) DescribeNamespace(ctx context.Context, name string) (*Namespace
 		clusters = append(clusters, cluster.GetClusterName())
 	}
 
+	var failovers []NamespaceFailover
+	for _, status := range resp.GetFailoverHistory() {
+		failovers = append(failovers, NamespaceFailover{
+			Time:    status.GetFailoverTime().AsTime(),
+			Version: status.GetFailoverVersion(),
+		})
+	}
+	slices.SortFunc(failovers, func(a, b NamespaceFailover) int {
+		return b.Time.Compare(a.Time)
+	})
+
 	detail := &NamespaceDetail{
 		Namespace: Namespace{
 			Name:            info.GetName(),
 			State:           MapNamespaceState(info.GetState()),
 			RetentionPeriod: retention,
+			RetentionDays:   retentionDays(config.GetWorkflowExecutionRetentionTtl()),
 			Description:     info.GetDescription(),
 			OwnerEmail:      info.GetOwnerEmail(),
 		},
@@ -394,13 +487,10 @@ func (c *Client) DescribeNamespace(ctx context.Context, name string) (*Namespace
 		FailoverVersion:    resp.GetFailoverVersion(),
 		HistoryArchival:    historyArchival,
 		VisibilityArchival: visibilityArchival,
+		ActiveCluster:      replication.GetActiveClusterName(),
 		Clusters:           clusters,
-	}
-
-	// Parse timestamps if available
-	if info.GetData() != nil {
-		// Note: CreatedAt and UpdatedAt are not directly exposed in the API response
-		// They would need to be extracted from namespace info data if stored there
+		ReplicationState:   formatReplicationState(replication.GetState()),
+		FailoverHistory:    failovers,
 	}
 
 	return detail, nil
@@ -408,6 +498,12 @@ func (c *Client) DescribeNamespace(ctx context.Context, name string) (*Namespace
 
 // UpdateNamespace modifies an existing namespace's configuration.
 func (c *Client) UpdateNamespace(ctx context.Context, req NamespaceUpdateRequest) error {
+	if req.RetentionDays != 0 {
+		if err := ValidateRetentionDays(req.RetentionDays); err != nil {
+			return err
+		}
+	}
+
 	// First describe to get current state
 	current, err := c.client.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
 		Namespace: req.Name,
@@ -487,6 +583,18 @@ func formatArchivalState(state enums.ArchivalState, uri string) string {
 	return stateStr
 }
 
+// formatReplicationState returns a readable replication state, or "" if the
+// server did not report one.
+func formatReplicationState(state enums.ReplicationState) string {
+	switch state {
+	case enums.REPLICATION_STATE_NORMAL:
+		return "Normal"
+	case enums.REPLICATION_STATE_HANDOVER:
+		return "Handover"
+	}
+	return ""
+}
+
 // ListWorkflows returns workflows for a namespace with optional filtering.
 func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error) {
 	if c.client == nil {
@@ -495,7 +603,7 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 
 	pageSize := opts.PageSize
 	if pageSize <= 0 {
-		pageSize = 100
+		pageSize = DefaultPageSize
 	}
 
 	req := &workflowservice.ListWorkflowExecutionsRequest{
@@ -523,6 +631,9 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 			Namespace: namespace,
 			TaskQueue: exec.GetTaskQueue(),
 			StartTime: exec.GetStartTime().AsTime(),
+
+			HistoryLength:    exec.GetHistoryLength(),
+			HistorySizeBytes: exec.GetHistorySizeBytes(),
 		}
 
 		if exec.GetCloseTime() != nil && !exec.GetCloseTime().AsTime().IsZero() {
@@ -533,6 +644,7 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 		if exec.GetParentExecution() != nil && exec.GetParentExecution().GetWorkflowId() != "" {
 			parentID := exec.GetParentExecution().GetWorkflowId()
 			wf.ParentID = &parentID
+			wf.ParentRunID = exec.GetParentExecution().GetRunId()
 		}
 
 		// Extract memo if present
@@ -545,18 +657,76 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 					if err := json.Unmarshal(v.GetData(), &strVal); err == nil {
 						wf.Memo[k] = strVal
 					} else {
-						wf.Memo[k] = string(v.GetData())
+						wf.Memo[k] = formatPayload(v)
 					}
 				}
 			}
 		}
 
+		wf.SearchAttributes = decodeSearchAttributes(exec.GetSearchAttributes())
+
 		workflows = append(workflows, wf)
 	}
 
 	return workflows, string(resp.GetNextPageToken()), nil
 }
 
+// CountWorkflows counts the workflows matching a query, grouped by status
+// when the server supports GROUP BY. Otherwise, as on standard visibility,
+// it counts the total and the running, completed, and failed workflows with
+// separate requests. Any ORDER BY in the query is ignored.
+func (c *Client) CountWorkflows(ctx context.Context, namespace, query string) (*WorkflowCounts, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	count := func(q string) (*workflowservice.CountWorkflowExecutionsResponse, error) {
+		return c.client.WorkflowService().CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: namespace,
+			Query:     q,
+		})
+	}
+
+	// Ordering does not change a count, and GROUP BY cannot follow ORDER BY
+	if i := strings.Index(strings.ToUpper(query), "ORDER BY"); i >= 0 {
+		query = strings.TrimSpace(query[:i])
+	}
+
+	grouped := strings.TrimSpace(query + " GROUP BY ExecutionStatus")
+	if resp, err := count(grouped); err == nil {
+		counts := &WorkflowCounts{Total: resp.GetCount(), ByStatus: make(map[string]int64)}
+		for _, group := range resp.GetGroups() {
+			if len(group.GetGroupValues()) == 0 {
+				continue
+			}
+			var status string
+			if err := json.Unmarshal(group.GetGroupValues()[0].GetData(), &status); err != nil {
+				continue
+			}
+			counts.ByStatus[status] += group.GetCount()
+		}
+		return counts, nil
+	}
+
+	resp, err := count(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count workflows: %w", err)
+	}
+	counts := &WorkflowCounts{Total: resp.GetCount(), ByStatus: make(map[string]int64)}
+	for _, status := range []string{"Running", "Completed", "Failed"} {
+		q := fmt.Sprintf("ExecutionStatus = '%s'", status)
+		if query != "" {
+			q = fmt.Sprintf("(%s) AND %s", query, q)
+		}
+		resp, err := count(q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s workflows: %w", strings.ToLower(status), err)
+		}
+		counts.ByStatus[status] = resp.GetCount()
+	}
+	return counts, nil
+}
+
 // GetWorkflow returns details for a specific workflow execution.
 func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*Workflow, error) {
 	if c.client == nil {
@@ -583,6 +753,9 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 		Namespace: namespace,
 		TaskQueue: info.GetTaskQueue(),
 		StartTime: info.GetStartTime().AsTime(),
+
+		HistoryLength:    info.GetHistoryLength(),
+		HistorySizeBytes: info.GetHistorySizeBytes(),
 	}
 
 	if info.GetCloseTime() != nil && !info.GetCloseTime().AsTime().IsZero() {
@@ -593,6 +766,37 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 	if info.GetParentExecution() != nil && info.GetParentExecution().GetWorkflowId() != "" {
 		parentID := info.GetParentExecution().GetWorkflowId()
 		wf.ParentID = &parentID
+		wf.ParentRunID = info.GetParentExecution().GetRunId()
+	}
+
+	wf.SearchAttributes = decodeSearchAttributes(info.GetSearchAttributes())
+
+	for _, pa := range resp.GetPendingActivities() {
+		wf.PendingActivities = append(wf.PendingActivities, convertPendingActivity(pa))
+	}
+	for _, pc := range resp.GetPendingChildren() {
+		wf.PendingChildren = append(wf.PendingChildren, PendingChild{
+			WorkflowID:       pc.GetWorkflowId(),
+			RunID:            pc.GetRunId(),
+			WorkflowType:     pc.GetWorkflowTypeName(),
+			InitiatedEventID: pc.GetInitiatedId(),
+		})
+	}
+	for _, op := range resp.GetPendingNexusOperations() {
+		nexusOp := PendingNexusOperation{
+			Endpoint:         op.GetEndpoint(),
+			Service:          op.GetService(),
+			Operation:        op.GetOperation(),
+			State:            formatEventType(strings.TrimPrefix(op.GetState().String(), "PENDING_NEXUS_OPERATION_STATE_")),
+			Attempt:          op.GetAttempt(),
+			ScheduledEventID: op.GetScheduledEventId(),
+			LastFailure:      op.GetLastAttemptFailure().GetMessage(),
+			BlockedReason:    op.GetBlockedReason(),
+		}
+		if op.GetScheduledTime() != nil {
+			nexusOp.ScheduledTime = op.GetScheduledTime().AsTime()
+		}
+		wf.PendingNexusOperations = append(wf.PendingNexusOperations, nexusOp)
 	}
 
 	// Note: Input/Output are populated separately from event history
@@ -601,6 +805,36 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 	return wf, nil
 }
 
+// convertPendingActivity converts a pending activity from DescribeWorkflowExecution.
+func convertPendingActivity(pa *workflowpb.PendingActivityInfo) PendingActivity {
+	activity := PendingActivity{
+		ActivityID:         pa.GetActivityId(),
+		ActivityType:       pa.GetActivityType().GetName(),
+		State:              formatEventType(strings.TrimPrefix(pa.GetState().String(), "PENDING_ACTIVITY_STATE_")),
+		Attempt:            pa.GetAttempt(),
+		MaximumAttempts:    pa.GetMaximumAttempts(),
+		LastFailure:        pa.GetLastFailure().GetMessage(),
+		LastWorkerIdentity: pa.GetLastWorkerIdentity(),
+		HeartbeatDetails:   formatPayloads(pa.GetHeartbeatDetails()),
+	}
+	if pa.GetScheduledTime() != nil {
+		activity.ScheduledTime = pa.GetScheduledTime().AsTime()
+	}
+	if pa.GetLastStartedTime() != nil && !pa.GetLastStartedTime().AsTime().IsZero() {
+		t := pa.GetLastStartedTime().AsTime()
+		activity.LastStartedTime = &t
+	}
+	if pa.GetNextAttemptScheduleTime() != nil && !pa.GetNextAttemptScheduleTime().AsTime().IsZero() {
+		t := pa.GetNextAttemptScheduleTime().AsTime()
+		activity.NextAttemptTime = &t
+	}
+	if pa.GetLastHeartbeatTime() != nil && !pa.GetLastHeartbeatTime().AsTime().IsZero() {
+		t := pa.GetLastHeartbeatTime().AsTime()
+		activity.LastHeartbeatTime = &t
+	}
+	return activity
+}
+
 // GetWorkflowHistory returns the event history for a workflow execution.
 func (c *Client) GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]HistoryEvent, error) {
 	if c.client == nil {
@@ -643,10 +877,14 @@ func (c *Client) GetWorkflowHistory(ctx context.Context, namespace, workflowID,
 }
 
 // GetEnhancedWorkflowHistory returns event history with relational data for tree/timeline views.
+// The history of a specific run is cached, so reloading it only fetches new events.
 func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
 	if c.client == nil {
 		return nil, fmt.Errorf("client not connected")
 	}
+	if runID != "" {
+		return c.getCachedEnhancedHistory(ctx, namespace, workflowID, runID)
+	}
 
 	var events []EnhancedHistoryEvent
 	var nextPageToken []byte
@@ -661,6 +899,9 @@ func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, work
 			NextPageToken: nextPageToken,
 		})
 		if err != nil {
+			if len(events) > 0 {
+				return events, &HistoryIncompleteError{Loaded: len(events), Err: err}
+			}
 			return nil, fmt.Errorf("failed to get workflow history: %w", err)
 		}
 
@@ -678,13 +919,133 @@ func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, work
 	return events, nil
 }
 
+// GetRecentWorkflowHistory retrieves up to limit of the most recent events by
+// paging the history backwards, and returns them oldest first.
+func (c *Client) GetRecentWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, limit int) ([]EnhancedHistoryEvent, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	var events []EnhancedHistoryEvent
+	var nextPageToken []byte
+
+	for len(events) < limit {
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistoryReverse(ctx, &workflowservice.GetWorkflowExecutionHistoryReverseRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			MaximumPageSize: int32(min(limit-len(events), 1000)),
+			NextPageToken:   nextPageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow history: %w", err)
+		}
+
+		for _, event := range resp.GetHistory().GetEvents() {
+			events = append(events, extractEnhancedEvent(event))
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	slices.Reverse(events)
+	return events, nil
+}
+
+// GetWorkflowStartEvent fetches only the first page of a run's history, one
+// event long, which is always its WorkflowExecutionStarted event.
+func (c *Client) GetWorkflowStartEvent(ctx context.Context, namespace, workflowID, runID string) (*EnhancedHistoryEvent, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow history: %w", err)
+	}
+
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 || events[0].GetEventType() != enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+		return nil, fmt.Errorf("start event not found for workflow %s", workflowID)
+	}
+	event := extractEnhancedEvent(events[0])
+	return &event, nil
+}
+
+// GetWorkflowHistoryJSON returns the full event history as indented JSON.
+func (c *Client) GetWorkflowHistoryJSON(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	return c.ExportWorkflowHistory(ctx, namespace, workflowID, runID, HistoryFormatJSON)
+}
+
+// ExportWorkflowHistory pages through the full event history and encodes it
+// in format.
+func (c *Client) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, format HistoryFormat) ([]byte, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	history := &historypb.History{}
+	var nextPageToken []byte
+
+	for {
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow history: %w", err)
+		}
+		history.Events = append(history.Events, resp.GetHistory().GetEvents()...)
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	var data []byte
+	var err error
+	switch format {
+	case HistoryFormatJSON:
+		data, err = temporalproto.CustomJSONMarshalOptions{Indent: "  "}.Marshal(history)
+	case HistoryFormatProto:
+		data, err = proto.Marshal(history)
+	default:
+		return nil, fmt.Errorf("unknown history format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workflow history: %w", err)
+	}
+	return data, nil
+}
+
 // extractEnhancedEvent extracts structured data from a history event for tree/timeline views.
 func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 	he := EnhancedHistoryEvent{
-		ID:      event.GetEventId(),
-		Type:    formatEventType(event.GetEventType().String()),
-		Time:    event.GetEventTime().AsTime(),
-		Details: extractEventDetails(event),
+		ID:       event.GetEventId(),
+		Type:     formatEventType(event.GetEventType().String()),
+		Time:     event.GetEventTime().AsTime(),
+		Details:  extractEventDetails(event),
+		Payloads: payloadData(eventPayloads(event)),
 	}
 
 	switch event.GetEventType() {
@@ -701,6 +1062,9 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			if attrs.GetInput() != nil {
 				he.Input = formatPayloads(attrs.GetInput())
 			}
+			he.SearchAttributes = decodeSearchAttributes(attrs.GetSearchAttributes())
+			he.ContinuedExecutionRunID = attrs.GetContinuedExecutionRunId()
+			he.FirstExecutionRunID = attrs.GetFirstExecutionRunId()
 		}
 
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
@@ -723,13 +1087,46 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
 		attrs := event.GetWorkflowExecutionTerminatedEventAttributes()
-		if attrs != nil && attrs.GetReason() != "" {
-			he.Failure = attrs.GetReason()
+		if attrs != nil {
+			if attrs.GetReason() != "" {
+				he.Failure = attrs.GetReason()
+			}
+			he.Identity = attrs.GetIdentity()
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_OPTIONS_UPDATED:
+		if attrs := event.GetWorkflowExecutionOptionsUpdatedEventAttributes(); attrs != nil {
+			he.Identity = attrs.GetIdentity()
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_PAUSED:
+		if attrs := event.GetWorkflowExecutionPausedEventAttributes(); attrs != nil {
+			he.Identity = attrs.GetIdentity()
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UNPAUSED:
+		if attrs := event.GetWorkflowExecutionUnpausedEventAttributes(); attrs != nil {
+			he.Identity = attrs.GetIdentity()
 		}
 
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
 		he.Failure = "Workflow timed out"
 
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		attrs := event.GetWorkflowExecutionContinuedAsNewEventAttributes()
+		if attrs != nil {
+			he.NewExecutionRunID = attrs.GetNewExecutionRunId()
+			if attrs.GetTaskQueue() != nil {
+				he.TaskQueue = attrs.GetTaskQueue().GetName()
+			}
+			if attrs.GetInput() != nil {
+				he.Input = formatPayloads(attrs.GetInput())
+			}
+			if attrs.GetFailure() != nil {
+				populateFailureDetails(&he, attrs.GetFailure())
+			}
+		}
+
 	case enums.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
 		attrs := event.GetWorkflowTaskScheduledEventAttributes()
 		if attrs != nil && attrs.GetTaskQueue() != nil {
@@ -838,6 +1235,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 		attrs := event.GetTimerStartedEventAttributes()
 		if attrs != nil {
 			he.TimerID = attrs.GetTimerId()
+			he.StartToFireTimeout = attrs.GetStartToFireTimeout().AsDuration()
 		}
 
 	case enums.EVENT_TYPE_TIMER_FIRED:
@@ -935,10 +1333,63 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			}
 		}
 
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		attrs := event.GetWorkflowExecutionSignaledEventAttributes()
+		if attrs != nil {
+			he.SignalName = attrs.GetSignalName()
+			he.Identity = attrs.GetIdentity()
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+		attrs := event.GetWorkflowExecutionUpdateAdmittedEventAttributes()
+		if attrs != nil {
+			populateUpdateRequest(&he, attrs.GetRequest())
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		attrs := event.GetWorkflowExecutionUpdateAcceptedEventAttributes()
+		if attrs != nil {
+			populateUpdateRequest(&he, attrs.GetAcceptedRequest())
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_REJECTED:
+		attrs := event.GetWorkflowExecutionUpdateRejectedEventAttributes()
+		if attrs != nil {
+			populateUpdateRequest(&he, attrs.GetRejectedRequest())
+			if attrs.GetFailure() != nil {
+				populateFailureDetails(&he, attrs.GetFailure())
+			}
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+		attrs := event.GetWorkflowExecutionUpdateCompletedEventAttributes()
+		if attrs != nil {
+			he.UpdateID = attrs.GetMeta().GetUpdateId()
+			if result := attrs.GetOutcome().GetSuccess(); result != nil {
+				he.Result = formatPayloads(result)
+			}
+			if failure := attrs.GetOutcome().GetFailure(); failure != nil {
+				populateFailureDetails(&he, failure)
+			}
+		}
+
+	case enums.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		attrs := event.GetUpsertWorkflowSearchAttributesEventAttributes()
+		if attrs != nil {
+			he.SearchAttributes = decodeUpsertedSearchAttributes(attrs.GetSearchAttributes())
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		attrs := event.GetWorkflowExecutionCancelRequestedEventAttributes()
+		if attrs != nil {
+			he.Identity = attrs.GetIdentity()
+		}
+
 	case enums.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
 		attrs := event.GetSignalExternalWorkflowExecutionInitiatedEventAttributes()
 		if attrs != nil && attrs.GetWorkflowExecution() != nil {
 			he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+			he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 		}
 
 	case enums.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
@@ -947,6 +1398,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 	}
@@ -954,6 +1406,20 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 	return he
 }
 
+// populateUpdateRequest copies the ID, name, input, and requester of a
+// workflow update request.
+func populateUpdateRequest(event *EnhancedHistoryEvent, req *updatepb.Request) {
+	if req == nil {
+		return
+	}
+	event.UpdateID = req.GetMeta().GetUpdateId()
+	event.Identity = req.GetMeta().GetIdentity()
+	event.UpdateName = req.GetInput().GetName()
+	if args := req.GetInput().GetArgs(); args != nil {
+		event.Input = formatPayloads(args)
+	}
+}
+
 func populateFailureDetails(event *EnhancedHistoryEvent, failure *failurepb.Failure) {
 	if failure == nil {
 		return
@@ -961,30 +1427,8 @@ func populateFailureDetails(event *EnhancedHistoryEvent, failure *failurepb.Fail
 	event.Failure = failure.GetMessage()
 	event.FailureSource = failure.GetSource()
 	event.FailureStackTrace = failure.GetStackTrace()
-	event.FailureCause = formatFailureCause(failure.GetCause())
-}
-
-func formatFailureCause(failure *failurepb.Failure) string {
-	if failure == nil {
-		return ""
-	}
-
-	var parts []string
-	for f := failure; f != nil; f = f.GetCause() {
-		var line strings.Builder
-		if f.GetSource() != "" {
-			line.WriteString(f.GetSource())
-			line.WriteString(": ")
-		}
-		line.WriteString(f.GetMessage())
-		if f.GetStackTrace() != "" {
-			line.WriteString("\n")
-			line.WriteString(f.GetStackTrace())
-		}
-		parts = append(parts, line.String())
-	}
-
-	return strings.Join(parts, "\n\nCaused by: ")
+	event.FailureChain = buildFailureChain(failure)
+	event.FailureCause = FormatFailureChain(event.FailureChain[1:])
 }
 
 // formatEventType cleans up the event type string for display
@@ -1010,6 +1454,7 @@ func formatEventType(eventType string) string {
 // extractEventDetails extracts a verbose summary string from a history event.
 func extractEventDetails(event *historypb.HistoryEvent) string {
 	var details []string
+	eventType := formatEventType(event.GetEventType().String())
 
 	switch event.GetEventType() {
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
@@ -1039,6 +1484,9 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			if attrs.GetAttempt() > 1 {
 				details = append(details, fmt.Sprintf("Attempt: %d", attrs.GetAttempt()))
 			}
+			if sa := decodeSearchAttributes(attrs.GetSearchAttributes()); len(sa) > 0 {
+				details = append(details, fmt.Sprintf("SearchAttributes: {%s}", formatSearchAttributeList(sa)))
+			}
 		}
 
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
@@ -1053,13 +1501,8 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 		attrs := event.GetWorkflowExecutionFailedEventAttributes()
 		if attrs != nil {
 			if attrs.GetFailure() != nil {
-				details = append(details, fmt.Sprintf("Failure: %s", attrs.GetFailure().GetMessage()))
-				if attrs.GetFailure().GetStackTrace() != "" {
-					// Truncate stack trace for display
-					trace := attrs.GetFailure().GetStackTrace()
-					if len(trace) > 200 {
-						trace = trace[:200] + "..."
-					}
+				details = append(details, fmt.Sprintf("Failure: %s", failureDetail(eventType, attrs.GetFailure())))
+				if trace := rootStackTrace(attrs.GetFailure()); trace != "" {
 					details = append(details, fmt.Sprintf("StackTrace: %s", trace))
 				}
 			}
@@ -1072,6 +1515,25 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			details = append(details, fmt.Sprintf("RetryState: %s", attrs.GetRetryState().String()))
 		}
 
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		attrs := event.GetWorkflowExecutionContinuedAsNewEventAttributes()
+		if attrs != nil {
+			details = append(details, fmt.Sprintf("NewExecutionRunId: %s", attrs.GetNewExecutionRunId()))
+			if attrs.GetWorkflowType() != nil {
+				details = append(details, fmt.Sprintf("WorkflowType: %s", attrs.GetWorkflowType().GetName()))
+			}
+			if attrs.GetTaskQueue() != nil {
+				details = append(details, fmt.Sprintf("TaskQueue: %s", attrs.GetTaskQueue().GetName()))
+			}
+			if attrs.GetInput() != nil {
+				details = append(details, fmt.Sprintf("Input: %s", formatPayloads(attrs.GetInput())))
+			}
+			if attrs.GetBackoffStartInterval() != nil {
+				details = append(details, fmt.Sprintf("Backoff: %s", attrs.GetBackoffStartInterval().AsDuration()))
+			}
+			details = append(details, fmt.Sprintf("Initiator: %s", attrs.GetInitiator().String()))
+		}
+
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
 		attrs := event.GetWorkflowExecutionCanceledEventAttributes()
 		if attrs != nil {
@@ -1135,7 +1597,7 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			details = append(details, fmt.Sprintf("ScheduledEventId: %d", attrs.GetScheduledEventId()))
 			details = append(details, fmt.Sprintf("Cause: %s", attrs.GetCause().String()))
 			if attrs.GetFailure() != nil {
-				details = append(details, fmt.Sprintf("Failure: %s", attrs.GetFailure().GetMessage()))
+				details = append(details, fmt.Sprintf("Failure: %s", failureDetail(eventType, attrs.GetFailure())))
 			}
 		}
 
@@ -1198,7 +1660,7 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			details = append(details, fmt.Sprintf("ScheduledEventId: %d", attrs.GetScheduledEventId()))
 			details = append(details, fmt.Sprintf("StartedEventId: %d", attrs.GetStartedEventId()))
 			if attrs.GetFailure() != nil {
-				details = append(details, fmt.Sprintf("Failure: %s", attrs.GetFailure().GetMessage()))
+				details = append(details, fmt.Sprintf("Failure: %s", failureDetail(eventType, attrs.GetFailure())))
 			}
 			details = append(details, fmt.Sprintf("RetryState: %s", attrs.GetRetryState().String()))
 		}
@@ -1273,13 +1735,24 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			}
 		}
 
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+		attrs := event.GetWorkflowExecutionUpdateAdmittedEventAttributes()
+		if attrs != nil {
+			details = append(details, updateRequestDetails(attrs.GetRequest())...)
+		}
+
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
 		attrs := event.GetWorkflowExecutionUpdateAcceptedEventAttributes()
 		if attrs != nil {
-			if attrs.GetAcceptedRequest() != nil {
-				if attrs.GetAcceptedRequest().GetMeta() != nil {
-					details = append(details, fmt.Sprintf("UpdateId: %s", attrs.GetAcceptedRequest().GetMeta().GetUpdateId()))
-				}
+			details = append(details, updateRequestDetails(attrs.GetAcceptedRequest())...)
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_REJECTED:
+		attrs := event.GetWorkflowExecutionUpdateRejectedEventAttributes()
+		if attrs != nil {
+			details = append(details, updateRequestDetails(attrs.GetRejectedRequest())...)
+			if attrs.GetFailure() != nil {
+				details = append(details, fmt.Sprintf("Rejection: %s", attrs.GetFailure().GetMessage()))
 			}
 		}
 
@@ -1289,6 +1762,12 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			if attrs.GetMeta() != nil {
 				details = append(details, fmt.Sprintf("UpdateId: %s", attrs.GetMeta().GetUpdateId()))
 			}
+			if result := attrs.GetOutcome().GetSuccess(); result != nil {
+				details = append(details, fmt.Sprintf("Result: %s", formatPayloads(result)))
+			}
+			if failure := attrs.GetOutcome().GetFailure(); failure != nil {
+				details = append(details, fmt.Sprintf("Failure: %s", failure.GetMessage()))
+			}
 		}
 
 	case enums.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
@@ -1340,7 +1819,7 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 				details = append(details, fmt.Sprintf("WorkflowId: %s", attrs.GetWorkflowExecution().GetWorkflowId()))
 			}
 			if attrs.GetFailure() != nil {
-				details = append(details, fmt.Sprintf("Failure: %s", attrs.GetFailure().GetMessage()))
+				details = append(details, fmt.Sprintf("Failure: %s", failureDetail(eventType, attrs.GetFailure())))
 			}
 			details = append(details, fmt.Sprintf("InitiatedEventId: %d", attrs.GetInitiatedEventId()))
 		}
@@ -1403,6 +1882,12 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			}
 		}
 
+	case enums.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		attrs := event.GetUpsertWorkflowSearchAttributesEventAttributes()
+		if attrs != nil {
+			details = append(details, formatSearchAttributeList(decodeUpsertedSearchAttributes(attrs.GetSearchAttributes())))
+		}
+
 	default:
 		// For unhandled event types, return event type name
 		details = append(details, fmt.Sprintf("EventType: %s", event.GetEventType().String()))
@@ -1411,6 +1896,10 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 	return strings.Join(details, ", ")
 }
 
+// payloadPreviewLen caps the raw text or encoded bytes shown for a payload
+// that is not JSON.
+const payloadPreviewLen = 100
+
 // formatPayloads formats payloads for display
 func formatPayloads(payloads *commonpb.Payloads) string {
 	if payloads == nil {
@@ -1422,30 +1911,122 @@ func formatPayloads(payloads *commonpb.Payloads) string {
 		if p == nil {
 			continue
 		}
-		data := p.GetData()
-		if len(data) == 0 {
-			continue
+		if s := formatPayload(p); s != "" {
+			results = append(results, s)
 		}
+	}
 
-		// Try to parse as JSON for nicer display
+	return strings.Join(results, ", ")
+}
+
+// formatPayload renders one payload according to its "encoding" metadata:
+// JSON encodings as compact JSON, binary/null as <null>, and other binary
+// encodings as their length and a base64 preview. Payloads without an
+// encoding are shown as JSON if they parse, else as text.
+func formatPayload(p *commonpb.Payload) string {
+	data := p.GetData()
+	encoding := string(p.GetMetadata()["encoding"])
+
+	switch {
+	case encoding == "binary/null":
+		return "<null>"
+	case len(data) == 0:
+		return ""
+	case strings.HasPrefix(encoding, "binary/"):
+		label := encoding
+		if messageType := string(p.GetMetadata()["messageType"]); messageType != "" {
+			label += " " + messageType
+		}
+		preview := base64.StdEncoding.EncodeToString(data)
+		if len(preview) > payloadPreviewLen {
+			preview = preview[:payloadPreviewLen] + "..."
+		}
+		return fmt.Sprintf("<%s, %d bytes> %s", label, len(data), preview)
+	case encoding == "" || strings.HasPrefix(encoding, "json/"):
+		// Format as compact JSON
 		var jsonVal interface{}
 		if err := json.Unmarshal(data, &jsonVal); err == nil {
-			// Format as compact JSON
 			if b, err := json.Marshal(jsonVal); err == nil {
-				results = append(results, string(b))
-				continue
+				return string(b)
 			}
 		}
+	}
 
-		// Fall back to raw string (truncated)
-		s := string(data)
-		if len(s) > 100 {
-			s = s[:100] + "..."
-		}
-		results = append(results, s)
+	// Fall back to raw string (truncated)
+	s := string(data)
+	if len(s) > payloadPreviewLen {
+		s = s[:payloadPreviewLen] + "..."
 	}
+	return s
+}
 
-	return strings.Join(results, ", ")
+// eventPayloads returns the input, result, or details payloads an event
+// carries, or nil if it has none.
+func eventPayloads(event *historypb.HistoryEvent) *commonpb.Payloads {
+	switch event.GetEventType() {
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		return event.GetWorkflowExecutionStartedEventAttributes().GetInput()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return event.GetWorkflowExecutionCompletedEventAttributes().GetResult()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetInput()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return event.GetWorkflowExecutionCanceledEventAttributes().GetDetails()
+	case enums.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		return event.GetActivityTaskScheduledEventAttributes().GetInput()
+	case enums.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		return event.GetActivityTaskCompletedEventAttributes().GetResult()
+	case enums.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		return event.GetActivityTaskCanceledEventAttributes().GetDetails()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return event.GetWorkflowExecutionSignaledEventAttributes().GetInput()
+	case enums.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		return event.GetStartChildWorkflowExecutionInitiatedEventAttributes().GetInput()
+	case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		return event.GetChildWorkflowExecutionCompletedEventAttributes().GetResult()
+	case enums.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return event.GetSignalExternalWorkflowExecutionInitiatedEventAttributes().GetInput()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+		return event.GetWorkflowExecutionUpdateAdmittedEventAttributes().GetRequest().GetInput().GetArgs()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		return event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest().GetInput().GetArgs()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+		return event.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome().GetSuccess()
+	}
+	return nil
+}
+
+// updateRequestDetails describes a workflow update request for the event
+// details line.
+func updateRequestDetails(req *updatepb.Request) []string {
+	if req == nil {
+		return nil
+	}
+	var details []string
+	if name := req.GetInput().GetName(); name != "" {
+		details = append(details, fmt.Sprintf("UpdateName: %s", name))
+	}
+	if id := req.GetMeta().GetUpdateId(); id != "" {
+		details = append(details, fmt.Sprintf("UpdateId: %s", id))
+	}
+	if args := req.GetInput().GetArgs(); args != nil {
+		details = append(details, fmt.Sprintf("Input: %s", formatPayloads(args)))
+	}
+	if identity := req.GetMeta().GetIdentity(); identity != "" {
+		details = append(details, fmt.Sprintf("Identity: %s", identity))
+	}
+	return details
+}
+
+// payloadData returns the raw data of each non-empty payload.
+func payloadData(payloads *commonpb.Payloads) [][]byte {
+	var data [][]byte
+	for _, p := range payloads.GetPayloads() {
+		if len(p.GetData()) > 0 {
+			data = append(data, p.GetData())
+		}
+	}
+	return data
 }
 
 // DescribeTaskQueue returns task queue info and active pollers.
@@ -1457,7 +2038,9 @@ func (c *Client) DescribeTaskQueue(ctx context.Context, namespace, taskQueue str
 			Name: taskQueue,
 			Kind: enums.TASK_QUEUE_KIND_NORMAL,
 		},
-		TaskQueueType: enums.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskQueueType:          enums.TASK_QUEUE_TYPE_WORKFLOW,
+		ReportStats:            true,
+		IncludeTaskQueueStatus: true, // Backlog hint from servers without stats
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to describe workflow task queue: %w", err)
@@ -1470,7 +2053,10 @@ func (c *Client) DescribeTaskQueue(ctx context.Context, namespace, taskQueue str
 			Name: taskQueue,
 			Kind: enums.TASK_QUEUE_KIND_NORMAL,
 		},
-		TaskQueueType: enums.TASK_QUEUE_TYPE_ACTIVITY,
+		TaskQueueType:          enums.TASK_QUEUE_TYPE_ACTIVITY,
+		ReportConfig:           true,
+		ReportStats:            true,
+		IncludeTaskQueueStatus: true,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to describe activity task queue: %w", err)
@@ -1498,15 +2084,102 @@ func (c *Client) DescribeTaskQueue(ctx context.Context, namespace, taskQueue str
 	}
 
 	info := &TaskQueueInfo{
-		Name:        taskQueue,
-		Type:        "Combined",
-		PollerCount: len(pollers),
-		Backlog:     0, // Backlog info requires enhanced visibility or approximation
+		Name:            taskQueue,
+		Type:            "Combined",
+		PollerCount:     len(pollers),
+		WorkflowPollers: len(wfResp.GetPollers()),
+		ActivityPollers: len(actResp.GetPollers()),
+		Workflow:        taskQueueStats(wfResp),
+		Activity:        taskQueueStats(actResp),
+	}
+	switch {
+	case info.ActivityPollers == 0 && info.WorkflowPollers > 0:
+		info.Type = TaskQueueTypeWorkflow
+	case info.WorkflowPollers == 0 && info.ActivityPollers > 0:
+		info.Type = TaskQueueTypeActivity
+	}
+	for _, stats := range []*TaskQueueStats{info.Workflow, info.Activity} {
+		if stats != nil {
+			info.Backlog += int(stats.BacklogCount)
+		}
+	}
+	if cfg := actResp.GetConfig(); cfg != nil {
+		info.RateLimit = taskQueueRateLimit(cfg)
 	}
 
 	return info, pollers, nil
 }
 
+// taskQueueStats extracts the backlog and task rates from a describe
+// response, falling back to the backlog hint older servers report instead.
+func taskQueueStats(resp *workflowservice.DescribeTaskQueueResponse) *TaskQueueStats {
+	if stats := resp.GetStats(); stats != nil {
+		return &TaskQueueStats{
+			BacklogCount: stats.GetApproximateBacklogCount(),
+			BacklogAge:   stats.GetApproximateBacklogAge().AsDuration(),
+			AddRate:      stats.GetTasksAddRate(),
+			DispatchRate: stats.GetTasksDispatchRate(),
+			HasRates:     true,
+		}
+	}
+	if status := resp.GetTaskQueueStatus(); status != nil {
+		return &TaskQueueStats{BacklogCount: status.GetBacklogCountHint()}
+	}
+	return nil
+}
+
+// SetTaskQueueRateLimit overrides the activity dispatch rate of a task queue.
+func (c *Client) SetTaskQueueRateLimit(ctx context.Context, namespace, taskQueue string, rps *float32, reason string) (*TaskQueueRateLimit, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	// A missing rate limit removes the override
+	update := &workflowservice.UpdateTaskQueueConfigRequest_RateLimitUpdate{Reason: reason}
+	if rps != nil {
+		update.RateLimit = &taskqueue.RateLimit{RequestsPerSecond: *rps}
+	}
+
+	resp, err := c.client.WorkflowService().UpdateTaskQueueConfig(ctx, &workflowservice.UpdateTaskQueueConfigRequest{
+		Namespace:            namespace,
+		Identity:             "tempo",
+		TaskQueue:            taskQueue,
+		TaskQueueType:        enums.TASK_QUEUE_TYPE_ACTIVITY,
+		UpdateQueueRateLimit: update,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task queue config: %w", err)
+	}
+	return taskQueueRateLimit(resp.GetConfig()), nil
+}
+
+// taskQueueRateLimit converts the queue-wide limit of a task queue config.
+func taskQueueRateLimit(cfg *taskqueue.TaskQueueConfig) *TaskQueueRateLimit {
+	limit := cfg.GetQueueRateLimit()
+	if limit.GetRateLimit() == nil {
+		return &TaskQueueRateLimit{}
+	}
+	rl := &TaskQueueRateLimit{
+		Set:               true,
+		RequestsPerSecond: limit.GetRateLimit().GetRequestsPerSecond(),
+		Reason:            limit.GetMetadata().GetReason(),
+		UpdatedBy:         limit.GetMetadata().GetUpdateIdentity(),
+	}
+	if ts := limit.GetMetadata().GetUpdateTime(); ts != nil {
+		t := ts.AsTime()
+		rl.UpdatedAt = &t
+	}
+	return rl
+}
+
+// retentionDays converts a retention TTL to whole days, returning 0 if unset.
+func retentionDays(d *durationpb.Duration) int {
+	if d == nil {
+		return 0
+	}
+	return int(d.AsDuration().Hours() / 24)
+}
+
 // formatDuration formats a protobuf duration as a human-readable string.
 func formatDuration(d *durationpb.Duration) string {
 	if d == nil {
@@ -1544,11 +2217,128 @@ func (c *Client) SignalWorkflow(ctx context.Context, namespace, workflowID, runI
 	return c.client.SignalWorkflow(ctx, workflowID, runID, signalName, json.RawMessage(input))
 }
 
+// UpdateWorkflow sends an update and waits for it to complete.
+func (c *Client) UpdateWorkflow(ctx context.Context, namespace, workflowID, runID, updateName string, args []byte) (*UpdateResult, error) {
+	opts := client.UpdateWorkflowOptions{
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		UpdateName:   updateName,
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	}
+	if len(args) > 0 {
+		opts.Args = []interface{}{json.RawMessage(args)}
+	}
+
+	// Running out of time is reported as context.DeadlineExceeded, since the
+	// update may have been delivered and still be running
+	handle, err := c.client.UpdateWorkflow(ctx, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("timed out waiting for update: %w", ctx.Err())
+		}
+		return nil, fmt.Errorf("failed to send update: %w", err)
+	}
+
+	res := &UpdateResult{UpdateName: updateName, UpdateID: handle.UpdateID()}
+	var result interface{}
+	if err := handle.Get(ctx, &result); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("timed out waiting for update result: %w", ctx.Err())
+		}
+		// The server delivered the update; the workflow's validator rejected
+		// it or its handler returned an error
+		res.Failure = err.Error()
+		return res, nil
+	}
+
+	if resultJSON, err := json.MarshalIndent(result, "", "  "); err == nil {
+		res.Result = string(resultJSON)
+	} else {
+		res.Result = fmt.Sprintf("%v", result)
+	}
+	return res, nil
+}
+
+// WaitForWorkflowResult blocks until the workflow closes and returns its
+// result, or the failure of a run that did not complete. It long-polls the
+// history for the close event, following continue-as-new to the final run.
+func (c *Client) WaitForWorkflowResult(ctx context.Context, namespace, workflowID, runID string) (*WorkflowResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	for {
+		event, err := c.waitForCloseEvent(ctx, namespace, workflowID, runID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for workflow result: %w", err)
+		}
+
+		he := extractEnhancedEvent(event)
+		switch event.GetEventType() {
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+			return &WorkflowResult{Result: he.Result}, nil
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+			runID = he.NewExecutionRunID
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+			return &WorkflowResult{Failure: "Workflow canceled"}, nil
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+			failure := "Workflow terminated"
+			if he.Failure != "" {
+				failure += ": " + he.Failure
+			}
+			return &WorkflowResult{Failure: failure}, nil
+		default:
+			return &WorkflowResult{Failure: he.Failure}, nil
+		}
+	}
+}
+
+// waitForCloseEvent long-polls a run's history until the server returns its
+// close event.
+func (c *Client) waitForCloseEvent(ctx context.Context, namespace, workflowID, runID string) (*historypb.HistoryEvent, error) {
+	var token []byte
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, historyLongPollTimeout)
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(pollCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken:          token,
+			WaitNewEvent:           true,
+			HistoryEventFilterType: enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+		})
+		timedOut := pollCtx.Err() != nil
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if timedOut {
+				continue
+			}
+			return nil, err
+		}
+
+		if events := resp.GetHistory().GetEvents(); len(events) > 0 {
+			return events[len(events)-1], nil
+		}
+		token = resp.GetNextPageToken()
+	}
+}
+
 // StartWorkflow starts a new workflow execution.
 func (c *Client) StartWorkflow(ctx context.Context, namespace string, req StartWorkflowRequest) (string, error) {
 	opts := client.StartWorkflowOptions{
-		ID:        req.WorkflowID,
-		TaskQueue: req.TaskQueue,
+		ID:                       req.WorkflowID,
+		TaskQueue:                req.TaskQueue,
+		CronSchedule:             req.CronSchedule,
+		WorkflowExecutionTimeout: req.ExecutionTimeout,
+		WorkflowRunTimeout:       req.RunTimeout,
+	}
+	if req.MaxAttempts > 0 {
+		opts.RetryPolicy = &sdktemporal.RetryPolicy{MaximumAttempts: req.MaxAttempts}
 	}
 
 	args := []interface{}{}
@@ -1599,7 +2389,17 @@ func (c *Client) DeleteWorkflow(ctx context.Context, namespace, workflowID, runI
 }
 
 // ResetWorkflow resets a workflow to a previous state, creating a new run.
-func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error) {
+func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string, exclude []ResetReapplyExclude) (string, error) {
+	var excludeTypes []enums.ResetReapplyExcludeType
+	for _, kind := range exclude {
+		switch kind {
+		case ResetExcludeSignals:
+			excludeTypes = append(excludeTypes, enums.RESET_REAPPLY_EXCLUDE_TYPE_SIGNAL)
+		case ResetExcludeUpdates:
+			excludeTypes = append(excludeTypes, enums.RESET_REAPPLY_EXCLUDE_TYPE_UPDATE)
+		}
+	}
+
 	resp, err := c.client.WorkflowService().ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
 		Namespace: namespace,
 		WorkflowExecution: &commonpb.WorkflowExecution{
@@ -1608,6 +2408,7 @@ func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID
 		},
 		Reason:                    reason,
 		WorkflowTaskFinishEventId: eventID,
+		ResetReapplyExcludeTypes:  excludeTypes,
 	})
 	if err != nil {
 		return "", err
@@ -1615,11 +2416,34 @@ func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID
 	return resp.GetRunId(), nil
 }
 
+// RequestActivityCancel pauses a pending activity so it stops retrying.
+// See Provider.RequestActivityCancel for the exact semantics.
+func (c *Client) RequestActivityCancel(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+
+	_, err := c.client.WorkflowService().PauseActivity(ctx, &workflowservice.PauseActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Identity: "tempo",
+		Activity: &workflowservice.PauseActivityRequest_Id{Id: activityID},
+		Reason:   reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pause activity: %w", err)
+	}
+	return nil
+}
+
 // ListSchedules returns all schedules in a namespace.
 func (c *Client) ListSchedules(ctx context.Context, namespace string, opts ListOptions) ([]Schedule, string, error) {
 	pageSize := opts.PageSize
 	if pageSize <= 0 {
-		pageSize = 100
+		pageSize = DefaultPageSize
 	}
 
 	resp, err := c.client.ScheduleClient().List(ctx, client.ScheduleListOptions{
@@ -1647,6 +2471,7 @@ func (c *Client) ListSchedules(ctx context.Context, namespace string, opts ListO
 		// Extract spec info
 		if entry.Spec != nil {
 			schedule.Spec = formatScheduleSpec(entry.Spec)
+			schedule.Cron, schedule.Interval = firstScheduleSpec(entry.Spec)
 		}
 
 		// Recent and future actions
@@ -1695,6 +2520,10 @@ func (c *Client) GetSchedule(ctx context.Context, namespace, scheduleID string)
 	// Extract spec info
 	if desc.Schedule.Spec != nil {
 		schedule.Spec = formatScheduleSpec(desc.Schedule.Spec)
+		schedule.Cron, schedule.Interval = firstScheduleSpec(desc.Schedule.Spec)
+	}
+	if desc.Schedule.Policy != nil {
+		schedule.OverlapPolicy = formatOverlapPolicy(desc.Schedule.Policy.Overlap)
 	}
 
 	// Info from description
@@ -1741,6 +2570,111 @@ func (c *Client) DeleteSchedule(ctx context.Context, namespace, scheduleID strin
 	return handle.Delete(ctx)
 }
 
+// CreateSchedule creates a schedule that starts a workflow on a cron or
+// interval spec.
+func (c *Client) CreateSchedule(ctx context.Context, namespace string, req ScheduleCreateRequest) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+	if (req.Cron == "") == (req.Interval == 0) {
+		return fmt.Errorf("set either a cron expression or an interval")
+	}
+	overlap, err := parseOverlapPolicy(req.OverlapPolicy)
+	if err != nil {
+		return err
+	}
+
+	workflowID := req.WorkflowID
+	if workflowID == "" {
+		workflowID = req.ScheduleID
+	}
+	args := []interface{}{}
+	if len(req.Input) > 0 {
+		args = append(args, json.RawMessage(req.Input))
+	}
+
+	_, err = c.client.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID:   req.ScheduleID,
+		Spec: *scheduleSpec(req.Cron, req.Interval),
+		Action: &client.ScheduleWorkflowAction{
+			ID:        workflowID,
+			Workflow:  req.WorkflowType,
+			Args:      args,
+			TaskQueue: req.TaskQueue,
+		},
+		Overlap: overlap,
+		Paused:  req.Paused,
+		Note:    req.Note,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+	return nil
+}
+
+// UpdateSchedule replaces a schedule's spec, if one is given, and sets
+// whether it is paused. The rest of the schedule is left as it is.
+func (c *Client) UpdateSchedule(ctx context.Context, namespace, scheduleID string, req ScheduleUpdateRequest) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+	if req.Cron != "" && req.Interval != 0 {
+		return fmt.Errorf("set either a cron expression or an interval, not both")
+	}
+
+	handle := c.client.ScheduleClient().GetHandle(ctx, scheduleID)
+	err := handle.Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			if req.Cron != "" || req.Interval != 0 {
+				schedule.Spec = scheduleSpec(req.Cron, req.Interval)
+			}
+			if schedule.State == nil {
+				schedule.State = &client.ScheduleState{}
+			}
+			schedule.State.Paused = req.Paused
+			if req.Note != "" {
+				schedule.State.Note = req.Note
+			}
+			return &client.ScheduleUpdate{Schedule: &schedule}, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return nil
+}
+
+// scheduleSpec returns a spec that fires on a cron expression or an interval.
+func scheduleSpec(cron string, interval time.Duration) *client.ScheduleSpec {
+	if cron != "" {
+		return &client.ScheduleSpec{CronExpressions: []string{cron}}
+	}
+	return &client.ScheduleSpec{Intervals: []client.ScheduleIntervalSpec{{Every: interval}}}
+}
+
+// parseOverlapPolicy maps one of ScheduleOverlapPolicies to its enum; empty
+// leaves the server default.
+func parseOverlapPolicy(name string) (enums.ScheduleOverlapPolicy, error) {
+	if name == "" {
+		return enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, nil
+	}
+	policy, err := enums.ScheduleOverlapPolicyFromString(name)
+	if err != nil {
+		return 0, fmt.Errorf("unknown overlap policy %q", name)
+	}
+	return policy, nil
+}
+
+// formatOverlapPolicy returns the name of an overlap policy, as listed in
+// ScheduleOverlapPolicies.
+func formatOverlapPolicy(policy enums.ScheduleOverlapPolicy) string {
+	if policy == enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		return "Skip"
+	}
+	return policy.String()
+}
+
 func convertScheduleRuns(actions []client.ScheduleActionResult) []ScheduleRun {
 	if len(actions) == 0 {
 		return nil
@@ -1762,6 +2696,19 @@ func convertScheduleRuns(actions []client.ScheduleActionResult) []ScheduleRun {
 	return runs
 }
 
+// firstScheduleSpec returns the first cron expression and interval of a spec.
+func firstScheduleSpec(spec *client.ScheduleSpec) (string, time.Duration) {
+	var cron string
+	var interval time.Duration
+	if len(spec.CronExpressions) > 0 {
+		cron = spec.CronExpressions[0]
+	}
+	if len(spec.Intervals) > 0 {
+		interval = spec.Intervals[0].Every
+	}
+	return cron, interval
+}
+
 // formatScheduleSpec creates a human-readable schedule specification.
 func formatScheduleSpec(spec *client.ScheduleSpec) string {
 	if spec == nil {
@@ -1875,6 +2822,82 @@ func (c *Client) TerminateWorkflows(ctx context.Context, namespace string, workf
 	return results, nil
 }
 
+// SignalWorkflows sends the same signal to multiple workflows and returns
+// results for each.
+func (c *Client) SignalWorkflows(ctx context.Context, namespace string, workflows []WorkflowIdentifier, signalName string, input []byte) ([]BatchResult, error) {
+	results := make([]BatchResult, len(workflows))
+
+	for i, wf := range workflows {
+		err := c.SignalWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, signalName, input)
+		results[i] = BatchResult{
+			WorkflowID: wf.WorkflowID,
+			RunID:      wf.RunID,
+			Success:    err == nil,
+		}
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+
+	return results, nil
+}
+
+// resetReapplyExcludeMinVersion is the first server release that honors
+// ResetReapplyExcludeTypes; older servers silently ignore it.
+var resetReapplyExcludeMinVersion = [2]int{1, 24}
+
+// SupportsResetReapplyExclude returns true if the server version is recent
+// enough to honor reset reapply exclusions.
+func (c *Client) SupportsResetReapplyExclude(ctx context.Context) (bool, error) {
+	return c.serverVersionSupports(ctx, resetReapplyExcludeMinVersion)
+}
+
+// taskQueueConfigMinVersion is the first server release that implements
+// UpdateTaskQueueConfig.
+var taskQueueConfigMinVersion = [2]int{1, 29}
+
+// SupportsTaskQueueConfig returns true if the server version is recent enough
+// to change task queue rate limits.
+func (c *Client) SupportsTaskQueueConfig(ctx context.Context) (bool, error) {
+	return c.serverVersionSupports(ctx, taskQueueConfigMinVersion)
+}
+
+// serverVersionSupports reports whether the connected server is at least
+// version want (major, minor).
+func (c *Client) serverVersionSupports(ctx context.Context, want [2]int) (bool, error) {
+	if c.client == nil {
+		return false, fmt.Errorf("client not connected")
+	}
+	resp, err := c.client.WorkflowService().GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{})
+	if err != nil {
+		return false, fmt.Errorf("failed to get system info: %w", err)
+	}
+	return serverVersionAtLeast(resp.GetServerVersion(), want), nil
+}
+
+// serverVersionAtLeast reports whether a server version such as "1.24.2" or
+// "v1.25.0-rc.1" is at least want (major, minor). Unparseable versions are
+// treated as too old.
+func serverVersionAtLeast(version string, want [2]int) bool {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	var got [2]int
+	for i := range got {
+		digits := strings.TrimRightFunc(parts[i], func(r rune) bool { return r < '0' || r > '9' })
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return false
+		}
+		got[i] = n
+	}
+	if got[0] != want[0] {
+		return got[0] > want[0]
+	}
+	return got[1] >= want[1]
+}
+
 // GetResetPoints returns valid reset points for a workflow execution.
 func (c *Client) GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]ResetPoint, error) {
 	// Get workflow history to find reset points
@@ -1971,71 +2994,125 @@ func (c *Client) GetChildWorkflows(ctx context.Context, namespace, workflowID, r
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workflow history: %w", err)
 	}
-	return c.getChildWorkflowsFromEvents(ctx, namespace, events)
+	return c.getChildWorkflowsFromEvents(ctx, namespace, workflowID, runID, events)
 }
 
-// getChildWorkflowsFromEvents extracts child workflows from pre-fetched events.
-// This avoids duplicate history fetches when called from GetWorkflowRelationships.
-func (c *Client) getChildWorkflowsFromEvents(ctx context.Context, namespace string, events []EnhancedHistoryEvent) ([]Workflow, error) {
-	// Track child workflow IDs
-	var childIDs []string
+// childWorkflowPageSize bounds how many children of one workflow are listed
+// through visibility.
+const childWorkflowPageSize = 200
+
+// getChildWorkflowsFromEvents finds the children of a workflow run, combining
+// the children its pre-fetched history started with any that visibility lists
+// under ParentWorkflowId. Visibility catches children the history does not
+// name, while the history covers servers whose visibility has not indexed a
+// child yet. This avoids duplicate history fetches when called from
+// GetWorkflowRelationships.
+func (c *Client) getChildWorkflowsFromEvents(ctx context.Context, namespace, workflowID, runID string, events []EnhancedHistoryEvent) ([]Workflow, error) {
+	type childRef struct {
+		workflowID string
+		runID      string
+	}
+	var refs []childRef
 	seen := make(map[string]bool)
 
 	for _, event := range events {
 		// ChildWorkflowExecutionStarted contains the child's run ID
 		if strings.Contains(event.Type, "ChildWorkflowExecutionStarted") {
 			if event.ChildWorkflowID != "" && !seen[event.ChildWorkflowID] {
-				childIDs = append(childIDs, event.ChildWorkflowID)
+				refs = append(refs, childRef{workflowID: event.ChildWorkflowID, runID: event.ChildRunID})
 				seen[event.ChildWorkflowID] = true
 			}
 		}
 	}
 
-	if len(childIDs) == 0 {
-		return nil, nil
+	// Children as indexed by visibility, keyed by workflow ID. Servers without
+	// the ParentWorkflowId attribute reject the query; fall back to history.
+	indexed := make(map[string]Workflow)
+	var extra []Workflow
+	listed, _, err := c.ListWorkflows(ctx, namespace, ListOptions{
+		PageSize: childWorkflowPageSize,
+		Query:    fmt.Sprintf("ParentWorkflowId = '%s'", workflowID),
+	})
+	if err == nil {
+		for _, wf := range listed {
+			if runID != "" && wf.ParentRunID != "" && wf.ParentRunID != runID {
+				continue
+			}
+			if _, ok := indexed[wf.ID]; ok {
+				continue
+			}
+			indexed[wf.ID] = wf
+			if !seen[wf.ID] {
+				extra = append(extra, wf)
+			}
+		}
+	}
+	slices.SortFunc(extra, func(a, b Workflow) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	if len(refs) == 0 {
+		return extra, nil
 	}
 
-	// Fetch child workflows in parallel (limit concurrency to avoid overwhelming the server)
+	// Fetch children visibility did not list in parallel (limit concurrency
+	// to avoid overwhelming the server)
 	type result struct {
+		index    int
 		workflow Workflow
 		err      error
 	}
 
-	results := make(chan result, len(childIDs))
+	results := make(chan result, len(refs))
 	sem := make(chan struct{}, 5) // Max 5 concurrent requests
 
-	for _, childID := range childIDs {
-		go func(id string) {
+	found := make([]Workflow, len(refs))
+	pending := 0
+	for i, ref := range refs {
+		if wf, ok := indexed[ref.workflowID]; ok {
+			found[i] = wf
+			continue
+		}
+		pending++
+		go func(i int, ref childRef) {
 			sem <- struct{}{}        // Acquire
 			defer func() { <-sem }() // Release
 
-			query := fmt.Sprintf("WorkflowId = '%s'", id)
+			query := fmt.Sprintf("WorkflowId = '%s'", ref.workflowID)
+			if ref.runID != "" {
+				query += fmt.Sprintf(" AND RunId = '%s'", ref.runID)
+			}
 			workflows, _, err := c.ListWorkflows(ctx, namespace, ListOptions{
 				PageSize: 1,
 				Query:    query,
 			})
 			if err != nil {
-				results <- result{err: err}
+				results <- result{index: i, err: err}
 				return
 			}
 			if len(workflows) > 0 {
-				results <- result{workflow: workflows[0]}
+				results <- result{index: i, workflow: workflows[0]}
 			} else {
-				results <- result{}
+				results <- result{index: i}
 			}
-		}(childID)
+		}(i, ref)
 	}
 
 	// Collect results
-	var children []Workflow
-	for range childIDs {
+	for range pending {
 		r := <-results
-		if r.err == nil && r.workflow.ID != "" {
-			children = append(children, r.workflow)
+		if r.err == nil {
+			found[r.index] = r.workflow
 		}
 	}
 
-	return children, nil
+	var children []Workflow
+	for _, wf := range found {
+		if wf.ID != "" {
+			children = append(children, wf)
+		}
+	}
+	return append(children, extra...), nil
 }
 
 // GetWorkflowRelationships returns the complete relationship graph for a workflow.
@@ -2116,7 +3193,7 @@ func (c *Client) GetWorkflowRelationships(ctx context.Context, namespace, workfl
 
 	// Get child workflows with depth control (reuse events, don't fetch again)
 	if depth > 0 {
-		children, err := c.getChildWorkflowsFromEvents(ctx, namespace, events)
+		children, err := c.getChildWorkflowsFromEvents(ctx, namespace, workflowID, current.RunID, events)
 		if err == nil {
 			for _, child := range children {
 				node := &WorkflowNode{
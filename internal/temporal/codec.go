@@ -0,0 +1,63 @@
+package temporal
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/grpc"
+)
+
+// codecTimeout bounds each request to the codec server.
+const codecTimeout = 10 * time.Second
+
+// configureCodec routes payloads through the remote codec server, if one is
+// configured, using the /encode and /decode protocol the Temporal Web UI
+// uses. Payloads in responses are decoded before tempo sees them, and
+// payloads tempo sends, such as signal input, are encoded.
+func configureCodec(opts *client.Options, connConfig ConnectionConfig) error {
+	if connConfig.CodecEndpoint == "" {
+		return nil
+	}
+
+	auth := connConfig.CodecAuth
+	codec := converter.NewRemotePayloadCodec(converter.RemotePayloadCodecOptions{
+		Endpoint: strings.TrimSuffix(connConfig.CodecEndpoint, "/"),
+		ModifyRequest: func(req *http.Request) error {
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+			return nil
+		},
+		Client: http.Client{Timeout: codecTimeout},
+	})
+
+	interceptor, err := converter.NewPayloadCodecGRPCClientInterceptor(converter.PayloadCodecGRPCClientInterceptorOptions{
+		Codecs: []converter.PayloadCodec{fallbackCodec{codec}},
+	})
+	if err != nil {
+		return err
+	}
+	opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions, grpc.WithChainUnaryInterceptor(interceptor))
+	return nil
+}
+
+// fallbackCodec leaves payloads encoded when the codec server cannot decode
+// them, so an unreachable codec shows raw bytes instead of failing the
+// request. Encoding errors are still returned: sending a payload unencoded
+// could hand workers data they cannot read.
+type fallbackCodec struct {
+	converter.PayloadCodec
+}
+
+func (c fallbackCodec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	decoded, err := c.PayloadCodec.Decode(payloads)
+	if err != nil {
+		sdkLogger.Warn("codec server could not decode payloads, showing them encoded", "error", err)
+		return payloads, nil
+	}
+	return decoded, nil
+}
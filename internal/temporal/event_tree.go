@@ -16,6 +16,7 @@ const (
 	GroupTimer
 	GroupChildWorkflow
 	GroupSignal
+	GroupUpdate
 	GroupMarker
 	GroupOther
 )
@@ -35,6 +36,8 @@ func (g EventGroupType) String() string {
 		return "ChildWorkflow"
 	case GroupSignal:
 		return "Signal"
+	case GroupUpdate:
+		return "Update"
 	case GroupMarker:
 		return "Marker"
 	default:
@@ -95,6 +98,9 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 	// Track workflow task groups by ScheduledEventID
 	wfTaskGroups := make(map[int64]*EventTreeNode)
 
+	// Track workflow update groups by UpdateID
+	updateGroups := make(map[string]*EventTreeNode)
+
 	// First pass: identify group roots and build groups
 	for i := range events {
 		ev := &events[i]
@@ -107,7 +113,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 		// Workflow start event
 		case ev.Type == "WorkflowExecutionStarted":
 			node := &EventTreeNode{
-				Name:      "Workflow Started",
+				Name:      withActor("Workflow Started", ev),
 				Type:      GroupWorkflow,
 				Status:    "Running",
 				StartTime: ev.Time,
@@ -116,11 +122,35 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			rootNodes = append(rootNodes, node)
 			processed[ev.ID] = true
 
+		// Workflow update events, grouped by update ID from admission to outcome
+		case strings.HasPrefix(ev.Type, "WorkflowExecutionUpdate"):
+			group, ok := updateGroups[ev.UpdateID]
+			if !ok || ev.UpdateID == "" {
+				group = &EventTreeNode{
+					Name:      withActor(updateNodeName(ev), ev),
+					Type:      GroupUpdate,
+					StartTime: ev.Time,
+				}
+				updateGroups[ev.UpdateID] = group
+				rootNodes = append(rootNodes, group)
+			}
+			group.Events = append(group.Events, ev)
+			group.Status = extractUpdateStatus(ev)
+			if group.Status != "Admitted" && group.Status != "Running" {
+				group.EndTime = &ev.Time
+				group.Duration = ev.Time.Sub(group.StartTime)
+			}
+			processed[ev.ID] = true
+
 		// Workflow terminal events
 		case strings.HasPrefix(ev.Type, "WorkflowExecution") && ev.Type != "WorkflowExecutionStarted" && ev.Type != "WorkflowExecutionSignaled":
 			status := extractWorkflowStatus(ev.Type)
+			name := fmt.Sprintf("Workflow %s", status)
+			if ev.NewExecutionRunID != "" {
+				name = fmt.Sprintf("Continued as new → %s", ev.NewExecutionRunID)
+			}
 			node := &EventTreeNode{
-				Name:      fmt.Sprintf("Workflow %s", status),
+				Name:      withActor(name, ev),
 				Type:      GroupWorkflow,
 				Status:    status,
 				StartTime: ev.Time,
@@ -143,23 +173,13 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 			rootNodes = append(rootNodes, node)
 			processed[ev.ID] = true
 
-		// Activity Started - links to Scheduled
+		// Activity Started - links to Scheduled, one attempt child per start
 		case ev.Type == "ActivityTaskStarted":
 			if group, ok := activityGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = "Running"
-				if ev.Attempt > 1 {
-					group.Attempts = int(ev.Attempt)
-					// Create attempt child node
-					attemptNode := &EventTreeNode{
-						Name:      fmt.Sprintf("Attempt %d", ev.Attempt),
-						Type:      GroupActivity,
-						Status:    "Running",
-						StartTime: ev.Time,
-						Events:    []*EnhancedHistoryEvent{ev},
-					}
-					group.Children = append(group.Children, attemptNode)
-				}
+				group.EndTime = nil
+				addActivityAttempt(group, ev)
 			}
 			processed[ev.ID] = true
 
@@ -172,7 +192,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				group.EndTime = &ev.Time
 				group.Duration = ev.Time.Sub(group.StartTime)
 
-				// Update attempt child if exists
+				// Close the attempt that was running
 				if len(group.Children) > 0 {
 					lastAttempt := group.Children[len(group.Children)-1]
 					lastAttempt.Events = append(lastAttempt.Events, ev)
@@ -275,7 +295,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 		// Signal events
 		case ev.Type == "WorkflowExecutionSignaled":
 			node := &EventTreeNode{
-				Name:      "Signal Received",
+				Name:      withActor("Signal Received", ev),
 				Type:      GroupSignal,
 				Status:    "Received",
 				StartTime: ev.Time,
@@ -314,9 +334,63 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 		}
 	}
 
+	// A single attempt needs no breakdown; retried activities start collapsed
+	// to one row with their attempt count
+	for _, group := range activityGroups {
+		if group.Attempts <= 1 {
+			group.Children = nil
+			continue
+		}
+		group.Collapsed = true
+	}
+
 	return rootNodes
 }
 
+// addActivityAttempt adds an attempt child for an activity started event.
+// Temporal records only the last start of a retried activity, with the
+// previous attempt's failure, so earlier attempts missing from history are
+// shown as one failed child spanning from scheduling to this start.
+func addActivityAttempt(group *EventTreeNode, ev *EnhancedHistoryEvent) {
+	attempt := int(ev.Attempt)
+	if attempt < 1 {
+		attempt = 1
+	}
+	if len(group.Children) == 0 && attempt > 1 {
+		name := "Attempt 1"
+		if attempt > 2 {
+			name = fmt.Sprintf("Attempts 1–%d", attempt-1)
+		}
+		group.Children = append(group.Children, &EventTreeNode{
+			Name:      name,
+			Type:      GroupActivity,
+			Status:    "Failed",
+			StartTime: group.StartTime,
+			EndTime:   &ev.Time,
+			Duration:  ev.Time.Sub(group.StartTime),
+			Events:    []*EnhancedHistoryEvent{ev},
+		})
+	}
+	group.Children = append(group.Children, &EventTreeNode{
+		Name:      fmt.Sprintf("Attempt %d", attempt),
+		Type:      GroupActivity,
+		Status:    "Running",
+		StartTime: ev.Time,
+		Events:    []*EnhancedHistoryEvent{ev},
+	})
+	if attempt > group.Attempts {
+		group.Attempts = attempt
+	}
+}
+
+// withActor appends who requested a client-initiated event to a node name.
+func withActor(name string, ev *EnhancedHistoryEvent) string {
+	if ActionBy(ev) == "" {
+		return name
+	}
+	return name + " · by " + ev.Identity
+}
+
 // extractWorkflowStatus extracts status from workflow terminal event type.
 func extractWorkflowStatus(eventType string) string {
 	switch eventType {
@@ -332,6 +406,38 @@ func extractWorkflowStatus(eventType string) string {
 		return "Terminated"
 	case "WorkflowExecutionContinuedAsNew":
 		return "ContinuedAsNew"
+	case "WorkflowExecutionCancelRequested":
+		return "CancelRequested"
+	default:
+		return "Unknown"
+	}
+}
+
+// updateNodeName names an update node by its handler, or by its ID when
+// the history only has the update's completion.
+func updateNodeName(ev *EnhancedHistoryEvent) string {
+	if ev.UpdateName == "" {
+		return fmt.Sprintf("Update: %s", ev.UpdateID)
+	}
+	return fmt.Sprintf("Update: %s", ev.UpdateName)
+}
+
+// extractUpdateStatus returns the status of a workflow update after one of
+// its events: Admitted, Running once accepted, Completed, Failed if the
+// handler returned an error, or Rejected by the validator.
+func extractUpdateStatus(ev *EnhancedHistoryEvent) string {
+	switch ev.Type {
+	case "WorkflowExecutionUpdateAdmitted":
+		return "Admitted"
+	case "WorkflowExecutionUpdateAccepted":
+		return "Running"
+	case "WorkflowExecutionUpdateRejected":
+		return "Rejected"
+	case "WorkflowExecutionUpdateCompleted":
+		if ev.Failure != "" {
+			return "Failed"
+		}
+		return "Completed"
 	default:
 		return "Unknown"
 	}
@@ -398,3 +504,88 @@ func FormatDuration(d time.Duration) string {
 	}
 	return fmt.Sprintf("%.1fh", d.Hours())
 }
+
+// SummarizeEventTree builds a one-line narrative of a workflow run from its event tree,
+// e.g. "Started → 3 activities → 1 failed → 1 retried → 2 children → Completed in 4.2s".
+// The now argument is used to report elapsed time for runs that are still open.
+func SummarizeEventTree(nodes []*EventTreeNode, now time.Time) string {
+	if len(nodes) == 0 {
+		return ""
+	}
+
+	var (
+		started                     *EventTreeNode
+		terminal                    *EventTreeNode
+		activities, failed, retried int
+		timers, children, signals   int
+		updates                     int
+	)
+	for _, node := range nodes {
+		switch node.Type {
+		case GroupWorkflow:
+			if node.EndTime == nil {
+				started = node
+			} else {
+				terminal = node
+			}
+		case GroupActivity:
+			activities++
+			switch {
+			case node.Status == "Failed" || node.Status == "TimedOut":
+				failed++
+			case node.Attempts > 1:
+				retried++
+			}
+		case GroupTimer:
+			timers++
+		case GroupChildWorkflow:
+			children++
+		case GroupSignal:
+			signals++
+		case GroupUpdate:
+			updates++
+		}
+	}
+
+	parts := []string{"Started"}
+	if activities > 0 {
+		parts = append(parts, pluralize(activities, "activity", "activities"))
+	}
+	if failed > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", failed))
+	}
+	if retried > 0 {
+		parts = append(parts, fmt.Sprintf("%d retried", retried))
+	}
+	if timers > 0 {
+		parts = append(parts, pluralize(timers, "timer", "timers"))
+	}
+	if children > 0 {
+		parts = append(parts, pluralize(children, "child", "children"))
+	}
+	if signals > 0 {
+		parts = append(parts, pluralize(signals, "signal", "signals"))
+	}
+	if updates > 0 {
+		parts = append(parts, pluralize(updates, "update", "updates"))
+	}
+
+	switch {
+	case terminal != nil && started != nil:
+		parts = append(parts, fmt.Sprintf("%s in %s", terminal.Status, FormatDuration(terminal.EndTime.Sub(started.StartTime))))
+	case terminal != nil:
+		parts = append(parts, terminal.Status)
+	case started != nil:
+		parts = append(parts, fmt.Sprintf("Running for %s", FormatDuration(now.Sub(started.StartTime))))
+	}
+
+	return strings.Join(parts, " → ")
+}
+
+// pluralize formats a count with the singular or plural noun.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
@@ -0,0 +1,140 @@
+package temporal
+
+import (
+	"fmt"
+	"strings"
+
+	failurepb "go.temporal.io/api/failure/v1"
+)
+
+// failureStackPreviewLen caps the leaf stack trace shown with a failure chain.
+const failureStackPreviewLen = 200
+
+// FailureLink is one failure in a cause chain, outermost first.
+type FailureLink struct {
+	Kind         string // SDK error name for the failure info, e.g. "ApplicationError"
+	Type         string // Application error type, timeout type, or activity/workflow type
+	NonRetryable bool
+	Message      string
+	Source       string
+	StackTrace   string
+}
+
+// Label names the failure with its type and retry flag, e.g.
+// "ApplicationError(PAYMENT_DECLINED, nonRetryable=true)".
+func (l FailureLink) Label() string {
+	var args []string
+	if l.Type != "" {
+		args = append(args, l.Type)
+	}
+	if l.NonRetryable {
+		args = append(args, "nonRetryable=true")
+	}
+	if len(args) == 0 {
+		return l.Kind
+	}
+	return fmt.Sprintf("%s(%s)", l.Kind, strings.Join(args, ", "))
+}
+
+// buildFailureChain walks a failure and its causes.
+func buildFailureChain(failure *failurepb.Failure) []FailureLink {
+	var chain []FailureLink
+	for f := failure; f != nil; f = f.GetCause() {
+		link := FailureLink{
+			Kind:       "Failure",
+			Message:    f.GetMessage(),
+			Source:     f.GetSource(),
+			StackTrace: f.GetStackTrace(),
+		}
+		switch {
+		case f.GetApplicationFailureInfo() != nil:
+			info := f.GetApplicationFailureInfo()
+			link.Kind, link.Type, link.NonRetryable = "ApplicationError", info.GetType(), info.GetNonRetryable()
+		case f.GetTimeoutFailureInfo() != nil:
+			link.Kind, link.Type = "TimeoutError", formatEventType(strings.TrimPrefix(f.GetTimeoutFailureInfo().GetTimeoutType().String(), "TIMEOUT_TYPE_"))
+		case f.GetCanceledFailureInfo() != nil:
+			link.Kind = "CanceledError"
+		case f.GetTerminatedFailureInfo() != nil:
+			link.Kind = "TerminatedError"
+		case f.GetServerFailureInfo() != nil:
+			link.Kind, link.NonRetryable = "ServerError", f.GetServerFailureInfo().GetNonRetryable()
+		case f.GetResetWorkflowFailureInfo() != nil:
+			link.Kind = "ResetWorkflowError"
+		case f.GetActivityFailureInfo() != nil:
+			link.Kind, link.Type = "ActivityError", f.GetActivityFailureInfo().GetActivityType().GetName()
+		case f.GetChildWorkflowExecutionFailureInfo() != nil:
+			link.Kind, link.Type = "ChildWorkflowExecutionError", f.GetChildWorkflowExecutionFailureInfo().GetWorkflowType().GetName()
+		case f.GetNexusOperationExecutionFailureInfo() != nil:
+			info := f.GetNexusOperationExecutionFailureInfo()
+			link.Kind, link.Type = "NexusOperationError", info.GetService()+"."+info.GetOperation()
+		case f.GetNexusHandlerFailureInfo() != nil:
+			link.Kind, link.Type = "NexusHandlerError", f.GetNexusHandlerFailureInfo().GetType()
+		}
+		chain = append(chain, link)
+	}
+	return chain
+}
+
+// FailureChainSummary names the event and each failure in its chain, e.g.
+// "ActivityTaskFailed → ApplicationError(PAYMENT_DECLINED, nonRetryable=true)".
+func FailureChainSummary(eventType string, chain []FailureLink) string {
+	parts := make([]string, 0, len(chain)+1)
+	if eventType != "" {
+		parts = append(parts, eventType)
+	}
+	for _, link := range chain {
+		parts = append(parts, link.Label())
+	}
+	return strings.Join(parts, " → ")
+}
+
+// FormatFailureChain renders a chain with each cause indented under the
+// failure it caused. Only the root cause's stack trace is included, and it
+// is truncated.
+func FormatFailureChain(chain []FailureLink) string {
+	var sb strings.Builder
+	for i, link := range chain {
+		indent := strings.Repeat("  ", i)
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("%s%s: %s", indent, link.Label(), link.Message))
+		if link.Source != "" {
+			sb.WriteString(fmt.Sprintf("\n%s  source: %s", indent, link.Source))
+		}
+		if i == len(chain)-1 && link.StackTrace != "" {
+			trace := truncateStackTrace(link.StackTrace)
+			for _, line := range strings.Split(trace, "\n") {
+				sb.WriteString(fmt.Sprintf("\n%s  %s", indent, line))
+			}
+		}
+	}
+	return sb.String()
+}
+
+// failureDetail summarizes a failure for event details: the chain from the
+// event to the root cause, and the root cause's message.
+func failureDetail(eventType string, failure *failurepb.Failure) string {
+	chain := buildFailureChain(failure)
+	if len(chain) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", FailureChainSummary(eventType, chain), chain[len(chain)-1].Message)
+}
+
+// rootStackTrace returns the stack trace of a failure's root cause,
+// truncated for event details.
+func rootStackTrace(failure *failurepb.Failure) string {
+	chain := buildFailureChain(failure)
+	if len(chain) == 0 {
+		return ""
+	}
+	return truncateStackTrace(chain[len(chain)-1].StackTrace)
+}
+
+func truncateStackTrace(trace string) string {
+	if len(trace) > failureStackPreviewLen {
+		return trace[:failureStackPreviewLen] + "..."
+	}
+	return trace
+}
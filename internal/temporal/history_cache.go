@@ -0,0 +1,234 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// historyCacheSize is how many runs' histories a client keeps between loads.
+const historyCacheSize = 16
+
+// cachedHistory is the history of one run as of its last load. History is
+// append-only, so a reload only fetches the events after the cached ones.
+type cachedHistory struct {
+	mu       sync.Mutex
+	events   []EnhancedHistoryEvent
+	token    []byte // Continues the history after events
+	complete bool   // The run has closed, so events will not change
+	usedAt   time.Time
+}
+
+// historyEntry returns the cache entry for a run, creating it and evicting
+// the least recently used entry if the cache is full.
+func (c *Client) historyEntry(namespace, workflowID, runID string) *cachedHistory {
+	c.historyMu.Lock()
+	defer c.historyMu.Unlock()
+
+	if c.histories == nil {
+		c.histories = make(map[string]*cachedHistory)
+	}
+	key := namespace + "/" + workflowID + "/" + runID
+	entry, ok := c.histories[key]
+	if !ok {
+		if len(c.histories) >= historyCacheSize {
+			var oldestKey string
+			var oldest time.Time
+			for k, e := range c.histories {
+				if oldestKey == "" || e.usedAt.Before(oldest) {
+					oldestKey, oldest = k, e.usedAt
+				}
+			}
+			delete(c.histories, oldestKey)
+		}
+		entry = &cachedHistory{}
+		c.histories[key] = entry
+	}
+	entry.usedAt = time.Now()
+	return entry
+}
+
+// getCachedEnhancedHistory returns a run's history, fetching only the events
+// added since it was last loaded. The run's history length, from describing
+// it, says whether there is anything new; a closed run is not fetched again.
+//
+// Pages are requested with WaitNewEvent so that, for a running workflow, the
+// server returns a token that continues after the last event instead of
+// ending the history. That token only blocks when there are no new events,
+// and it is only used when the history length says there are.
+func (c *Client) getCachedEnhancedHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error) {
+	entry := c.historyEntry(namespace, workflowID, runID)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.complete {
+		return slices.Clone(entry.events), nil
+	}
+
+	desc, err := c.client.WorkflowService().DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow history: %w", err)
+	}
+	info := desc.GetWorkflowExecutionInfo()
+	length := info.GetHistoryLength()
+	running := info.GetStatus() == enums.WORKFLOW_EXECUTION_STATUS_RUNNING
+
+	events, token := entry.events, entry.token
+	if len(token) == 0 {
+		// Nothing to continue from, so start over
+		events = nil
+	}
+	if len(events) == 0 || lastEventID(events) < length {
+		for {
+			resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+				Namespace: namespace,
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: workflowID,
+					RunId:      runID,
+				},
+				NextPageToken: token,
+				WaitNewEvent:  true,
+			})
+			if err != nil {
+				entry.events, entry.token = nil, nil
+				if len(events) > 0 {
+					return events, &HistoryIncompleteError{Loaded: len(events), Err: err}
+				}
+				return nil, fmt.Errorf("failed to get workflow history: %w", err)
+			}
+
+			last := lastEventID(events)
+			for _, event := range resp.GetHistory().GetEvents() {
+				if event.GetEventId() > last {
+					events = append(events, extractEnhancedEvent(event))
+				}
+			}
+
+			token = resp.GetNextPageToken()
+			if len(token) == 0 || lastEventID(events) >= length {
+				break
+			}
+		}
+	}
+
+	entry.events, entry.token = events, token
+	entry.complete = len(token) == 0 || (!running && lastEventID(events) >= length)
+	return slices.Clone(events), nil
+}
+
+// lastEventID returns the ID of the last event, or 0 if there are none.
+func lastEventID(events []EnhancedHistoryEvent) int64 {
+	if len(events) == 0 {
+		return 0
+	}
+	return events[len(events)-1].ID
+}
+
+// historyLongPollTimeout bounds one long poll of the history tail. The server
+// answers sooner, with no events, if nothing happens.
+const historyLongPollTimeout = 70 * time.Second
+
+// StreamWorkflowHistory long-polls a run's history for new events. It picks
+// up from the cached history of the run when there is one, and adds the
+// events it receives to the cache.
+func (c *Client) StreamWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, afterEventID int64, onEvents func([]EnhancedHistoryEvent)) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+
+	// Start from the end of the cached history rather than the first event
+	var entry *cachedHistory
+	var token []byte
+	var pending []EnhancedHistoryEvent
+	closed := false
+	if runID != "" {
+		entry = c.historyEntry(namespace, workflowID, runID)
+		entry.mu.Lock()
+		if (len(entry.token) > 0 || entry.complete) && lastEventID(entry.events) >= afterEventID {
+			token, closed = entry.token, entry.complete
+			for _, ev := range entry.events {
+				if ev.ID > afterEventID {
+					pending = append(pending, ev)
+				}
+			}
+		}
+		entry.mu.Unlock()
+	}
+	if len(pending) > 0 {
+		onEvents(pending)
+	}
+	if closed {
+		return nil
+	}
+
+	last := max(afterEventID, lastEventID(pending))
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, historyLongPollTimeout)
+		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(pollCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			NextPageToken: token,
+			WaitNewEvent:  true,
+		})
+		timedOut := pollCtx.Err() != nil
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if timedOut {
+				continue
+			}
+			return fmt.Errorf("failed to stream workflow history: %w", err)
+		}
+
+		var batch []EnhancedHistoryEvent
+		for _, event := range resp.GetHistory().GetEvents() {
+			if event.GetEventId() > last {
+				batch = append(batch, extractEnhancedEvent(event))
+			}
+		}
+		token = resp.GetNextPageToken()
+
+		if len(batch) > 0 {
+			last = lastEventID(batch)
+			if entry != nil {
+				entry.appendStreamed(batch, token)
+			}
+			onEvents(batch)
+		}
+		if len(token) == 0 {
+			// The history ended, so the run has closed
+			return nil
+		}
+	}
+}
+
+// appendStreamed adds streamed events to the cache if they follow on from
+// the cached ones.
+func (h *cachedHistory) appendStreamed(events []EnhancedHistoryEvent, token []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.events) == 0 || events[0].ID != lastEventID(h.events)+1 {
+		return
+	}
+	h.events = append(h.events, events...)
+	h.token = token
+	h.complete = len(token) == 0
+}
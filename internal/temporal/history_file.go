@@ -0,0 +1,48 @@
+package temporal
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"go.temporal.io/sdk/client"
+)
+
+// LoadHistoryFile reads a workflow history exported as JSON, as written by
+// the Temporal CLI (temporal workflow show --output json) or the Web UI.
+func LoadHistoryFile(path string) ([]EnhancedHistoryEvent, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	events, err := readHistoryJSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history %s: %w", path, err)
+	}
+	return events, nil
+}
+
+// ParseHistoryJSON parses a workflow history exported as JSON.
+func ParseHistoryJSON(data []byte) ([]EnhancedHistoryEvent, error) {
+	events, err := readHistoryJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history: %w", err)
+	}
+	return events, nil
+}
+
+func readHistoryJSON(r io.Reader) ([]EnhancedHistoryEvent, error) {
+	history, err := client.HistoryFromJSON(r, client.HistoryJSONOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]EnhancedHistoryEvent, 0, len(history.GetEvents()))
+	for _, event := range history.GetEvents() {
+		events = append(events, extractEnhancedEvent(event))
+	}
+	return events, nil
+}
@@ -0,0 +1,137 @@
+package temporal
+
+// shapeDiffLimit caps the alignment table size (events in A times events in
+// B, after trimming the common prefix and suffix). Larger middles are shown
+// as removed and added rather than aligned.
+const shapeDiffLimit = 4_000_000
+
+// ShapeOp says how a row of a history shape diff lines up.
+type ShapeOp int
+
+const (
+	ShapeSame  ShapeOp = iota // the event appears in both histories
+	ShapeOnlyA                // the event appears only in history A
+	ShapeOnlyB                // the event appears only in history B
+)
+
+// ShapeRow is one aligned row of a history shape diff. A or B is nil when
+// the event exists on one side only.
+type ShapeRow struct {
+	Op ShapeOp
+	A  *EnhancedHistoryEvent
+	B  *EnhancedHistoryEvent
+}
+
+// EventShapeKey identifies an event by its type and what it acts on, so
+// activities match by activity type, timers by ID, children by workflow
+// type, and signals and updates by name. IDs, times, and payloads are ignored.
+func EventShapeKey(ev EnhancedHistoryEvent) string {
+	switch {
+	case ev.ActivityType != "":
+		return ev.Type + ":" + ev.ActivityType
+	case ev.TimerID != "":
+		return ev.Type + ":" + ev.TimerID
+	case ev.ChildWorkflowType != "":
+		return ev.Type + ":" + ev.ChildWorkflowType
+	case ev.SignalName != "":
+		return ev.Type + ":" + ev.SignalName
+	case ev.UpdateName != "":
+		return ev.Type + ":" + ev.UpdateName
+	}
+	return ev.Type
+}
+
+// DiffHistoryShape aligns two event sequences by EventShapeKey using a
+// longest common subsequence, so an inserted or missing step shows up as a
+// single gap instead of shifting every later event. It returns the aligned
+// rows and the index of the first row that is not ShapeSame, or -1.
+func DiffHistoryShape(a, b []EnhancedHistoryEvent) ([]ShapeRow, int) {
+	keysA := make([]string, len(a))
+	for i := range a {
+		keysA[i] = EventShapeKey(a[i])
+	}
+	keysB := make([]string, len(b))
+	for i := range b {
+		keysB[i] = EventShapeKey(b[i])
+	}
+
+	// Trim the common prefix and suffix; runs of the same code usually share
+	// most of their history.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && keysA[prefix] == keysB[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && keysA[len(a)-1-suffix] == keysB[len(b)-1-suffix] {
+		suffix++
+	}
+
+	rows := make([]ShapeRow, 0, max(len(a), len(b)))
+	for i := 0; i < prefix; i++ {
+		rows = append(rows, ShapeRow{Op: ShapeSame, A: &a[i], B: &b[i]})
+	}
+	rows = append(rows, alignShape(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix],
+		keysA[prefix:len(a)-suffix], keysB[prefix:len(b)-suffix])...)
+	for i := suffix; i > 0; i-- {
+		rows = append(rows, ShapeRow{Op: ShapeSame, A: &a[len(a)-i], B: &b[len(b)-i]})
+	}
+
+	first := -1
+	if prefix < len(a) || prefix < len(b) {
+		first = prefix
+	}
+	return rows, first
+}
+
+// alignShape aligns the differing middle of two histories.
+func alignShape(a, b []EnhancedHistoryEvent, keysA, keysB []string) []ShapeRow {
+	n, m := len(a), len(b)
+	var rows []ShapeRow
+	if n*m > shapeDiffLimit {
+		for i := range a {
+			rows = append(rows, ShapeRow{Op: ShapeOnlyA, A: &a[i]})
+		}
+		for j := range b {
+			rows = append(rows, ShapeRow{Op: ShapeOnlyB, B: &b[j]})
+		}
+		return rows
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if keysA[i] == keysB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case keysA[i] == keysB[j]:
+			rows = append(rows, ShapeRow{Op: ShapeSame, A: &a[i], B: &b[j]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			rows = append(rows, ShapeRow{Op: ShapeOnlyA, A: &a[i]})
+			i++
+		default:
+			rows = append(rows, ShapeRow{Op: ShapeOnlyB, B: &b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		rows = append(rows, ShapeRow{Op: ShapeOnlyA, A: &a[i]})
+	}
+	for ; j < m; j++ {
+		rows = append(rows, ShapeRow{Op: ShapeOnlyB, B: &b[j]})
+	}
+	return rows
+}
@@ -0,0 +1,40 @@
+package temporal
+
+import "time"
+
+// PendingTimer is a timer that has started but has neither fired nor been
+// canceled.
+type PendingTimer struct {
+	TimerID        string
+	StartedEventID int64
+	StartedTime    time.Time
+	FireTime       time.Time // Zero if the history does not record the timeout
+}
+
+// PendingTimers returns the timers in events that are still waiting to fire,
+// in the order they were started. DescribeWorkflowExecution does not report
+// timers, so they are derived from history.
+func PendingTimers(events []EnhancedHistoryEvent) []PendingTimer {
+	var timers []PendingTimer
+	closed := make(map[int64]bool)
+	for _, ev := range events {
+		if ev.Type == "TimerFired" || ev.Type == "TimerCanceled" {
+			closed[ev.StartedEventID] = true
+		}
+	}
+	for _, ev := range events {
+		if ev.Type != "TimerStarted" || closed[ev.ID] {
+			continue
+		}
+		timer := PendingTimer{
+			TimerID:        ev.TimerID,
+			StartedEventID: ev.ID,
+			StartedTime:    ev.Time,
+		}
+		if ev.StartToFireTimeout > 0 {
+			timer.FireTime = ev.Time.Add(ev.StartToFireTimeout)
+		}
+		timers = append(timers, timer)
+	}
+	return timers
+}
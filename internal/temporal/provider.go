@@ -2,7 +2,11 @@ package temporal
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
+
+	"go.temporal.io/api/serviceerror"
 )
 
 // Provider defines the interface for Temporal data access.
@@ -33,18 +37,60 @@ type Provider interface {
 	// ListWorkflows returns workflows for a namespace with optional filtering.
 	ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error)
 
-	// GetWorkflow returns details for a specific workflow execution.
+	// GetWorkflow returns details for a specific workflow execution. An
+	// empty runID resolves to the latest run of the workflow ID.
 	GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*Workflow, error)
 
 	// GetWorkflowHistory returns the event history for a workflow execution.
 	GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]HistoryEvent, error)
 
 	// GetEnhancedWorkflowHistory returns event history with relational data for tree/timeline views.
+	// If paging fails after some events were fetched, those events are returned
+	// together with a *HistoryIncompleteError.
 	GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error)
 
+	// StreamWorkflowHistory long-polls a run's history, calling onEvents from
+	// its own goroutine with each batch of events after afterEventID as the
+	// server records them. It returns nil once the run has closed, or ctx's
+	// error when ctx is done.
+	StreamWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, afterEventID int64, onEvents func([]EnhancedHistoryEvent)) error
+
+	// GetRecentWorkflowHistory retrieves up to limit of the most recent events,
+	// oldest first, without fetching the rest of a large history.
+	GetRecentWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, limit int) ([]EnhancedHistoryEvent, error)
+
+	// GetWorkflowStartEvent returns a run's WorkflowExecutionStarted event,
+	// which holds the original input, without fetching the rest of its history.
+	GetWorkflowStartEvent(ctx context.Context, namespace, workflowID, runID string) (*EnhancedHistoryEvent, error)
+
+	// GetWorkflowHistoryJSON returns the full event history as indented JSON,
+	// in the format the Temporal CLI and Web UI export.
+	GetWorkflowHistoryJSON(ctx context.Context, namespace, workflowID, runID string) ([]byte, error)
+
+	// ExportWorkflowHistory returns the full event history encoded in format,
+	// ready to be written to a file and replayed or shared.
+	ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, format HistoryFormat) ([]byte, error)
+
+	// ReplayHistory replays a JSON history against the workflow definition
+	// registered for workflowType, see RegisterReplayWorkflow.
+	ReplayHistory(ctx context.Context, historyJSON []byte, workflowType string) (*ReplayResult, error)
+
 	// DescribeTaskQueue returns task queue info and active pollers.
 	DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error)
 
+	// GetTaskQueueVersioning returns a task queue's worker build ID version
+	// sets, its default build ID, and which build IDs are still reachable.
+	GetTaskQueueVersioning(ctx context.Context, namespace, taskQueue string) (*TaskQueueVersioning, error)
+
+	// SetTaskQueueRateLimit overrides the activity dispatch rate of a task
+	// queue and returns the resulting limit. A nil rps removes the override;
+	// zero pauses dispatch. Requires server support, see SupportsTaskQueueConfig.
+	SetTaskQueueRateLimit(ctx context.Context, namespace, taskQueue string, rps *float32, reason string) (*TaskQueueRateLimit, error)
+
+	// SupportsTaskQueueConfig returns true if the server can change task
+	// queue configuration.
+	SupportsTaskQueueConfig(ctx context.Context) (bool, error)
+
 	// Close releases any resources held by the provider.
 	Close() error
 
@@ -65,6 +111,9 @@ type Provider interface {
 	// Config returns the connection configuration used by this provider.
 	Config() ConnectionConfig
 
+	// SetAuthToken replaces the auth token header value without reconnecting.
+	SetAuthToken(token string)
+
 	// Workflow Mutations
 
 	// CancelWorkflow requests graceful cancellation of a workflow execution.
@@ -78,6 +127,16 @@ type Provider interface {
 	// SignalWorkflow sends a signal to a running workflow execution.
 	SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error
 
+	// UpdateWorkflow sends an update to a running workflow execution and waits
+	// for the handler to finish. args is optional JSON-encoded input. An update
+	// the workflow rejects or fails is reported in the result, not as an error.
+	UpdateWorkflow(ctx context.Context, namespace, workflowID, runID, updateName string, args []byte) (*UpdateResult, error)
+
+	// WaitForWorkflowResult blocks until a workflow closes, following
+	// continue-as-new, and returns how it closed. An error means waiting
+	// itself failed, for example because ctx was canceled.
+	WaitForWorkflowResult(ctx context.Context, namespace, workflowID, runID string) (*WorkflowResult, error)
+
 	// StartWorkflow starts a new workflow execution.
 	// Returns the run ID of the started workflow.
 	StartWorkflow(ctx context.Context, namespace string, req StartWorkflowRequest) (string, error)
@@ -90,7 +149,16 @@ type Provider interface {
 	DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error
 
 	// ResetWorkflow resets a workflow to a previous state, creating a new run.
-	ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error)
+	// Events of the kinds in exclude are not reapplied to the new run; this
+	// requires server support, see SupportsResetReapplyExclude.
+	ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string, exclude []ResetReapplyExclude) (string, error)
+
+	// RequestActivityCancel stops a single pending activity from making further progress.
+	// Temporal has no client API to cancel an individual activity, so this pauses the
+	// activity on the server: no further attempts are scheduled, and a heartbeating
+	// activity receives a cancellation on its next heartbeat. The workflow is not
+	// notified and still sees the activity as pending until it is unpaused or reset.
+	RequestActivityCancel(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error
 
 	// Schedule Operations
 
@@ -112,8 +180,29 @@ type Provider interface {
 	// DeleteSchedule permanently deletes a schedule.
 	DeleteSchedule(ctx context.Context, namespace, scheduleID string) error
 
+	// CreateSchedule creates a schedule that starts a workflow on a cron or
+	// interval spec.
+	CreateSchedule(ctx context.Context, namespace string, req ScheduleCreateRequest) error
+
+	// UpdateSchedule changes a schedule's spec and whether it is paused.
+	UpdateSchedule(ctx context.Context, namespace, scheduleID string, req ScheduleUpdateRequest) error
+
 	// Query Operations
 
+	// CountWorkflows counts the workflows matching a query, by status.
+	CountWorkflows(ctx context.Context, namespace, query string) (*WorkflowCounts, error)
+
+	// ValidateQuery checks a visibility query with a one-row list, returning
+	// a *QueryError if the server rejects it.
+	ValidateQuery(ctx context.Context, namespace, query string) error
+
+	// ListSearchAttributes returns the search attributes registered in a namespace.
+	ListSearchAttributes(ctx context.Context, namespace string) ([]SearchAttributeDefinition, error)
+
+	// GetSearchAttributes returns the latest value of each search attribute
+	// the workflow started with or upserted, read from its history.
+	GetSearchAttributes(ctx context.Context, namespace, workflowID, runID string) ([]UpsertedSearchAttribute, error)
+
 	// QueryWorkflow executes a query against a running workflow and returns the result.
 	// queryType is the name of the query handler (e.g., "__stack_trace" for built-in stack trace).
 	// args is optional JSON-encoded arguments to pass to the query handler.
@@ -127,9 +216,29 @@ type Provider interface {
 	// TerminateWorkflows terminates multiple workflows and returns results for each.
 	TerminateWorkflows(ctx context.Context, namespace string, workflows []WorkflowIdentifier, reason string) ([]BatchResult, error)
 
+	// SignalWorkflows sends the same signal to multiple workflows and returns results for each.
+	SignalWorkflows(ctx context.Context, namespace string, workflows []WorkflowIdentifier, signalName string, input []byte) ([]BatchResult, error)
+
+	// StartBatchOperation starts a server-side batch job against every workflow
+	// matching a visibility query and returns its job ID.
+	StartBatchOperation(ctx context.Context, namespace string, req BatchOperationRequest) (string, error)
+
+	// DescribeBatchOperation returns the progress of a server-side batch job.
+	DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*BatchOperation, error)
+
+	// ListBatchOperations returns the namespace's recent server-side batch jobs, newest first.
+	ListBatchOperations(ctx context.Context, namespace string) ([]BatchOperation, error)
+
+	// StopBatchOperation stops a running server-side batch job.
+	StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error
+
 	// GetResetPoints returns valid reset points for a workflow execution.
 	GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]ResetPoint, error)
 
+	// SupportsResetReapplyExclude returns true if the server honors the
+	// exclude option of ResetWorkflow.
+	SupportsResetReapplyExclude(ctx context.Context) (bool, error)
+
 	// Workflow Relationship Operations
 
 	// GetWorkflowRelationships returns the complete relationship graph for a workflow.
@@ -140,9 +249,12 @@ type Provider interface {
 	GetChildWorkflows(ctx context.Context, namespace, workflowID, runID string) ([]Workflow, error)
 }
 
+// DefaultPageSize is the page size used when ListOptions.PageSize is unset.
+const DefaultPageSize = 100
+
 // ListOptions configures workflow list queries.
 type ListOptions struct {
-	PageSize  int
+	PageSize  int // Rows per page, DefaultPageSize if 0
 	PageToken string
 	Query     string // Visibility query (e.g., "WorkflowType='OrderWorkflow'")
 }
@@ -152,6 +264,7 @@ type Namespace struct {
 	Name            string
 	State           string
 	RetentionPeriod string
+	RetentionDays   int // Effective retention in whole days, 0 if unknown
 	Description     string
 	OwnerEmail      string
 }
@@ -172,17 +285,42 @@ type NamespaceUpdateRequest struct {
 	RetentionDays int
 }
 
+// Retention bounds for namespace create and update. The server does not expose
+// its configured limits, so these mirror the Temporal server's default minimum
+// and a generous upper bound. The server still rejects values outside its own range.
+const (
+	MinRetentionDays = 1
+	MaxRetentionDays = 3650
+)
+
+// ValidateRetentionDays returns an error if days is outside the allowed retention range.
+func ValidateRetentionDays(days int) error {
+	if days < MinRetentionDays || days > MaxRetentionDays {
+		return fmt.Errorf("retention must be between %d and %d days", MinRetentionDays, MaxRetentionDays)
+	}
+	return nil
+}
+
 // NamespaceDetail contains extended namespace information.
+// The server does not report when a namespace was created or last updated;
+// the failover history is the only change record it keeps.
 type NamespaceDetail struct {
 	Namespace
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
 	HistoryArchival    string // Archival state + URI
 	VisibilityArchival string // Archival state + URI
 	ID                 string // Internal namespace UUID
 	IsGlobalNamespace  bool
 	FailoverVersion    int64
-	Clusters           []string // Active clusters for multi-region
+	ActiveCluster      string              // Cluster that accepts writes
+	Clusters           []string            // Clusters the namespace is replicated to
+	ReplicationState   string              // "Normal", or "Handover" during a graceful failover
+	FailoverHistory    []NamespaceFailover // Most recent first
+}
+
+// NamespaceFailover records when a global namespace moved to a failover version.
+type NamespaceFailover struct {
+	Time    time.Time
+	Version int64
 }
 
 // Workflow represents a workflow execution.
@@ -190,7 +328,7 @@ type Workflow struct {
 	ID        string
 	RunID     string
 	Type      string
-	Status    string // "Running", "Completed", "Failed", "Canceled", "Terminated", "TimedOut"
+	Status    string // "Running", "Completed", "Failed", "Canceled", "Terminated", "TimedOut", "ContinuedAsNew"
 	Namespace string
 	TaskQueue string
 	StartTime time.Time
@@ -199,6 +337,68 @@ type Workflow struct {
 	Memo      map[string]string
 	Input     string // JSON-formatted workflow input
 	Output    string // JSON-formatted workflow result (or failure message)
+
+	// ParentRunID is the run of ParentID that started this workflow.
+	ParentRunID string
+
+	// SearchAttributes holds indexed values decoded by their registered type.
+	SearchAttributes map[string]string
+
+	// History size as reported by the server, before any history is fetched
+	HistoryLength    int64
+	HistorySizeBytes int64
+
+	// PendingActivities is only populated by GetWorkflow.
+	PendingActivities []PendingActivity
+
+	// PendingChildren is only populated by GetWorkflow.
+	PendingChildren []PendingChild
+
+	// PendingNexusOperations is only populated by GetWorkflow.
+	PendingNexusOperations []PendingNexusOperation
+}
+
+// PendingChild is a child workflow that has been started but has not yet closed.
+type PendingChild struct {
+	WorkflowID       string
+	RunID            string
+	WorkflowType     string
+	InitiatedEventID int64
+}
+
+// PendingNexusOperation is a Nexus operation that has been scheduled but has
+// not yet completed.
+type PendingNexusOperation struct {
+	Endpoint         string
+	Service          string
+	Operation        string
+	State            string // "Scheduled", "BackingOff", "Started", "Blocked"
+	Attempt          int32
+	ScheduledTime    time.Time
+	ScheduledEventID int64
+	LastFailure      string
+	BlockedReason    string
+}
+
+// PendingActivity represents an activity that has been scheduled but has not yet completed.
+type PendingActivity struct {
+	ActivityID         string
+	ActivityType       string
+	State              string // "Scheduled", "Started", "CancelRequested", "Paused", "PauseRequested"
+	Attempt            int32
+	MaximumAttempts    int32 // 0 means unlimited
+	ScheduledTime      time.Time
+	LastStartedTime    *time.Time
+	NextAttemptTime    *time.Time // Set while the activity is backing off between retries
+	LastFailure        string
+	LastWorkerIdentity string
+	LastHeartbeatTime  *time.Time
+	HeartbeatDetails   string // Details from the last heartbeat, as compact JSON where possible
+}
+
+// IsBackingOff returns true if the activity is waiting for its next retry attempt.
+func (p PendingActivity) IsBackingOff() bool {
+	return p.NextAttemptTime != nil && p.State == "Scheduled"
 }
 
 // HistoryEvent represents a workflow history event.
@@ -225,12 +425,37 @@ type EnhancedHistoryEvent struct {
 	ActivityID   string
 	ActivityType string
 	TimerID      string
+	SignalName   string
+
+	// Workflow update identity, set on the update's admitted, accepted,
+	// rejected, and completed events. Completed events carry only the ID.
+	UpdateID   string
+	UpdateName string
+
+	// StartToFireTimeout is how long a started timer waits before firing
+	StartToFireTimeout time.Duration
+
+	// SearchAttributes set by the started event or a search attribute
+	// upsert. An empty value means the upsert removed the attribute.
+	SearchAttributes map[string]string
 
 	// Child workflow info
 	ChildWorkflowID   string
 	ChildRunID        string
 	ChildWorkflowType string
 
+	// Payloads holds the untruncated data of the event's input, result, or
+	// details payloads, one entry per payload. Details only shows a preview.
+	Payloads [][]byte
+
+	// Continue-as-new: the run that replaced this one
+	NewExecutionRunID string
+
+	// Started event: the run this one continued from, if any, and the first
+	// run of its chain
+	ContinuedExecutionRunID string
+	FirstExecutionRunID     string
+
 	// Timing for Gantt view
 	EndTime *time.Time // Computed from linked completion event
 
@@ -243,16 +468,51 @@ type EnhancedHistoryEvent struct {
 	FailureSource     string
 	FailureStackTrace string
 	FailureCause      string
+	FailureChain      []FailureLink // The failure and its causes, outermost first
 	Result            string
 	Input             string // Workflow/Activity input
 }
 
+// SystemNamespace is the internal namespace where the server runs its own
+// workflows, such as batch operations and archival.
+const SystemNamespace = "temporal-system"
+
 // TaskQueueInfo represents task queue status information.
 type TaskQueueInfo struct {
-	Name        string
-	Type        string // "Workflow" or "Activity"
-	PollerCount int
-	Backlog     int
+	Name            string
+	Type            string // "Workflow", "Activity", or "Combined" by which types are polled
+	PollerCount     int
+	WorkflowPollers int
+	ActivityPollers int
+	Backlog         int
+	RateLimit       *TaskQueueRateLimit // Activity rate limit, nil if the server doesn't report one
+	Workflow        *TaskQueueStats     // Workflow task stats, nil if the server doesn't report them
+	Activity        *TaskQueueStats     // Activity task stats, nil if the server doesn't report them
+}
+
+// TaskQueueStats is the approximate backlog and throughput of one task queue
+// type. Servers that predate task queue stats only report a backlog hint, in
+// which case HasRates is false.
+type TaskQueueStats struct {
+	BacklogCount int64
+	BacklogAge   time.Duration // Age of the oldest backlogged task
+	AddRate      float32       // Tasks added per second
+	DispatchRate float32       // Tasks dispatched per second
+	HasRates     bool
+}
+
+// TaskQueueRateLimit is the activity dispatch rate limit of a task queue.
+type TaskQueueRateLimit struct {
+	Set               bool    // False when no limit overrides the workers' own
+	RequestsPerSecond float32 // Zero means dispatch is paused
+	Reason            string
+	UpdatedBy         string
+	UpdatedAt         *time.Time
+}
+
+// Paused reports whether the limit stops activity dispatch entirely.
+func (r *TaskQueueRateLimit) Paused() bool {
+	return r != nil && r.Set && r.RequestsPerSecond == 0
 }
 
 // Poller represents a worker polling a task queue.
@@ -279,6 +539,36 @@ type Schedule struct {
 	RecentActions int64 // Actions in the last 24h
 	RecentRuns    []ScheduleRun
 	OverlapPolicy string
+	Cron          string        // First cron expression of the spec, if any
+	Interval      time.Duration // First interval of the spec, if any
+}
+
+// ScheduleOverlapPolicies are the overlap policies a schedule can be created
+// with, the server default first.
+var ScheduleOverlapPolicies = []string{"Skip", "BufferOne", "BufferAll", "CancelOther", "TerminateOther", "AllowAll"}
+
+// ScheduleCreateRequest contains parameters for creating a schedule. Exactly
+// one of Cron and Interval is set.
+type ScheduleCreateRequest struct {
+	ScheduleID    string
+	Cron          string        // Cron expression, e.g. "0 * * * *"
+	Interval      time.Duration // Start a workflow every Interval
+	WorkflowType  string
+	WorkflowID    string // Base ID of started workflows; defaults to the schedule ID
+	TaskQueue     string
+	Input         []byte // JSON-encoded workflow input
+	OverlapPolicy string // One of ScheduleOverlapPolicies; empty means Skip
+	Paused        bool
+	Note          string
+}
+
+// ScheduleUpdateRequest contains parameters for updating a schedule. With
+// neither Cron nor Interval set, the current spec is kept.
+type ScheduleUpdateRequest struct {
+	Cron     string
+	Interval time.Duration
+	Paused   bool
+	Note     string // Replaces the schedule's note when set
 }
 
 // ScheduleRun represents a workflow execution started by a schedule action.
@@ -300,6 +590,11 @@ type ConnectionConfig struct {
 	TLSSkipVerify bool
 	APIKey        string            // For Temporal Cloud API key authentication
 	GRPCMeta      map[string]string // Custom gRPC metadata headers attached to every request
+	AuthHeader    string            // Header carrying AuthToken (default "authorization")
+	AuthScheme    string            // Prefix before AuthToken (default "Bearer"; "none" sends the bare token)
+	AuthToken     string            // Per-user token; replaceable at runtime with SetAuthToken
+	CodecEndpoint string            // Remote codec server that decodes payloads, empty for none
+	CodecAuth     string            // Authorization header value sent to the codec server
 }
 
 // DefaultConnectionConfig returns default connection settings.
@@ -317,6 +612,56 @@ type QueryResult struct {
 	Error     string // Error message if query failed
 }
 
+// WorkflowResult is how a workflow closed: its result, or why it did not
+// complete.
+type WorkflowResult struct {
+	Result  string // JSON-formatted result
+	Failure string // Why the workflow failed, was canceled or terminated, or timed out
+}
+
+// UpdateResult represents the outcome of a workflow update.
+type UpdateResult struct {
+	UpdateName string
+	UpdateID   string
+	Result     string // JSON-formatted result
+	Failure    string // Why the workflow rejected or failed the update
+}
+
+// HistoryIncompleteError reports that a history fetch failed partway through.
+// The events fetched before the failure are returned alongside it.
+type HistoryIncompleteError struct {
+	Loaded int // Number of events fetched before the failure
+	Err    error
+}
+
+func (e *HistoryIncompleteError) Error() string {
+	return fmt.Sprintf("history incomplete after %d events: %v", e.Loaded, e.Err)
+}
+
+func (e *HistoryIncompleteError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether err means the requested workflow, run, or
+// other resource does not exist.
+func IsNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound)
+}
+
+// IsInvalidArgument reports whether err means the server rejected the
+// request itself, such as a query it does not support.
+func IsInvalidArgument(err error) bool {
+	var invalid *serviceerror.InvalidArgument
+	return errors.As(err, &invalid)
+}
+
+// WorkflowCounts is the number of workflows matching a query.
+type WorkflowCounts struct {
+	Total    int64
+	ByStatus map[string]int64 // Keyed by status name, e.g. "Running"
+}
+
 // WorkflowIdentifier uniquely identifies a workflow execution.
 type WorkflowIdentifier struct {
 	WorkflowID string
@@ -340,12 +685,50 @@ type ResetPoint struct {
 	Reason      string // Why this is a valid reset point
 }
 
+// ResetReapplyExclude is a kind of event that a reset can skip reapplying.
+// By default, signals and updates received after the reset point are
+// reapplied to the new run.
+type ResetReapplyExclude string
+
+const (
+	ResetExcludeSignals ResetReapplyExclude = "signal"
+	ResetExcludeUpdates ResetReapplyExclude = "update"
+)
+
+// HistoryFormat is a file format a workflow history can be exported in.
+type HistoryFormat string
+
+const (
+	// HistoryFormatJSON is indented JSON, as written by
+	// `temporal workflow show --output json` and read by the SDK replayer.
+	HistoryFormatJSON HistoryFormat = "json"
+	// HistoryFormatProto is the binary protobuf encoding of the History message.
+	HistoryFormatProto HistoryFormat = "proto"
+)
+
+// HistoryFormats lists the supported export formats.
+var HistoryFormats = []HistoryFormat{HistoryFormatJSON, HistoryFormatProto}
+
+// Ext returns the file extension for the format, e.g. ".json".
+func (f HistoryFormat) Ext() string {
+	if f == HistoryFormatProto {
+		return ".pb"
+	}
+	return ".json"
+}
+
 // StartWorkflowRequest contains parameters for starting a new workflow execution.
 type StartWorkflowRequest struct {
 	WorkflowID   string
 	WorkflowType string
 	TaskQueue    string
 	Input        []byte // JSON-encoded workflow input
+
+	// Optional settings; zero values leave the server defaults
+	CronSchedule     string
+	ExecutionTimeout time.Duration // Across all runs, including retries and continue-as-new
+	RunTimeout       time.Duration // For a single run
+	MaxAttempts      int32         // Retry the workflow up to this many attempts in total
 }
 
 // SignalWithStartRequest contains parameters for starting a workflow with a signal.
@@ -0,0 +1,268 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// QueryError describes a problem with a visibility query, pointing at the
+// clause that caused it when one can be identified.
+type QueryError struct {
+	Clause string // The offending clause, empty if unknown
+	Reason string
+}
+
+func (e *QueryError) Error() string {
+	if e.Clause == "" {
+		return e.Reason
+	}
+	return fmt.Sprintf("%s in: %s", e.Reason, e.Clause)
+}
+
+// IsQueryError reports whether err is a problem with the query itself rather
+// than with reaching the server.
+func IsQueryError(err error) bool {
+	var qe *QueryError
+	return errors.As(err, &qe)
+}
+
+// queryToken is one lexical element of a visibility query.
+type queryToken struct {
+	text       string
+	start, end int  // Byte offsets into the query
+	literal    bool // A quoted string
+}
+
+// queryOperatorSymbols are the comparison operators written with symbols.
+var queryOperatorSymbols = map[string]bool{
+	"=": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true,
+}
+
+// tokenizeQuery splits a query into words, quoted literals, operator symbols,
+// parentheses, and commas.
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	i := 0
+	for i < len(query) {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"' || c == '`':
+			end := i + 1
+			for end < len(query) && query[end] != c {
+				if query[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(query) {
+				return nil, &QueryError{Clause: query[i:], Reason: fmt.Sprintf("unterminated %c quote", c)}
+			}
+			tokens = append(tokens, queryToken{text: query[i : end+1], start: i, end: end + 1, literal: true})
+			i = end + 1
+		case c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, queryToken{text: string(c), start: i, end: i + 1})
+			i++
+		case strings.IndexByte("=!<>", c) >= 0:
+			end := i
+			for end < len(query) && strings.IndexByte("=!<>", query[end]) >= 0 {
+				end++
+			}
+			tokens = append(tokens, queryToken{text: query[i:end], start: i, end: end})
+			i = end
+		default:
+			end := i
+			for end < len(query) && strings.IndexByte(" \t\n\r()=!<>,'\"`", query[end]) < 0 {
+				end++
+			}
+			tokens = append(tokens, queryToken{text: query[i:end], start: i, end: end})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// queryClauses splits a tokenized query into its comparison clauses, dropping
+// the AND / OR connectors, NOT, parentheses, and any ORDER BY.
+func queryClauses(tokens []queryToken) [][]queryToken {
+	var clauses [][]queryToken
+	var current []queryToken
+	between := false // The next AND belongs to BETWEEN ... AND ...
+
+	flush := func() {
+		if len(current) > 0 {
+			clauses = append(clauses, current)
+		}
+		current = nil
+	}
+
+	for i, tok := range tokens {
+		word := strings.ToUpper(tok.text)
+		if tok.literal {
+			current = append(current, tok)
+			continue
+		}
+		switch {
+		case word == "ORDER" && i+1 < len(tokens) && strings.EqualFold(tokens[i+1].text, "BY"):
+			flush()
+			return clauses
+		case word == "AND" && between:
+			between = false
+			current = append(current, tok)
+		case word == "AND" || word == "OR":
+			flush()
+		case word == "NOT" && len(current) == 0:
+		case tok.text == "(" && len(current) == 0:
+		case tok.text == ")" && !inList(current):
+		default:
+			if word == "BETWEEN" {
+				between = true
+			}
+			current = append(current, tok)
+		}
+	}
+	flush()
+	return clauses
+}
+
+// inList reports whether a clause has an IN list still open.
+func inList(clause []queryToken) bool {
+	depth := 0
+	for _, tok := range clause {
+		switch tok.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+	}
+	return depth > 0
+}
+
+// clauseText returns the query text a clause was parsed from.
+func clauseText(query string, clause []queryToken) string {
+	return query[clause[0].start:clause[len(clause)-1].end]
+}
+
+// LintQuery checks a visibility query for mistakes that can be caught without
+// asking the server: unbalanced quotes and parentheses, a dangling AND / OR,
+// clauses that do not start with a search attribute, and unknown operators.
+func LintQuery(query string) error {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return err
+	}
+
+	depth := 0
+	for _, tok := range tokens {
+		if tok.literal {
+			continue
+		}
+		switch tok.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth < 0 {
+				return &QueryError{Clause: query[:tok.end], Reason: "unmatched closing parenthesis"}
+			}
+		}
+	}
+	if depth > 0 {
+		return &QueryError{Reason: "unclosed parenthesis"}
+	}
+	if n := len(tokens); n > 0 && !tokens[n-1].literal {
+		switch last := strings.ToUpper(tokens[n-1].text); last {
+		case "AND", "OR", "NOT":
+			return &QueryError{Reason: fmt.Sprintf("query ends with %s", last)}
+		}
+	}
+
+	for _, clause := range queryClauses(tokens) {
+		if problem := lintClause(clause); problem != "" {
+			return &QueryError{Clause: clauseText(query, clause), Reason: problem}
+		}
+	}
+	return nil
+}
+
+// lintClause checks that a clause reads "attribute operator value" and
+// returns what is wrong with it, or "" if nothing is.
+func lintClause(clause []queryToken) string {
+	field := clause[0]
+	if (field.literal && !strings.HasPrefix(field.text, "`")) || queryOperatorSymbols[field.text] || field.text == "(" || field.text == ")" || field.text == "," {
+		return fmt.Sprintf("expected a search attribute before %s", field.text)
+	}
+	if len(clause) < 2 {
+		return fmt.Sprintf("missing operator after %s", field.text)
+	}
+
+	op := clause[1]
+	rest := clause[2:]
+	word := strings.ToUpper(op.text)
+	if word == "NOT" && len(rest) > 0 {
+		word += " " + strings.ToUpper(rest[0].text)
+		rest = rest[1:]
+	}
+
+	switch word {
+	case "=", "!=", ">", ">=", "<", "<=", "STARTS_WITH", "NOT STARTS_WITH",
+		"IN", "NOT IN", "BETWEEN", "NOT BETWEEN":
+		if len(rest) == 0 {
+			return fmt.Sprintf("missing value after %s", op.text)
+		}
+	case "IS":
+		value := ""
+		for _, tok := range rest {
+			value += " " + strings.ToUpper(tok.text)
+		}
+		if value != " NULL" && value != " NOT NULL" {
+			return "IS must be followed by NULL or NOT NULL"
+		}
+	default:
+		return fmt.Sprintf("unknown operator %s (use =, !=, >, >=, <, <=, IN, BETWEEN, STARTS_WITH, or IS NULL)", op.text)
+	}
+	return ""
+}
+
+// ValidateQuery checks a visibility query against the server by listing a
+// single workflow. A query the server rejects is returned as a *QueryError
+// naming the clause the server complained about; other failures, such as the
+// server being unreachable, are returned as they are.
+func (c *Client) ValidateQuery(ctx context.Context, namespace, query string) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+
+	_, err := c.client.WorkflowService().ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: namespace,
+		PageSize:  1,
+		Query:     query,
+	})
+	var invalid *serviceerror.InvalidArgument
+	if errors.As(err, &invalid) {
+		return &QueryError{Clause: rejectedClause(query, invalid.Message), Reason: invalid.Message}
+	}
+	return err
+}
+
+// rejectedClause finds the clause whose search attribute the server's error
+// message names, or "" if none does.
+func rejectedClause(query, message string) string {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return ""
+	}
+	for _, clause := range queryClauses(tokens) {
+		if strings.Contains(message, clause[0].text) {
+			return clauseText(query, clause)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package temporal
+
+import "strings"
+
+// IsNonDeterminismFailure reports whether a WorkflowTaskFailed event was caused
+// by the worker replaying history with code that no longer matches it.
+func IsNonDeterminismFailure(ev EnhancedHistoryEvent) bool {
+	if !strings.Contains(ev.Type, "WorkflowTaskFailed") {
+		return false
+	}
+	if strings.Contains(ev.Details, "NON_DETERMINISTIC") {
+		return true
+	}
+	failure := strings.ToLower(ev.Failure)
+	return strings.Contains(failure, "nondetermin") || strings.Contains(failure, "non-determin")
+}
+
+// FirstNonDeterminismFailure returns the earliest non-determinism workflow task
+// failure in events.
+func FirstNonDeterminismFailure(events []EnhancedHistoryEvent) (EnhancedHistoryEvent, bool) {
+	for _, ev := range events {
+		if IsNonDeterminismFailure(ev) {
+			return ev, true
+		}
+	}
+	return EnhancedHistoryEvent{}, false
+}
+
+// LastGoodWorkflowTask returns the last WorkflowTaskCompleted event before
+// beforeID as a reset point. Resetting there replays only history that the
+// previous code produced successfully.
+func LastGoodWorkflowTask(events []EnhancedHistoryEvent, beforeID int64) (ResetPoint, bool) {
+	var last *EnhancedHistoryEvent
+	for i := range events {
+		ev := &events[i]
+		if ev.ID >= beforeID {
+			break
+		}
+		if strings.Contains(ev.Type, "WorkflowTaskCompleted") {
+			last = ev
+		}
+	}
+	if last == nil {
+		return ResetPoint{}, false
+	}
+	return ResetPoint{
+		EventID:     last.ID,
+		EventType:   last.Type,
+		Timestamp:   last.Time,
+		Description: "Last workflow task completed before the non-determinism failure",
+		Reason:      "Reset to last good workflow task",
+	}, true
+}
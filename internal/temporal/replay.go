@@ -0,0 +1,161 @@
+package temporal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ReplayResult is the outcome of replaying a workflow history against
+// workflow code.
+type ReplayResult struct {
+	OK     bool
+	Output []string // Replayer output or error, one line per entry
+}
+
+// replayWorkflows holds the workflow definitions ReplayHistory can run,
+// keyed by workflow type.
+var replayWorkflows = map[string]any{}
+
+// RegisterReplayWorkflow makes a workflow definition available to
+// ReplayHistory under workflowType. Builds of tempo that link in workflow
+// code call this from an init function.
+func RegisterReplayWorkflow(workflowType string, fn any) {
+	replayWorkflows[workflowType] = fn
+}
+
+// ReplayWorkflowTypes returns the registered workflow types, sorted.
+func ReplayWorkflowTypes() []string {
+	types := make([]string, 0, len(replayWorkflows))
+	for t := range replayWorkflows {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// IsReplayWorkflowRegistered reports whether ReplayHistory can run
+// workflowType in process.
+func IsReplayWorkflowRegistered(workflowType string) bool {
+	_, ok := replayWorkflows[workflowType]
+	return ok
+}
+
+// ReplayHistory replays a JSON history against the registered definition of
+// workflowType with the SDK's workflow replayer. A replay that fails, for
+// example with a non-determinism error, is reported in the result; the error
+// is for histories that cannot be replayed at all.
+func (c *Client) ReplayHistory(ctx context.Context, historyJSON []byte, workflowType string) (*ReplayResult, error) {
+	fn, ok := replayWorkflows[workflowType]
+	if !ok {
+		return nil, fmt.Errorf("workflow type %q is not registered for replay", workflowType)
+	}
+
+	history, err := client.HistoryFromJSON(bytes.NewReader(historyJSON), client.HistoryJSONOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history: %w", err)
+	}
+
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflowWithOptions(fn, workflow.RegisterOptions{Name: workflowType})
+
+	// The default logger writes to stdout, which would draw over the UI
+	quiet := log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err := replayer.ReplayWorkflowHistory(quiet, history); err != nil {
+		return &ReplayResult{Output: splitLines(err.Error())}, nil
+	}
+	return &ReplayResult{OK: true}, nil
+}
+
+// ReplayWithBinary replays a JSON history with a locally built replayer: the
+// binary is run with the path of a temporary history file as its only
+// argument, and a non-zero exit means the replay failed.
+func ReplayWithBinary(ctx context.Context, binary string, historyJSON []byte) (*ReplayResult, error) {
+	f, err := os.CreateTemp("", "tempo-replay-*.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create history file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(historyJSON); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to write history file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	out, err := exec.CommandContext(ctx, binary, f.Name()).CombinedOutput()
+	result := &ReplayResult{OK: err == nil, Output: splitLines(string(out))}
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("failed to run %s: %w", binary, err)
+	}
+	return result, nil
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+var (
+	// replayEventIDPattern matches an explicit event ID, e.g. "EventId: 12".
+	replayEventIDPattern = regexp.MustCompile(`(?i)event ?id[:= ]+(\d+)`)
+	// replayEventPattern matches the event the SDK names in a history
+	// mismatch, e.g. "missing replay command for ActivityTaskScheduled: (...)".
+	replayEventPattern = regexp.MustCompile(`(?:for|history event is) ([A-Z][A-Za-z]+): \(([^)]*)`)
+	// replayIdentityPattern matches the activity or timer ID in an event.
+	replayIdentityPattern = regexp.MustCompile(`(ActivityId|TimerId):([^,\s)]+)`)
+)
+
+// DivergedEventID returns the first event a failed replay's output names,
+// or 0 if none can be identified.
+func DivergedEventID(output []string, events []EnhancedHistoryEvent) int64 {
+	for _, line := range output {
+		if m := replayEventIDPattern.FindStringSubmatch(line); m != nil {
+			if id, err := strconv.ParseInt(m[1], 10, 64); err == nil {
+				return id
+			}
+		}
+
+		m := replayEventPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		eventType := m[1]
+		var activityID, timerID string
+		for _, attr := range replayIdentityPattern.FindAllStringSubmatch(m[2], -1) {
+			if attr[1] == "ActivityId" {
+				activityID = attr[2]
+			} else {
+				timerID = attr[2]
+			}
+		}
+		for _, ev := range events {
+			if ev.Type != eventType {
+				continue
+			}
+			if (activityID == "" || ev.ActivityID == activityID) && (timerID == "" || ev.TimerID == timerID) {
+				return ev.ID
+			}
+		}
+	}
+	return 0
+}
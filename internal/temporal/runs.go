@@ -0,0 +1,45 @@
+package temporal
+
+import "sort"
+
+// PreviousRun returns the run of the same workflow ID that started most
+// recently before current. runs is typically the result of listing
+// executions with WorkflowId = current.ID.
+func PreviousRun(runs []Workflow, current Workflow) (Workflow, bool) {
+	var prev Workflow
+	found := false
+	for _, run := range runs {
+		if run.ID != current.ID || run.RunID == current.RunID {
+			continue
+		}
+		if !run.StartTime.Before(current.StartTime) {
+			continue
+		}
+		if !found || run.StartTime.After(prev.StartTime) {
+			prev = run
+			found = true
+		}
+	}
+	return prev, found
+}
+
+// RunChain returns the runs of current's workflow ID oldest first, as created
+// by continue-as-new, cron, retries, and resets, along with the index of
+// current among them, or -1 if it is not listed.
+func RunChain(runs []Workflow, current Workflow) ([]Workflow, int) {
+	var chain []Workflow
+	for _, run := range runs {
+		if run.ID == current.ID {
+			chain = append(chain, run)
+		}
+	}
+	sort.SliceStable(chain, func(i, j int) bool {
+		return chain[i].StartTime.Before(chain[j].StartTime)
+	})
+	for i, run := range chain {
+		if run.RunID == current.RunID {
+			return chain, i
+		}
+	}
+	return chain, -1
+}
@@ -0,0 +1,260 @@
+package temporal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+)
+
+// searchAttributeTimeFormat is the display format for Datetime search attributes.
+const searchAttributeTimeFormat = "2006-01-02 15:04:05 MST"
+
+// decodeSearchAttribute decodes a search attribute payload according to its
+// registered type and formats it for display. When valueType is unspecified,
+// the type recorded in the payload's "type" metadata is used instead.
+// Datetime values render in local time and KeywordList values are comma-joined.
+func decodeSearchAttribute(payload *commonpb.Payload, valueType enums.IndexedValueType) (string, error) {
+	if payload == nil || len(payload.GetData()) == 0 {
+		return "", nil
+	}
+	if valueType == enums.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		valueType = payloadValueType(payload)
+	}
+
+	data := payload.GetData()
+	switch valueType {
+	case enums.INDEXED_VALUE_TYPE_TEXT, enums.INDEXED_VALUE_TYPE_KEYWORD:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", fmt.Errorf("decode %s: %w", valueType, err)
+		}
+		return s, nil
+
+	case enums.INDEXED_VALUE_TYPE_INT:
+		var n int64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return "", fmt.Errorf("decode %s: %w", valueType, err)
+		}
+		return strconv.FormatInt(n, 10), nil
+
+	case enums.INDEXED_VALUE_TYPE_DOUBLE:
+		var f float64
+		if err := json.Unmarshal(data, &f); err != nil {
+			return "", fmt.Errorf("decode %s: %w", valueType, err)
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+
+	case enums.INDEXED_VALUE_TYPE_BOOL:
+		var b bool
+		if err := json.Unmarshal(data, &b); err != nil {
+			return "", fmt.Errorf("decode %s: %w", valueType, err)
+		}
+		return strconv.FormatBool(b), nil
+
+	case enums.INDEXED_VALUE_TYPE_DATETIME:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", fmt.Errorf("decode %s: %w", valueType, err)
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return "", fmt.Errorf("decode %s: %w", valueType, err)
+		}
+		return t.Local().Format(searchAttributeTimeFormat), nil
+
+	case enums.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		var list []string
+		if err := json.Unmarshal(data, &list); err != nil {
+			// A single keyword is also accepted for keyword list attributes
+			var s string
+			if err2 := json.Unmarshal(data, &s); err2 != nil {
+				return "", fmt.Errorf("decode %s: %w", valueType, err)
+			}
+			return s, nil
+		}
+		return strings.Join(list, ", "), nil
+	}
+
+	// Unknown type: show JSON as-is, unquoting plain strings
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s, nil
+	}
+	return string(bytes.TrimSpace(data)), nil
+}
+
+// payloadValueType reads the indexed value type from a payload's "type" metadata.
+func payloadValueType(payload *commonpb.Payload) enums.IndexedValueType {
+	raw, ok := payload.GetMetadata()["type"]
+	if !ok {
+		return enums.INDEXED_VALUE_TYPE_UNSPECIFIED
+	}
+	valueType, err := enums.IndexedValueTypeFromString(string(raw))
+	if err != nil {
+		return enums.INDEXED_VALUE_TYPE_UNSPECIFIED
+	}
+	return valueType
+}
+
+// decodeSearchAttributes decodes all indexed fields into display strings.
+// Values that fail to decode are shown as their raw payload data.
+func decodeSearchAttributes(sa *commonpb.SearchAttributes) map[string]string {
+	fields := sa.GetIndexedFields()
+	if len(fields) == 0 {
+		return nil
+	}
+	attrs := make(map[string]string, len(fields))
+	for name, payload := range fields {
+		value, err := decodeSearchAttribute(payload, enums.INDEXED_VALUE_TYPE_UNSPECIFIED)
+		if err != nil {
+			value = string(payload.GetData())
+		}
+		attrs[name] = value
+	}
+	return attrs
+}
+
+// decodeUpsertedSearchAttributes decodes the fields of a search attribute
+// upsert. Unlike decodeSearchAttributes, fields with an empty payload are
+// kept with an empty value, since an upsert uses them to remove an attribute.
+func decodeUpsertedSearchAttributes(sa *commonpb.SearchAttributes) map[string]string {
+	fields := sa.GetIndexedFields()
+	if len(fields) == 0 {
+		return nil
+	}
+	attrs := make(map[string]string, len(fields))
+	for name, payload := range fields {
+		value, err := decodeSearchAttribute(payload, enums.INDEXED_VALUE_TYPE_UNSPECIFIED)
+		if err != nil {
+			value = string(payload.GetData())
+		}
+		attrs[name] = value
+	}
+	return attrs
+}
+
+// formatSearchAttributeList renders attributes as "Name=value" pairs sorted
+// by name, with removed attributes shown as "Name=(removed)".
+func formatSearchAttributeList(attrs map[string]string) string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		value := attrs[name]
+		if value == "" {
+			value = "(removed)"
+		}
+		pairs[i] = name + "=" + value
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// UpsertedSearchAttribute is the latest value of a search attribute as
+// recorded in a workflow's history.
+type UpsertedSearchAttribute struct {
+	Name     string
+	Value    string
+	EventID  int64 // The started event, or the upsert that last set it
+	Time     time.Time
+	Upserted bool // Set by an upsert rather than at start
+}
+
+// LatestSearchAttributes replays the started event and search attribute
+// upserts in events and returns the attributes still set, sorted by name.
+func LatestSearchAttributes(events []EnhancedHistoryEvent) []UpsertedSearchAttribute {
+	latest := make(map[string]UpsertedSearchAttribute)
+	for _, ev := range events {
+		for name, value := range ev.SearchAttributes {
+			if value == "" {
+				delete(latest, name)
+				continue
+			}
+			latest[name] = UpsertedSearchAttribute{
+				Name:     name,
+				Value:    value,
+				EventID:  ev.ID,
+				Time:     ev.Time,
+				Upserted: ev.Type != "WorkflowExecutionStarted",
+			}
+		}
+	}
+
+	attrs := make([]UpsertedSearchAttribute, 0, len(latest))
+	for _, attr := range latest {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Name < attrs[j].Name })
+	return attrs
+}
+
+// GetSearchAttributes returns the latest value of each search attribute the
+// workflow started with or upserted, read from its history.
+func (c *Client) GetSearchAttributes(ctx context.Context, namespace, workflowID, runID string) ([]UpsertedSearchAttribute, error) {
+	events, err := c.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return LatestSearchAttributes(events), nil
+}
+
+// SearchAttributeDefinition is a search attribute registered in a namespace.
+type SearchAttributeDefinition struct {
+	Name   string
+	Type   string // Indexed value type, e.g. "Keyword" or "Datetime"
+	System bool   // Built into the server rather than registered by an operator
+}
+
+// BuiltinSearchAttributes are the system search attributes every server
+// indexes, used when a namespace's attributes cannot be listed.
+var BuiltinSearchAttributes = []SearchAttributeDefinition{
+	{Name: "BuildIds", Type: "KeywordList", System: true},
+	{Name: "CloseTime", Type: "Datetime", System: true},
+	{Name: "ExecutionDuration", Type: "Int", System: true},
+	{Name: "ExecutionStatus", Type: "Keyword", System: true},
+	{Name: "ExecutionTime", Type: "Datetime", System: true},
+	{Name: "HistoryLength", Type: "Int", System: true},
+	{Name: "HistorySizeBytes", Type: "Int", System: true},
+	{Name: "ParentWorkflowId", Type: "Keyword", System: true},
+	{Name: "RootWorkflowId", Type: "Keyword", System: true},
+	{Name: "RunId", Type: "Keyword", System: true},
+	{Name: "StartTime", Type: "Datetime", System: true},
+	{Name: "StateTransitionCount", Type: "Int", System: true},
+	{Name: "TaskQueue", Type: "Keyword", System: true},
+	{Name: "TemporalChangeVersion", Type: "KeywordList", System: true},
+	{Name: "WorkflowId", Type: "Keyword", System: true},
+	{Name: "WorkflowType", Type: "Keyword", System: true},
+}
+
+// ListSearchAttributes returns the system and custom search attributes
+// registered in a namespace, sorted by name.
+func (c *Client) ListSearchAttributes(ctx context.Context, namespace string) ([]SearchAttributeDefinition, error) {
+	resp, err := c.client.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search attributes: %w", err)
+	}
+
+	attrs := make([]SearchAttributeDefinition, 0, len(resp.GetSystemAttributes())+len(resp.GetCustomAttributes()))
+	for name, valueType := range resp.GetSystemAttributes() {
+		attrs = append(attrs, SearchAttributeDefinition{Name: name, Type: valueType.String(), System: true})
+	}
+	for name, valueType := range resp.GetCustomAttributes() {
+		attrs = append(attrs, SearchAttributeDefinition{Name: name, Type: valueType.String()})
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Name < attrs[j].Name })
+	return attrs, nil
+}
@@ -0,0 +1,191 @@
+package temporal
+
+import (
+	"testing"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enums "go.temporal.io/api/enums/v1"
+)
+
+func saPayload(data, valueType string) *commonpb.Payload {
+	p := &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("json/plain")},
+		Data:     []byte(data),
+	}
+	if valueType != "" {
+		p.Metadata["type"] = []byte(valueType)
+	}
+	return p
+}
+
+func TestDecodeSearchAttribute(t *testing.T) {
+	ts := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		payload   *commonpb.Payload
+		valueType enums.IndexedValueType
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "keyword",
+			payload:   saPayload(`"order-123"`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_KEYWORD,
+			want:      "order-123",
+		},
+		{
+			name:      "text",
+			payload:   saPayload(`"free form text"`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_TEXT,
+			want:      "free form text",
+		},
+		{
+			name:      "int",
+			payload:   saPayload(`42`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_INT,
+			want:      "42",
+		},
+		{
+			name:      "int beyond float precision",
+			payload:   saPayload(`9007199254740993`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_INT,
+			want:      "9007199254740993",
+		},
+		{
+			name:      "double",
+			payload:   saPayload(`3.25`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_DOUBLE,
+			want:      "3.25",
+		},
+		{
+			name:      "bool",
+			payload:   saPayload(`true`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_BOOL,
+			want:      "true",
+		},
+		{
+			name:      "datetime",
+			payload:   saPayload(`"2024-03-15T14:30:00Z"`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_DATETIME,
+			want:      ts.Local().Format(searchAttributeTimeFormat),
+		},
+		{
+			name:      "keyword list",
+			payload:   saPayload(`["red","green","blue"]`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+			want:      "red, green, blue",
+		},
+		{
+			name:      "keyword list with single value",
+			payload:   saPayload(`"red"`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+			want:      "red",
+		},
+		{
+			name:    "type from metadata",
+			payload: saPayload(`["a","b"]`, "KeywordList"),
+			want:    "a, b",
+		},
+		{
+			name:    "datetime type from metadata",
+			payload: saPayload(`"2024-03-15T14:30:00Z"`, "Datetime"),
+			want:    ts.Local().Format(searchAttributeTimeFormat),
+		},
+		{
+			name:    "unknown type unquotes strings",
+			payload: saPayload(`"plain"`, ""),
+			want:    "plain",
+		},
+		{
+			name:    "unknown type keeps raw JSON",
+			payload: saPayload(`{"k":1}`, ""),
+			want:    `{"k":1}`,
+		},
+		{
+			name:      "nil payload",
+			payload:   nil,
+			valueType: enums.INDEXED_VALUE_TYPE_KEYWORD,
+			want:      "",
+		},
+		{
+			name:      "mismatched type",
+			payload:   saPayload(`"not a number"`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_INT,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid datetime",
+			payload:   saPayload(`"yesterday"`, ""),
+			valueType: enums.INDEXED_VALUE_TYPE_DATETIME,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeSearchAttribute(tt.payload, tt.valueType)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeSearchAttributes(t *testing.T) {
+	sa := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomIntField":  saPayload(`7`, "Int"),
+			"CustomBoolField": saPayload(`false`, "Bool"),
+			"Broken":          saPayload(`oops`, "Int"),
+		},
+	}
+
+	got := decodeSearchAttributes(sa)
+	want := map[string]string{
+		"CustomIntField":  "7",
+		"CustomBoolField": "false",
+		"Broken":          "oops",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %q, want %q", k, got[k], v)
+		}
+	}
+
+	if decodeSearchAttributes(nil) != nil {
+		t.Error("expected nil for nil search attributes")
+	}
+}
+
+func TestLatestSearchAttributes(t *testing.T) {
+	events := []EnhancedHistoryEvent{
+		{ID: 1, Type: "WorkflowExecutionStarted", SearchAttributes: map[string]string{"CustomerId": "c-1", "Stage": "new"}},
+		{ID: 5, Type: "UpsertWorkflowSearchAttributes", SearchAttributes: map[string]string{"Stage": "paid", "Priority": "2"}},
+		{ID: 9, Type: "UpsertWorkflowSearchAttributes", SearchAttributes: map[string]string{"Priority": ""}},
+	}
+
+	got := LatestSearchAttributes(events)
+	want := []UpsertedSearchAttribute{
+		{Name: "CustomerId", Value: "c-1", EventID: 1},
+		{Name: "Stage", Value: "paid", EventID: 5, Upserted: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d attributes, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("attribute %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -2,20 +2,69 @@ package temporal
 
 import (
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"go.temporal.io/api/enums/v1"
 )
 
 // Typed workflow status handles - use these for compile-time safe color/icon access.
 var (
-	StatusRunning    = theme.DefineStatus("Running", theme.Info, theme.IconRunning)
-	StatusCompleted  = theme.DefineStatus("Completed", theme.Success, theme.IconCompleted)
-	StatusFailed     = theme.DefineStatus("Failed", theme.Error, theme.IconFailed)
-	StatusCanceled   = theme.DefineStatus("Canceled", theme.Warning, theme.IconCanceled)
-	StatusTerminated = theme.DefineStatus("Terminated", theme.Error, theme.IconStop)
-	StatusTimedOut   = theme.DefineStatus("TimedOut", theme.Warning, theme.IconTimedOut)
-	StatusUnknown    = theme.DefineStatus("Unknown", theme.FgDim, theme.IconPending)
+	StatusRunning    *theme.Status
+	StatusCompleted  *theme.Status
+	StatusFailed     *theme.Status
+	StatusCanceled   *theme.Status
+	StatusTerminated *theme.Status
+	StatusTimedOut   *theme.Status
+	StatusContinued  *theme.Status
+	StatusUnknown    *theme.Status
 )
 
+// Typed namespace state handles.
+var (
+	NamespaceStateActive     *theme.Status
+	NamespaceStateDeprecated *theme.Status
+	NamespaceStateDeleted    *theme.Status
+	NamespaceStateUnknown    *theme.Status
+)
+
+// Task queue type handles.
+var (
+	TaskQueueTypeWorkflowStatus *theme.Status
+	TaskQueueTypeActivityStatus *theme.Status
+	TaskQueueTypeUnknownStatus  *theme.Status
+)
+
+func init() {
+	defineStatuses()
+}
+
+// UseIconSet switches the active icon set and redefines the status handles
+// so they pick up the new glyphs. Call before building any views.
+func UseIconSet(s icons.Set) {
+	icons.Use(s)
+	defineStatuses()
+}
+
+// defineStatuses (re)creates all status handles from the active icon set.
+func defineStatuses() {
+	StatusRunning = theme.DefineStatus("Running", theme.Info, icons.Running)
+	StatusCompleted = theme.DefineStatus("Completed", theme.Success, icons.Completed)
+	StatusFailed = theme.DefineStatus("Failed", theme.Error, icons.Failed)
+	StatusCanceled = theme.DefineStatus("Canceled", theme.Warning, icons.Canceled)
+	StatusTerminated = theme.DefineStatus("Terminated", theme.Error, icons.Stop)
+	StatusTimedOut = theme.DefineStatus("TimedOut", theme.Warning, icons.TimedOut)
+	StatusContinued = theme.DefineStatus("ContinuedAsNew", theme.Info, icons.ArrowRight)
+	StatusUnknown = theme.DefineStatus("Unknown", theme.FgDim, icons.Pending)
+
+	NamespaceStateActive = theme.DefineStatus("Active", theme.Success, icons.Check)
+	NamespaceStateDeprecated = theme.DefineStatus("Deprecated", theme.Warning, icons.Warning)
+	NamespaceStateDeleted = theme.DefineStatus("Deleted", theme.Error, icons.Delete)
+	NamespaceStateUnknown = theme.DefineStatus("Unknown", theme.FgDim, icons.Pending)
+
+	TaskQueueTypeWorkflowStatus = theme.DefineStatus("Workflow", theme.Info, icons.Workflow)
+	TaskQueueTypeActivityStatus = theme.DefineStatus("Activity", theme.Info, icons.Activity)
+	TaskQueueTypeUnknownStatus = theme.DefineStatus("Unknown", theme.FgDim, icons.Pending)
+}
+
 // MapWorkflowStatus converts a Temporal SDK workflow execution status to a display string.
 func MapWorkflowStatus(status enums.WorkflowExecutionStatus) string {
 	switch status {
@@ -32,7 +81,7 @@ func MapWorkflowStatus(status enums.WorkflowExecutionStatus) string {
 	case enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
 		return "TimedOut"
 	case enums.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW:
-		return "Completed" // Treat ContinuedAsNew as completed for display
+		return "ContinuedAsNew"
 	default:
 		return "Unknown"
 	}
@@ -53,19 +102,13 @@ func GetWorkflowStatus(status string) *theme.Status {
 		return StatusTerminated
 	case "TimedOut":
 		return StatusTimedOut
+	case "ContinuedAsNew":
+		return StatusContinued
 	default:
 		return StatusUnknown
 	}
 }
 
-// Typed namespace state handles.
-var (
-	NamespaceStateActive     = theme.DefineStatus("Active", theme.Success, theme.IconCheck)
-	NamespaceStateDeprecated = theme.DefineStatus("Deprecated", theme.Warning, theme.IconWarning)
-	NamespaceStateDeleted    = theme.DefineStatus("Deleted", theme.Error, theme.IconDelete)
-	NamespaceStateUnknown    = theme.DefineStatus("Unknown", theme.FgDim, theme.IconPending)
-)
-
 // MapNamespaceState converts a Temporal SDK namespace state to a display string.
 func MapNamespaceState(state enums.NamespaceState) string {
 	switch state {
@@ -94,13 +137,6 @@ func GetNamespaceState(state string) *theme.Status {
 	}
 }
 
-// Task queue type handles.
-var (
-	TaskQueueTypeWorkflowStatus = theme.DefineStatus("Workflow", theme.Info, theme.IconWorkflow)
-	TaskQueueTypeActivityStatus = theme.DefineStatus("Activity", theme.Info, theme.IconActivity)
-	TaskQueueTypeUnknownStatus  = theme.DefineStatus("Unknown", theme.FgDim, theme.IconPending)
-)
-
 // Task queue type string constants (for data storage).
 const (
 	TaskQueueTypeWorkflow = "Workflow"
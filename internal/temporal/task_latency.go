@@ -0,0 +1,62 @@
+package temporal
+
+import (
+	"strings"
+	"time"
+)
+
+// WorkflowTaskLatency summarizes workflow task timings for a run.
+// Dispatch latency (Scheduled → Started) is time spent waiting for a worker to
+// poll the task; high values indicate worker saturation. Execution latency
+// (Started → Completed) is time the worker spent running the task.
+type WorkflowTaskLatency struct {
+	Dispatched   int // Tasks with a paired Scheduled and Started event
+	DispatchAvg  time.Duration
+	DispatchMax  time.Duration
+	Executed     int // Tasks with a paired Started and Completed event
+	ExecutionAvg time.Duration
+	ExecutionMax time.Duration
+}
+
+// ComputeWorkflowTaskLatency pairs workflow task events by their scheduled and
+// started event IDs and aggregates dispatch and execution latency.
+func ComputeWorkflowTaskLatency(events []EnhancedHistoryEvent) WorkflowTaskLatency {
+	scheduled := make(map[int64]time.Time)
+	started := make(map[int64]time.Time)
+
+	var lat WorkflowTaskLatency
+	var dispatchTotal, executionTotal time.Duration
+	for _, ev := range events {
+		switch {
+		case strings.Contains(ev.Type, "WorkflowTaskScheduled"):
+			scheduled[ev.ID] = ev.Time
+		case strings.Contains(ev.Type, "WorkflowTaskStarted"):
+			started[ev.ID] = ev.Time
+			if at, ok := scheduled[ev.ScheduledEventID]; ok {
+				d := ev.Time.Sub(at)
+				lat.Dispatched++
+				dispatchTotal += d
+				if d > lat.DispatchMax {
+					lat.DispatchMax = d
+				}
+			}
+		case strings.Contains(ev.Type, "WorkflowTaskCompleted"):
+			if at, ok := started[ev.StartedEventID]; ok {
+				d := ev.Time.Sub(at)
+				lat.Executed++
+				executionTotal += d
+				if d > lat.ExecutionMax {
+					lat.ExecutionMax = d
+				}
+			}
+		}
+	}
+
+	if lat.Dispatched > 0 {
+		lat.DispatchAvg = dispatchTotal / time.Duration(lat.Dispatched)
+	}
+	if lat.Executed > 0 {
+		lat.ExecutionAvg = executionTotal / time.Duration(lat.Executed)
+	}
+	return lat
+}
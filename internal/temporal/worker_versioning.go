@@ -0,0 +1,108 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// reachabilityBuildIDLimit is the number of build IDs the server accepts in
+// one reachability request by default.
+const reachabilityBuildIDLimit = 5
+
+// TaskQueueVersioning describes the worker build IDs registered on a task
+// queue with build-ID-based worker versioning.
+type TaskQueueVersioning struct {
+	DefaultBuildID string
+	VersionSets    []BuildIDVersionSet // Oldest first; the last set holds the default
+	Reachability   map[string][]string // Build ID to the tasks it can still receive
+}
+
+// BuildIDVersionSet is a set of build IDs that are compatible with each other.
+type BuildIDVersionSet struct {
+	BuildIDs []string // Oldest first
+	Default  string   // The build ID new tasks in this set go to
+}
+
+// Versioned reports whether the task queue has any build IDs registered.
+func (v *TaskQueueVersioning) Versioned() bool {
+	return v != nil && len(v.VersionSets) > 0
+}
+
+// Drained reports whether a build ID can no longer receive tasks for open
+// workflows, so its workers can be shut down. It is false while reachability
+// is unknown.
+func (v *TaskQueueVersioning) Drained(buildID string) bool {
+	reach, ok := v.Reachability[buildID]
+	if !ok {
+		return false
+	}
+	for _, r := range reach {
+		if r != enums.TASK_REACHABILITY_CLOSED_WORKFLOWS.String() {
+			return false
+		}
+	}
+	return true
+}
+
+// GetTaskQueueVersioning returns a task queue's worker build ID version sets
+// and what each build ID can still be sent.
+func (c *Client) GetTaskQueueVersioning(ctx context.Context, namespace, taskQueue string) (*TaskQueueVersioning, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build ID compatibility: %w", err)
+	}
+
+	versioning := &TaskQueueVersioning{Reachability: make(map[string][]string)}
+	var buildIDs []string
+	for _, set := range resp.GetMajorVersionSets() {
+		ids := set.GetBuildIds()
+		if len(ids) == 0 {
+			continue
+		}
+		versioning.VersionSets = append(versioning.VersionSets, BuildIDVersionSet{
+			BuildIDs: ids,
+			Default:  ids[len(ids)-1],
+		})
+		buildIDs = append(buildIDs, ids...)
+	}
+	if !versioning.Versioned() {
+		return versioning, nil
+	}
+	versioning.DefaultBuildID = versioning.VersionSets[len(versioning.VersionSets)-1].Default
+
+	for start := 0; start < len(buildIDs); start += reachabilityBuildIDLimit {
+		batch := buildIDs[start:min(start+reachabilityBuildIDLimit, len(buildIDs))]
+		reachResp, err := c.client.WorkflowService().GetWorkerTaskReachability(ctx, &workflowservice.GetWorkerTaskReachabilityRequest{
+			Namespace:  namespace,
+			BuildIds:   batch,
+			TaskQueues: []string{taskQueue},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get build ID reachability: %w", err)
+		}
+		for _, b := range reachResp.GetBuildIdReachability() {
+			reach := []string{}
+			for _, tqr := range b.GetTaskQueueReachability() {
+				if tqr.GetTaskQueue() != taskQueue {
+					continue
+				}
+				for _, r := range tqr.GetReachability() {
+					reach = append(reach, r.String())
+				}
+			}
+			versioning.Reachability[b.GetBuildId()] = reach
+		}
+	}
+
+	return versioning, nil
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/atterpac/jig/nav"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/theme/themes"
+	"github.com/galaxy-io/tempo/internal/audit"
 	"github.com/galaxy-io/tempo/internal/command"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/gdamore/tcell/v2"
@@ -52,6 +54,15 @@ type App struct {
 
 	// Dev mode
 	devMode bool
+
+	// Operations sent from this session, for highlighting in history
+	sentOps sentOpLog
+
+	// Local record of mutating operations
+	auditLog *audit.Log
+
+	// Workflow types seen per namespace, for form completion
+	workflowTypes workflowTypeCache
 }
 
 // NewApp creates a new application controller with no provider (uses mock data).
@@ -67,20 +78,30 @@ func NewApp() *App {
 // NewAppWithProvider creates a new application controller with a Temporal provider.
 func NewAppWithProvider(provider temporal.Provider, defaultNamespace string, cfg *config.Config, activeProfile string) *App {
 	a := &App{
-		provider:      provider,
+		auditLog:      audit.New(audit.DefaultPath(), audit.DefaultMaxBytes),
 		currentNS:     defaultNamespace,
 		stopMonitor:   make(chan struct{}),
 		config:        cfg,
 		activeProfile: activeProfile,
 	}
+	if provider != nil {
+		a.provider = audit.Wrap(provider, a.auditLog)
+	}
+	if cfg != nil {
+		SetEventStyles(cfg.EventStyles)
+		SetMaxPayloadRenderBytes(cfg.GetMaxPayloadRenderBytes())
+		SetExplainEvents(cfg.ExplainEvents)
+	}
 	a.buildApp()
 	a.setup()
 
 	// Set initial profile name in stats bar (must be first - clears sections)
-	a.setProfile(activeProfile)
 	// Set initial connection status based on provider (adds section 2)
 	if provider != nil {
+		a.setProfile(activeProfile, provider.Config().Address)
 		a.setConnected(provider.IsConnected())
+	} else {
+		a.setProfile(activeProfile, "")
 	}
 	return a
 }
@@ -100,10 +121,10 @@ func (a *App) buildApp() {
 		TopBar:       a.statusBar,
 		TopBarHeight: 3,
 		ShowCrumbs:   true,
-		BottomBar:    a.menu,
+		BottomBar:    a.footerBar(),
 		OnComponentChange: func(c nav.Component) {
 			if c != nil {
-				a.menu.SetHints(c.Hints())
+				a.setFooterHints(c.Hints())
 			}
 			a.updateCrumbs()
 		},
@@ -202,6 +223,18 @@ func (a *App) setup() {
 			return nil
 		}
 
+		// Reload the active view's data (Ctrl+R) - works everywhere except modals
+		if event.Key() == tcell.KeyCtrlR && !isModalPage {
+			a.reloadCurrentView()
+			return nil
+		}
+
+		// Cycle the key hint footer: full, compact, off (F2) - works everywhere except modals
+		if event.Key() == tcell.KeyF2 && !isModalPage {
+			a.cycleFooter()
+			return nil
+		}
+
 		// Command bar (: key) - works everywhere except modals
 		if event.Rune() == ':' && !isModalPage {
 			a.showCommandBar()
@@ -257,20 +290,22 @@ func (a *App) updateCrumbs() {
 			path = []string{"Namespaces", a.currentNS, "Schedules"}
 		case "workflow-diff":
 			path = []string{"Namespaces", a.currentNS, "Workflows", "Diff"}
+		case "history-diff":
+			path = []string{"Namespaces", a.currentNS, "Workflows", "Diff", "History"}
 		}
 	}
 	a.app.Crumbs().SetPath(path)
 }
 
 // Status bar helpers
-// Section layout: [0] profile, [1] namespace, [2] connection status
+// Section layout: [0] profile, [1] namespace, [2] connection status, [3] data staleness
 
 func (a *App) setConnected(connected bool) {
-	icon := theme.IconDisconnected
+	icon := icons.Disconnected
 	text := "disconnected"
 	colorFunc := theme.Error
 	if connected {
-		icon = theme.IconConnected
+		icon = icons.Connected
 		text = "connected"
 		colorFunc = theme.Success
 	}
@@ -289,9 +324,12 @@ func (a *App) setConnected(connected bool) {
 	}
 }
 
-func (a *App) setProfile(name string) {
+func (a *App) setProfile(name, address string) {
 	a.statusBar.ClearSections()
-	// Section 0: profile (accent color, no icon)
+	// Section 0: profile and its cluster address (accent color, no icon)
+	if address != "" {
+		name += fmt.Sprintf(" [%s]@ %s[-]", theme.TagFgDim(), address)
+	}
 	a.statusBar.AddSection(layout.StatusSection{
 		Text:      name,
 		ColorFunc: theme.Accent,
@@ -315,6 +353,12 @@ type WorkflowStats struct {
 	Running   int
 	Completed int
 	Failed    int
+
+	// Set from server-side counts; the other statuses are shown when non-zero
+	Canceled   int
+	Terminated int
+	TimedOut   int
+	Total      int // Every matching workflow, 0 when only the loaded rows were counted
 }
 
 // SetWorkflowStats updates the workflow statistics in the status bar (right-aligned).
@@ -337,6 +381,25 @@ func (a *App) SetWorkflowStats(stats WorkflowStats) {
 	a.statusBar.AddRightSection(layout.StatusSection{
 		Text: fmt.Sprintf("[%s]Failed:[-] [%s]%d[-]", dimTag, failedColor, stats.Failed),
 	})
+	for _, other := range []struct {
+		label string
+		count int
+	}{
+		{"Canceled", stats.Canceled},
+		{"Terminated", stats.Terminated},
+		{"Timed Out", stats.TimedOut},
+	} {
+		if other.count > 0 {
+			a.statusBar.AddRightSection(layout.StatusSection{
+				Text: fmt.Sprintf("[%s]%s:[-] [%s]%d[-]", dimTag, other.label, theme.TagWarning(), other.count),
+			})
+		}
+	}
+	if stats.Total > 0 {
+		a.statusBar.AddRightSection(layout.StatusSection{
+			Text: fmt.Sprintf("[%s]Total:[-] [%s]%d[-]", dimTag, theme.TagFg(), stats.Total),
+		})
+	}
 }
 
 // ClearWorkflowStats removes workflow statistics from the status bar.
@@ -357,6 +420,11 @@ func (a *App) Provider() temporal.Provider {
 	return a.provider
 }
 
+// AuditLog returns the local log of mutating operations.
+func (a *App) AuditLog() *audit.Log {
+	return a.auditLog
+}
+
 // SetNamespace sets the current namespace context.
 // Thread-safe: can be called from any goroutine.
 func (a *App) SetNamespace(ns string) {
@@ -387,9 +455,11 @@ func (a *App) NavigateToWorkflowDetail(workflowID, runID string) {
 	a.app.Pages().Push(wd)
 }
 
-// NavigateToEvents pushes the event history view.
-func (a *App) NavigateToEvents(workflowID, runID string) {
+// NavigateToEvents pushes the event history view. A positive limit loads only
+// that many of the most recent events.
+func (a *App) NavigateToEvents(workflowID, runID string, limit int) {
 	ev := NewEventHistory(a, workflowID, runID)
+	ev.historyLimit = limit
 	a.app.Pages().Push(ev)
 }
 
@@ -405,6 +475,19 @@ func (a *App) NavigateToSchedules() {
 	a.app.Pages().Push(sl)
 }
 
+// NavigateToAuditLog pushes the audit log view.
+func (a *App) NavigateToAuditLog() {
+	al := NewAuditLogView(a)
+	a.app.Pages().Push(al)
+}
+
+// NavigateToBatchOperations pushes the batch job view for a namespace.
+func (a *App) NavigateToBatchOperations(namespace string) {
+	a.SetNamespace(namespace)
+	bv := NewBatchOperationsView(a, namespace)
+	a.app.Pages().Push(bv)
+}
+
 // NavigateToNamespaceDetail pushes the namespace detail view.
 func (a *App) NavigateToNamespaceDetail(namespace string) {
 	nd := NewNamespaceDetail(a, namespace)
@@ -423,6 +506,18 @@ func (a *App) NavigateToWorkflowDiffEmpty() {
 	a.app.Pages().Push(wd)
 }
 
+// NavigateToHistoryDiff pushes a shape diff of two exported history files.
+func (a *App) NavigateToHistoryDiff(pathA, pathB string) {
+	hd := NewHistoryDiffView(a, pathA, pathB)
+	a.app.Pages().Push(hd)
+}
+
+// NavigateToReplay pushes a replay of a workflow run against local workflow code.
+func (a *App) NavigateToReplay(workflowID, runID, workflowType string) {
+	rv := NewReplayView(a, workflowID, runID, workflowType)
+	a.app.Pages().Push(rv)
+}
+
 // NavigateToWorkflowGraph pushes the workflow graph view.
 func (a *App) NavigateToWorkflowGraph(workflow *temporal.Workflow) {
 	wg := NewWorkflowGraphView(a, a.CurrentNamespace(), workflow)
@@ -438,6 +533,7 @@ func (a *App) Run() error {
 
 	if hasProvider && a.stopMonitor != nil {
 		go a.connectionMonitor()
+		go a.stalenessMonitor()
 	}
 
 	// Check for updates if enabled
@@ -479,7 +575,7 @@ func (a *App) checkForUpdates() {
 	}
 
 	a.app.QueueUpdateDraw(func() {
-		a.toasts.Success("Updated, restart plz " + theme.IconHeart)
+		a.toasts.Success("Updated, restart plz " + icons.Heart)
 	})
 }
 
@@ -515,6 +611,11 @@ func (a *App) ToastError(message string) {
 	a.toasts.Error(message)
 }
 
+// ToastWarning displays a warning toast (call from within QueueUpdateDraw).
+func (a *App) ToastWarning(message string) {
+	a.toasts.Warning(message)
+}
+
 // connectionMonitor periodically checks the connection and attempts reconnection if needed.
 func (a *App) connectionMonitor() {
 	ticker := time.NewTicker(connectionCheckInterval)
@@ -690,6 +791,8 @@ func (a *App) showHintSheet() {
 		{Key: "?", Description: "Help"},
 		{Key: "T", Description: "Theme"},
 		{Key: "P", Description: "Profile"},
+		{Key: "Ctrl+R", Description: "Reload"},
+		{Key: "F2", Description: "Footer"},
 		{Key: "Esc", Description: "Back"},
 		{Key: "q", Description: "Quit"},
 	}
@@ -820,7 +923,7 @@ func (a *App) showCommandBar() {
 
 	// Set up tab completion with built-in + user commands
 	a.statusBar.SetOnComplete(func(input string) []string {
-		builtins := []string{"profile"}
+		builtins := []string{"profile", "ns", "audit", "batch", "historydiff", "token"}
 		var userCmds []string
 		if a.config != nil {
 			userCmds = a.config.ListCommandNames(a.activeProfile)
@@ -1174,21 +1277,40 @@ func (a *App) SwitchProfile(name string) {
 		TLSSkipVerify: profileCfg.TLS.SkipVerify,
 		APIKey:        profileCfg.APIKey,
 		GRPCMeta:      profileCfg.GRPCMeta,
+		CodecEndpoint: profileCfg.CodecEndpoint,
+		CodecAuth:     profileCfg.CodecAuth,
+	}
+	if auth := profileCfg.AuthToken; auth != nil {
+		connConfig.AuthHeader = auth.Header
+		connConfig.AuthScheme = auth.Scheme
+		token, err := auth.InitialToken()
+		if err != nil {
+			a.ToastWarning(fmt.Sprintf("%v; set a token with :token", err))
+		}
+		connConfig.AuthToken = token
 	}
 
+	previous := provider.Config()
+
 	// Stop current views
 	if current := a.app.Pages().Current(); current != nil {
 		current.Stop()
 	}
 
 	// Update UI to show connecting state (setProfile must be first - clears sections)
-	a.setProfile(name + " (connecting...)")
+	a.setProfile(name+" (connecting...)", connConfig.Address)
 	a.setConnected(false)
 
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		err := provider.ReconnectWithConfig(ctx, connConfig)
 		cancel()
+		if err != nil {
+			// Go back to the cluster we were on rather than leave tempo disconnected
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			_ = provider.ReconnectWithConfig(ctx, previous)
+			cancel()
+		}
 
 		// Update state before QueueUpdateDraw to avoid deadlock
 		if err == nil {
@@ -1203,12 +1325,16 @@ func (a *App) SwitchProfile(name string) {
 
 		a.app.QueueUpdateDraw(func() {
 			if err != nil {
-				a.setProfile(currentProfile + " (failed)")
-				a.setConnected(false)
+				a.setProfile(currentProfile, previous.Address)
+				a.setConnected(provider.IsConnected())
+				a.ToastError(fmt.Sprintf("Failed to switch to profile %s: %v", name, err))
+				if current := a.app.Pages().Current(); current != nil {
+					current.Start()
+				}
 				return
 			}
 
-			a.setProfile(name)
+			a.setProfile(name, connConfig.Address)
 			a.setConnected(true)
 			a.setNamespace(connConfig.Namespace)
 
@@ -1276,6 +1402,14 @@ func (a *App) Config() *config.Config {
 	return a.config
 }
 
+// PageSize returns the configured number of rows fetched per list request.
+func (a *App) PageSize() int {
+	if a.config == nil {
+		return config.DefaultPageSize
+	}
+	return a.config.GetPageSize()
+}
+
 // FilterModeCallbacks holds callbacks for filter mode.
 type FilterModeCallbacks struct {
 	OnSubmit func(text string)
@@ -1417,6 +1551,16 @@ func (a *App) handleCommandInput(text string) {
 	if strings.HasPrefix(text, "profile") {
 		cmdArgs := strings.TrimPrefix(text, "profile")
 		a.handleProfileCommand(strings.TrimSpace(cmdArgs))
+	} else if cmdName == "ns" {
+		a.handleNamespaceCommand(args)
+	} else if text == "audit" {
+		a.NavigateToAuditLog()
+	} else if text == "batch" {
+		a.NavigateToBatchOperations(a.CurrentNamespace())
+	} else if cmdName == "historydiff" {
+		a.handleHistoryDiffCommand(args)
+	} else if text == "token" {
+		a.showAuthTokenForm()
 	} else {
 		a.toasts.Warning(fmt.Sprintf("Unknown command: %s", cmdName))
 	}
@@ -1425,6 +1569,22 @@ func (a *App) handleCommandInput(text string) {
 	a.refocusCurrent()
 }
 
+// handleHistoryDiffCommand opens the shape diff of two exported histories:
+// :historydiff <a.json> <b.json>
+func (a *App) handleHistoryDiffCommand(args []string) {
+	if len(args) != 2 {
+		a.toasts.Warning("Usage: historydiff <history-a.json> <history-b.json>")
+		return
+	}
+	pathA, errA := resolvePayloadPath(args[0])
+	pathB, errB := resolvePayloadPath(args[1])
+	if errA != nil || errB != nil {
+		a.toasts.Warning("Usage: historydiff <history-a.json> <history-b.json>")
+		return
+	}
+	a.NavigateToHistoryDiff(pathA, pathB)
+}
+
 // buildCommandContext resolves all context variables from current app state.
 func (a *App) buildCommandContext(args []string) command.Context {
 	ctx := command.Context{
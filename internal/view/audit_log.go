@@ -0,0 +1,240 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/input"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/audit"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// auditLogLimit caps how many entries the audit view loads.
+const auditLogLimit = 500
+
+// AuditLogView lists the mutating operations recorded by this machine.
+type AuditLogView struct {
+	*components.MasterDetailView
+	app     *App
+	table   *components.Table
+	preview *tview.TextView
+	entries []audit.Entry
+}
+
+// NewAuditLogView creates a new audit log view.
+func NewAuditLogView(app *App) *AuditLogView {
+	al := &AuditLogView{
+		app:     app,
+		table:   components.NewTable(),
+		preview: tview.NewTextView(),
+	}
+	al.setup()
+
+	// Register for automatic theme refresh
+	theme.RegisterRefreshable(al)
+
+	return al
+}
+
+func (al *AuditLogView) setup() {
+	al.table.SetHeaders("TIME", "ACTION", "TARGET", "OUTCOME")
+	al.table.SetBorder(false)
+	al.table.SetBackgroundColor(theme.Bg())
+
+	al.preview.SetDynamicColors(true)
+	al.preview.SetBackgroundColor(theme.Bg())
+	al.preview.SetTextColor(theme.Fg())
+	al.preview.SetWordWrap(true)
+
+	al.MasterDetailView = components.NewMasterDetailView().
+		SetMasterTitle(fmt.Sprintf("%s My Actions", icons.Database)).
+		SetDetailTitle(fmt.Sprintf("%s Details", icons.Info)).
+		SetMasterContent(al.table).
+		SetDetailContent(al.preview).
+		SetRatio(0.6).
+		ConfigureEmpty(icons.Info, "No Actions", "Mutating operations you perform are recorded here")
+
+	al.table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(al.entries) {
+			al.updatePreview(al.entries[row-1])
+		}
+	})
+
+	al.table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(al.entries) {
+			al.openTarget(al.entries[row])
+		}
+	})
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (al *AuditLogView) RefreshTheme() {
+	bg := theme.Bg()
+	al.table.SetBackgroundColor(bg)
+	al.preview.SetBackgroundColor(bg)
+	al.preview.SetTextColor(theme.Fg())
+	al.populateTable()
+}
+
+func (al *AuditLogView) loadData() {
+	log := al.app.AuditLog()
+	if log == nil {
+		al.entries = nil
+		al.populateTable()
+		return
+	}
+
+	entries, err := log.Recent(auditLogLimit)
+	if err != nil {
+		al.showError(err)
+		return
+	}
+	al.entries = entries
+	al.populateTable()
+}
+
+func (al *AuditLogView) populateTable() {
+	currentRow := al.table.SelectedRow()
+
+	al.table.ClearRows()
+	al.table.SetHeaders("TIME", "ACTION", "TARGET", "OUTCOME")
+
+	now := time.Now()
+	for _, e := range al.entries {
+		color := theme.Success()
+		if e.Outcome != audit.OutcomeSuccess {
+			color = theme.Error()
+		}
+		al.table.AddRowWithColor(color,
+			formatRelativeTime(now, e.Time),
+			e.Action,
+			truncate(e.Target, 40),
+			e.Outcome,
+		)
+	}
+
+	if len(al.entries) == 0 {
+		al.preview.SetText(fmt.Sprintf("[%s]No actions recorded yet.[-]", theme.TagFgDim()))
+		return
+	}
+	if currentRow < 0 || currentRow >= len(al.entries) {
+		currentRow = 0
+	}
+	al.table.SelectRow(currentRow)
+	al.updatePreview(al.entries[currentRow])
+}
+
+func (al *AuditLogView) updatePreview(e audit.Entry) {
+	var sb strings.Builder
+	field := func(label, value string) {
+		if value == "" {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("[%s]%s[-]\n[%s]%s[-]\n\n", theme.TagFgDim(), label, theme.TagFg(), tview.Escape(value)))
+	}
+
+	sb.WriteString(fmt.Sprintf("[%s::b]%s[-:-:-]\n\n", theme.TagAccent(), e.Action))
+	field("Time", e.Time.Local().Format("2006-01-02 15:04:05 MST"))
+	field("Namespace", e.Namespace)
+	field("Target", e.Target)
+	field("Run ID", e.RunID)
+	field("Detail", e.Detail)
+
+	outcomeColor := theme.TagSuccess()
+	if e.Outcome != audit.OutcomeSuccess {
+		outcomeColor = theme.TagError()
+	}
+	sb.WriteString(fmt.Sprintf("[%s]Outcome[-]\n[%s]%s[-]", theme.TagFgDim(), outcomeColor, e.Outcome))
+	if e.Error != "" {
+		sb.WriteString(fmt.Sprintf("\n[%s]%s[-]", theme.TagError(), tview.Escape(e.Error)))
+	}
+	al.preview.SetText(sb.String())
+}
+
+// openTarget navigates to the workflow an entry refers to.
+func (al *AuditLogView) openTarget(e audit.Entry) {
+	if e.RunID == "" || !strings.Contains(e.Action, "Workflow") {
+		return
+	}
+	if e.Namespace != "" && e.Namespace != al.app.CurrentNamespace() {
+		al.app.ToastWarning(fmt.Sprintf("Switch to namespace %s to open this workflow", e.Namespace))
+		return
+	}
+	al.app.NavigateToWorkflowDetail(e.Target, e.RunID)
+}
+
+func (al *AuditLogView) showError(err error) {
+	al.table.ClearRows()
+	al.table.SetHeaders("TIME", "ACTION", "TARGET", "OUTCOME")
+	al.table.AddRowWithColor(theme.Error(),
+		"",
+		icons.Error+" Error loading audit log",
+		err.Error(),
+		"",
+	)
+}
+
+// Name returns the view name.
+func (al *AuditLogView) Name() string {
+	return "audit"
+}
+
+// Reload rereads the audit log.
+func (al *AuditLogView) Reload() {
+	al.loadData()
+}
+
+// Start is called when the view becomes active.
+func (al *AuditLogView) Start() {
+	bindings := input.NewKeyBindings().
+		OnRune('r', func(e *tcell.EventKey) bool {
+			al.loadData()
+			return true
+		}).
+		OnRune('p', func(e *tcell.EventKey) bool {
+			al.ToggleDetail()
+			return true
+		})
+
+	al.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if bindings.Handle(event) {
+			return nil
+		}
+		return event
+	})
+	al.loadData()
+}
+
+// Stop is called when the view is deactivated.
+func (al *AuditLogView) Stop() {
+	al.table.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (al *AuditLogView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "r", Description: "Refresh"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Open workflow"},
+		{Key: "p", Description: "Preview"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the table.
+func (al *AuditLogView) Focus(delegate func(p tview.Primitive)) {
+	delegate(al.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (al *AuditLogView) Draw(screen tcell.Screen) {
+	bg := theme.Bg()
+	al.preview.SetBackgroundColor(bg)
+	al.preview.SetTextColor(theme.Fg())
+	al.MasterDetailView.Draw(screen)
+}
@@ -0,0 +1,81 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+)
+
+// showAuthTokenForm asks for a new auth token, e.g. pasted from an SSO flow,
+// and swaps it into the live connection without reconnecting.
+func (a *App) showAuthTokenForm() {
+	provider := a.Provider()
+	if provider == nil {
+		a.ToastWarning("Not connected to a Temporal server")
+		return
+	}
+
+	header := provider.Config().AuthHeader
+	if header == "" {
+		header = "authorization"
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Update Auth Token", icons.Info),
+		Width:    70,
+		Height:   9,
+		Backdrop: true,
+	})
+
+	form := components.NewFormBuilder().
+		Password("token", fmt.Sprintf("Token (sent in %q)", header)).
+		Validate(validators.Required()).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			a.app.Pages().DismissModal()
+			a.applyAuthToken(strings.TrimSpace(values["token"].(string)))
+		}).
+		OnCancel(func() {
+			a.app.Pages().DismissModal()
+		}).
+		Build()
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Update"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(form)
+}
+
+// applyAuthToken installs token on the provider and checks that the server
+// accepts it.
+func (a *App) applyAuthToken(token string) {
+	provider := a.Provider()
+	if provider == nil {
+		return
+	}
+	provider.SetAuthToken(token)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), connectionCheckTimeout)
+		defer cancel()
+		err := provider.CheckConnection(ctx)
+
+		a.app.QueueUpdateDraw(func() {
+			if err != nil {
+				a.setConnected(false)
+				a.ToastError(fmt.Sprintf("Token updated, but the server rejected the check: %v", err))
+				return
+			}
+			a.setConnected(true)
+			a.ToastSuccess("Auth token updated")
+		})
+	}()
+}
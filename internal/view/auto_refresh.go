@@ -0,0 +1,55 @@
+package view
+
+import (
+	"time"
+)
+
+// autoRefreshInterval is how often workflow detail and event history re-poll
+// a running workflow, matching the workflow list's auto-refresh.
+const autoRefreshInterval = 5 * time.Second
+
+// autoRefresher calls a view's refresh on an interval until stopped.
+type autoRefresher struct {
+	stopCh chan struct{}
+}
+
+// active reports whether auto-refresh is on.
+func (r *autoRefresher) active() bool {
+	return r.stopCh != nil
+}
+
+// start calls tick on the UI goroutine every autoRefreshInterval. Ticks
+// queued before stop are dropped.
+func (r *autoRefresher) start(app *App, tick func()) {
+	if r.stopCh != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	r.stopCh = stop
+	go func() {
+		ticker := time.NewTicker(autoRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				app.JigApp().QueueUpdateDraw(func() {
+					if r.stopCh != stop {
+						return
+					}
+					tick()
+				})
+			}
+		}
+	}()
+}
+
+// stop turns auto-refresh off if it is on.
+func (r *autoRefresher) stop() {
+	if r.stopCh != nil {
+		close(r.stopCh)
+		r.stopCh = nil
+	}
+}
@@ -0,0 +1,423 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/async"
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/input"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// BatchOperationsView lists a namespace's server-side batch jobs and their
+// progress. It refreshes itself while any job is running.
+type BatchOperationsView struct {
+	*components.MasterDetailView
+	freshness
+	app         *App
+	namespace   string
+	table       *components.Table
+	preview     *tview.TextView
+	jobs        []temporal.BatchOperation
+	loading     bool
+	stopRefresh chan struct{}
+}
+
+// NewBatchOperationsView creates a new batch operations view.
+func NewBatchOperationsView(app *App, namespace string) *BatchOperationsView {
+	bv := &BatchOperationsView{
+		app:       app,
+		namespace: namespace,
+		table:     components.NewTable(),
+		preview:   tview.NewTextView(),
+	}
+	bv.setup()
+
+	// Register for automatic theme refresh
+	theme.RegisterRefreshable(bv)
+
+	return bv
+}
+
+func (bv *BatchOperationsView) setup() {
+	bv.table.SetHeaders("JOB ID", "OPERATION", "STATE", "PROGRESS", "FAILED", "STARTED")
+	bv.table.SetBorder(false)
+	bv.table.SetBackgroundColor(theme.Bg())
+
+	bv.preview.SetDynamicColors(true)
+	bv.preview.SetBackgroundColor(theme.Bg())
+	bv.preview.SetTextColor(theme.Fg())
+	bv.preview.SetWordWrap(true)
+
+	bv.MasterDetailView = components.NewMasterDetailView().
+		SetMasterTitle(fmt.Sprintf("%s Batch Jobs", icons.Grid)).
+		SetDetailTitle(fmt.Sprintf("%s Details", icons.Info)).
+		SetMasterContent(bv.table).
+		SetDetailContent(bv.preview).
+		SetRatio(0.6).
+		ConfigureEmpty(icons.Info, "No Batch Jobs", "Batch operations started in this namespace are listed here")
+
+	bv.table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(bv.jobs) {
+			bv.preview.SetText(formatBatchJobProgress(&bv.jobs[row-1]))
+		}
+		bv.app.setFooterHints(bv.Hints())
+	})
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (bv *BatchOperationsView) RefreshTheme() {
+	bg := theme.Bg()
+	bv.table.SetBackgroundColor(bg)
+	bv.preview.SetBackgroundColor(bg)
+	bv.preview.SetTextColor(theme.Fg())
+	bv.populateTable()
+}
+
+func (bv *BatchOperationsView) loadData() {
+	provider := bv.app.Provider()
+	if provider == nil || bv.loading {
+		return
+	}
+
+	bv.loading = true
+	namespace := bv.namespace
+
+	async.NewLoader[[]temporal.BatchOperation]().
+		WithTimeout(15 * time.Second).
+		OnSuccess(func(jobs []temporal.BatchOperation) {
+			bv.jobs = jobs
+			bv.markLoaded()
+			bv.populateTable()
+		}).
+		OnError(func(err error) {
+			bv.showError(err)
+		}).
+		OnFinally(func() {
+			bv.loading = false
+		}).
+		Run(func(ctx context.Context) ([]temporal.BatchOperation, error) {
+			return provider.ListBatchOperations(ctx, namespace)
+		})
+}
+
+func (bv *BatchOperationsView) populateTable() {
+	currentRow := bv.table.SelectedRow()
+
+	bv.table.ClearRows()
+	bv.table.SetHeaders("JOB ID", "OPERATION", "STATE", "PROGRESS", "FAILED", "STARTED")
+
+	now := time.Now()
+	for _, job := range bv.jobs {
+		bv.table.AddRowWithColor(batchJobStateColor(job.State),
+			truncate(job.JobID, 40),
+			job.Operation,
+			job.State,
+			fmt.Sprintf("%d/%d", job.CompletedCount, job.TotalCount),
+			fmt.Sprintf("%d", job.FailedCount),
+			formatRelativeTime(now, job.StartTime),
+		)
+	}
+
+	if len(bv.jobs) == 0 {
+		bv.preview.SetText(fmt.Sprintf("[%s]No batch jobs in %s.[-]", theme.TagFgDim(), bv.namespace))
+		return
+	}
+	if currentRow < 0 || currentRow >= len(bv.jobs) {
+		currentRow = 0
+	}
+	bv.table.SelectRow(currentRow)
+	bv.preview.SetText(formatBatchJobProgress(&bv.jobs[currentRow]))
+}
+
+// batchJobStateColor colors a job row by its state.
+func batchJobStateColor(state string) tcell.Color {
+	switch state {
+	case "Running":
+		return temporal.StatusRunning.Color()
+	case "Completed":
+		return temporal.StatusCompleted.Color()
+	case "Failed":
+		return temporal.StatusFailed.Color()
+	}
+	return theme.FgDim()
+}
+
+func (bv *BatchOperationsView) showError(err error) {
+	bv.table.ClearRows()
+	bv.table.SetHeaders("JOB ID", "OPERATION", "STATE", "PROGRESS", "FAILED", "STARTED")
+	bv.table.AddRowWithColor(theme.Error(),
+		icons.Error+" Error loading batch jobs",
+		err.Error(),
+		"",
+		"",
+		"",
+		"",
+	)
+}
+
+func (bv *BatchOperationsView) selectedJob() *temporal.BatchOperation {
+	row := bv.table.SelectedRow()
+	if row >= 0 && row < len(bv.jobs) {
+		return &bv.jobs[row]
+	}
+	return nil
+}
+
+// hasRunningJobs reports whether any listed job is still running.
+func (bv *BatchOperationsView) hasRunningJobs() bool {
+	for _, job := range bv.jobs {
+		if !job.Done() {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshRunningJobs re-describes only the jobs still running, since the
+// others no longer change. A job that fails to describe keeps its last state.
+func (bv *BatchOperationsView) refreshRunningJobs() {
+	provider := bv.app.Provider()
+	if provider == nil || bv.loading {
+		return
+	}
+	var running []string
+	for _, job := range bv.jobs {
+		if !job.Done() {
+			running = append(running, job.JobID)
+		}
+	}
+	if len(running) == 0 {
+		return
+	}
+
+	bv.loading = true
+	namespace := bv.namespace
+
+	async.NewLoader[[]temporal.BatchOperation]().
+		WithTimeout(15 * time.Second).
+		OnSuccess(func(updated []temporal.BatchOperation) {
+			for _, job := range updated {
+				for i := range bv.jobs {
+					if bv.jobs[i].JobID == job.JobID {
+						bv.jobs[i] = job
+					}
+				}
+			}
+			bv.markLoaded()
+			bv.populateTable()
+		}).
+		OnFinally(func() {
+			bv.loading = false
+		}).
+		Run(func(ctx context.Context) ([]temporal.BatchOperation, error) {
+			var updated []temporal.BatchOperation
+			for _, jobID := range running {
+				if job, err := provider.DescribeBatchOperation(ctx, namespace, jobID); err == nil {
+					updated = append(updated, *job)
+				}
+			}
+			return updated, nil
+		})
+}
+
+// startAutoRefresh refreshes the running jobs every batchJobPollInterval
+// while any job is running.
+func (bv *BatchOperationsView) startAutoRefresh() {
+	stop := make(chan struct{})
+	bv.stopRefresh = stop
+	go func() {
+		ticker := time.NewTicker(batchJobPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				bv.app.JigApp().QueueUpdateDraw(func() {
+					if bv.hasRunningJobs() {
+						bv.refreshRunningJobs()
+					}
+				})
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (bv *BatchOperationsView) stopAutoRefresh() {
+	if bv.stopRefresh != nil {
+		close(bv.stopRefresh)
+		bv.stopRefresh = nil
+	}
+}
+
+// showStopConfirm asks for a reason and stops the selected running job.
+func (bv *BatchOperationsView) showStopConfirm() {
+	if bv.app.blockReadOnly() {
+		return
+	}
+	job := bv.selectedJob()
+	if job == nil {
+		return
+	}
+	if job.Done() {
+		bv.app.ToastWarning(fmt.Sprintf("Batch job %s is already %s", job.JobID, job.State))
+		return
+	}
+	jobID := job.JobID
+
+	form := components.NewFormBuilder().
+		Text("reason", "Reason (required)").
+			Placeholder("Enter reason for stopping the job").
+			Validate(validators.Required()).
+			Done().
+		OnSubmit(func(values map[string]any) {
+			reason := values["reason"].(string)
+			bv.closeModal()
+			bv.stopJob(jobID, reason)
+		}).
+		OnCancel(func() {
+			bv.closeModal()
+		}).
+		Build()
+
+	infoText := tview.NewTextView().SetDynamicColors(true)
+	infoText.SetBackgroundColor(theme.Bg())
+	infoText.SetText(fmt.Sprintf(`[%s]Job:[-] %s
+[%s]Processed:[-] %d of %d (not rolled back)`,
+		theme.TagFgDim(), tview.Escape(jobID),
+		theme.TagFgDim(), job.CompletedCount+job.FailedCount, job.TotalCount))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(infoText, 3, 0, false).
+		AddItem(form, 0, 1, true)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Stop %s Job", icons.Stop, job.Operation),
+		Width:    70,
+		Height:   12,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Stop job"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	bv.app.JigApp().Pages().Push(modal)
+	bv.app.JigApp().SetFocus(form)
+}
+
+func (bv *BatchOperationsView) stopJob(jobID, reason string) {
+	provider := bv.app.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := bv.namespace
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := provider.StopBatchOperation(ctx, namespace, jobID, reason)
+
+		bv.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				bv.app.ToastError(err.Error())
+				return
+			}
+			bv.app.ToastSuccess(fmt.Sprintf("Stopped batch job %s", jobID))
+			bv.loadData()
+		})
+	}()
+}
+
+func (bv *BatchOperationsView) closeModal() {
+	bv.app.JigApp().Pages().DismissModal()
+}
+
+// Name returns the view name.
+func (bv *BatchOperationsView) Name() string {
+	return "batch"
+}
+
+// Reload reloads the batch job list.
+func (bv *BatchOperationsView) Reload() {
+	bv.loadData()
+}
+
+// Start is called when the view becomes active.
+func (bv *BatchOperationsView) Start() {
+	bindings := input.NewKeyBindings().
+		OnRune('r', func(e *tcell.EventKey) bool {
+			bv.loadData()
+			return true
+		}).
+		OnRune('p', func(e *tcell.EventKey) bool {
+			bv.ToggleDetail()
+			return true
+		}).
+		OnRune('X', func(e *tcell.EventKey) bool {
+			bv.showStopConfirm()
+			return true
+		}).
+		OnRune('y', func(e *tcell.EventKey) bool {
+			if job := bv.selectedJob(); job != nil {
+				bv.app.showYankMenu([]yankTarget{{Key: 'i', Label: "Job ID", Value: job.JobID}})
+			}
+			return true
+		})
+
+	bv.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if bindings.Handle(event) {
+			return nil
+		}
+		return event
+	})
+	bv.loadData()
+	bv.startAutoRefresh()
+}
+
+// Stop is called when the view is deactivated.
+func (bv *BatchOperationsView) Stop() {
+	bv.table.SetInputCapture(nil)
+	bv.stopAutoRefresh()
+}
+
+// Hints returns keybinding hints for this view.
+func (bv *BatchOperationsView) Hints() []KeyHint {
+	hints := []KeyHint{
+		{Key: "r", Description: "Refresh"},
+		{Key: "j/k", Description: "Navigate"},
+	}
+	if job := bv.selectedJob(); job != nil && !job.Done() && !bv.app.readOnlyNamespace() {
+		hints = append(hints, KeyHint{Key: "X", Description: "Stop Job"})
+	}
+	hints = append(hints,
+		KeyHint{Key: "y", Description: "Yank"},
+		KeyHint{Key: "p", Description: "Preview"},
+		KeyHint{Key: "esc", Description: "Back"},
+	)
+	return hints
+}
+
+// Focus sets focus to the table.
+func (bv *BatchOperationsView) Focus(delegate func(p tview.Primitive)) {
+	delegate(bv.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (bv *BatchOperationsView) Draw(screen tcell.Screen) {
+	bg := theme.Bg()
+	bv.preview.SetBackgroundColor(bg)
+	bv.preview.SetTextColor(theme.Fg())
+	bv.MasterDetailView.Draw(screen)
+}
@@ -0,0 +1,139 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// blastRadiusDepth is how many levels of child workflows the terminate
+// preview resolves.
+const blastRadiusDepth = 5
+
+// blastRadius counts the descendants a termination may reach.
+type blastRadius struct {
+	total int
+	open  int // Still running, so their parent close policy applies
+}
+
+// measureBlastRadius counts nodes and their descendants.
+func measureBlastRadius(nodes []*temporal.WorkflowNode) blastRadius {
+	var br blastRadius
+	for _, n := range nodes {
+		br.total++
+		if n.Status == "Running" {
+			br.open++
+		}
+		sub := measureBlastRadius(n.Children)
+		br.total += sub.total
+		br.open += sub.open
+	}
+	return br
+}
+
+// showTerminatePreview resolves the workflow's descendants and, if it has
+// any, shows them before asking to confirm the termination.
+func (wd *WorkflowDetail) showTerminatePreview() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
+	provider := wd.app.Provider()
+	if provider == nil {
+		wd.showTerminateConfirm(blastRadius{})
+		return
+	}
+	namespace := wd.app.CurrentNamespace()
+	workflowID, runID := wd.workflowID, wd.runID
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		rel, err := provider.GetWorkflowRelationships(ctx, namespace, workflowID, runID, blastRadiusDepth)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.app.ToastWarning(fmt.Sprintf("Could not resolve child workflows: %v", err))
+				wd.showTerminateConfirm(blastRadius{})
+				return
+			}
+			if len(rel.Children) == 0 {
+				wd.showTerminateConfirm(blastRadius{})
+				return
+			}
+			wd.showBlastRadius(rel)
+		})
+	}()
+}
+
+// showBlastRadius lists the workflow and every resolved descendant with its
+// status. Enter continues to the terminate confirmation.
+func (wd *WorkflowDetail) showBlastRadius(rel *temporal.WorkflowRelationships) {
+	br := measureBlastRadius(rel.Children)
+
+	table := components.NewTable()
+	table.SetHeaders("WORKFLOW", "TYPE", "STATUS")
+	table.SetBorder(false)
+
+	var addRow func(w temporal.Workflow, depth int)
+	addRow = func(w temporal.Workflow, depth int) {
+		prefix := ""
+		if depth > 0 {
+			prefix = strings.Repeat("  ", depth-1) + "└ "
+		}
+		status := temporal.GetWorkflowStatus(w.Status)
+		row := table.Table.GetRowCount()
+		table.AddRow(prefix+w.ID, w.Type, status.Icon()+" "+w.Status)
+		table.GetCell(row, 2).SetTextColor(status.Color())
+	}
+	var addNodes func(nodes []*temporal.WorkflowNode, depth int)
+	addNodes = func(nodes []*temporal.WorkflowNode, depth int) {
+		for _, n := range nodes {
+			addRow(n.Workflow, depth)
+			addNodes(n.Children, depth+1)
+		}
+	}
+	addRow(*rel.Current, 0)
+	addNodes(rel.Children, 1)
+	table.SelectRow(0)
+
+	summary := tview.NewTextView().SetDynamicColors(true)
+	summary.SetBackgroundColor(theme.Bg())
+	summary.SetText(fmt.Sprintf("[%s::b]%d descendant workflows, %d still running.[-:-:-]\n[%s]Running children are terminated, cancelled, or abandoned according to their parent close policy.[-]",
+		theme.TagWarning(), br.total, br.open, theme.TagFgDim()))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(summary, 3, 0, false)
+	content.AddItem(table, 0, 1, true)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Terminate: Blast Radius", icons.Warning),
+		Width:    100,
+		Height:   min(br.total+10, 30),
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Continue"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal()
+	})
+	table.SetOnSelect(func(row int) {
+		wd.closeModal()
+		wd.showTerminateConfirm(br)
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(table)
+}
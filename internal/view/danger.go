@@ -0,0 +1,63 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+)
+
+// confirmation is what must be typed to confirm a destructive action.
+type confirmation struct {
+	phrase string // Empty when no typed confirmation is needed
+	danger bool   // The phrase comes from danger_namespaces
+}
+
+// confirmationFor returns the confirmation for action in namespace: the
+// configured danger phrase in danger namespaces, otherwise fallback.
+func (a *App) confirmationFor(action, namespace, fallback string) confirmation {
+	if a.config != nil {
+		if phrase := a.config.DangerConfirmation(action, namespace); phrase != "" {
+			return confirmation{phrase: phrase, danger: true}
+		}
+	}
+	return confirmation{phrase: fallback}
+}
+
+// required reports whether the action needs a typed confirmation.
+func (c confirmation) required() bool {
+	return c.phrase != ""
+}
+
+// matches reports whether typed confirms the action.
+func (c confirmation) matches(typed string) bool {
+	return typed == c.phrase
+}
+
+// addField adds the type-to-confirm field to a form. what names the fallback
+// phrase in the label, e.g. "workflow ID".
+func (c confirmation) addField(fb *components.FormBuilder, what string) *components.FormBuilder {
+	label := fmt.Sprintf("Type %s to confirm", what)
+	if c.danger {
+		label = fmt.Sprintf("Type %q to confirm", c.phrase)
+	}
+	return fb.Text("confirm", label).
+		Placeholder(c.phrase).
+		Validate(validators.Custom(func(value any) error {
+			if s, ok := value.(string); ok && !c.matches(s) {
+				return fmt.Errorf("must match %q", c.phrase)
+			}
+			return nil
+		})).
+		Done()
+}
+
+// warning returns a line explaining why the phrase is needed, or "" outside
+// danger namespaces.
+func (c confirmation) warning(namespace string) string {
+	if !c.danger {
+		return ""
+	}
+	return fmt.Sprintf("\n[%s::b]%s is a danger namespace.[-:-:-]", theme.TagError(), namespace)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/theme/themes"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -125,7 +126,7 @@ func (ds *DebugScreen) setup() {
 	ds.inner.AddItem(ds.pons, 55, 0, false) // rat is ~50 chars wide
 
 	// Create panel
-	ds.panel = components.NewPanel().SetTitle(fmt.Sprintf("%s Debug Info", theme.IconInfo))
+	ds.panel = components.NewPanel().SetTitle(fmt.Sprintf("%s Debug Info", icons.Info))
 	ds.panel.SetContent(ds.inner)
 
 	// Single panel layout
@@ -0,0 +1,102 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// durationHeat colors activity and child workflow durations from fast to
+// slow, so the slowest steps of a workflow stand out.
+type durationHeat struct {
+	fast time.Duration // At or below this is colored fastest
+	slow time.Duration // At or above this is colored slowest
+}
+
+// newDurationHeat derives the scale from the durations of the finished
+// activities and child workflows in nodes: the median is fast and the 90th
+// percentile slow. A positive slow duration replaces the percentile. With
+// fewer than two durations there is nothing to compare and the scale is off.
+func newDurationHeat(nodes []*temporal.EventTreeNode, slow time.Duration) durationHeat {
+	var durations []time.Duration
+	var collect func(nodes []*temporal.EventTreeNode)
+	collect = func(nodes []*temporal.EventTreeNode) {
+		for _, n := range nodes {
+			if heatedNode(n) {
+				durations = append(durations, n.Duration)
+			}
+			collect(n.Children)
+		}
+	}
+	collect(nodes)
+
+	if len(durations) < 2 {
+		return durationHeat{}
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	h := durationHeat{
+		fast: durations[len(durations)/2],
+		slow: durations[len(durations)*9/10],
+	}
+	if slow > 0 {
+		h.slow = slow
+		h.fast = min(h.fast, slow/2)
+	}
+	return h
+}
+
+// heatedNode reports whether a node's duration is placed on the scale.
+func heatedNode(n *temporal.EventTreeNode) bool {
+	if n.EndTime == nil || n.Duration <= 0 {
+		return false
+	}
+	return n.Type == temporal.GroupActivity || n.Type == temporal.GroupChildWorkflow
+}
+
+// enabled reports whether the scale separates fast from slow.
+func (h durationHeat) enabled() bool {
+	return h.slow > h.fast
+}
+
+// color returns the color for d on a gradient from the success color
+// through the warning color to the error color.
+func (h durationHeat) color(d time.Duration) tcell.Color {
+	frac := float64(d-h.fast) / float64(h.slow-h.fast)
+	frac = max(0, min(1, frac))
+	if frac <= 0.5 {
+		return blendColors(theme.Success(), theme.Warning(), frac*2)
+	}
+	return blendColors(theme.Warning(), theme.Error(), (frac-0.5)*2)
+}
+
+// colorTag wraps a formatted duration in the color for d.
+func (h durationHeat) colorTag(d time.Duration) string {
+	r, g, b := h.color(d).RGB()
+	if r < 0 {
+		return temporal.FormatDuration(d)
+	}
+	return fmt.Sprintf("[#%02x%02x%02x]%s[-]", r, g, b, temporal.FormatDuration(d))
+}
+
+// blendColors mixes a and b, with t from 0 (all a) to 1 (all b). Colors
+// without an RGB value, such as the terminal default, fall back to the
+// nearer end.
+func blendColors(a, b tcell.Color, t float64) tcell.Color {
+	ar, ag, ab := a.RGB()
+	br, bg, bb := b.RGB()
+	if ar < 0 || br < 0 {
+		if t < 0.5 {
+			return a
+		}
+		return b
+	}
+	mix := func(x, y int32) int32 {
+		return x + int32(float64(y-x)*t)
+	}
+	return tcell.NewRGBColor(mix(ar, br), mix(ag, bg), mix(ab, bb))
+}
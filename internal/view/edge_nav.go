@@ -0,0 +1,85 @@
+package view
+
+import (
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/input"
+	"github.com/gdamore/tcell/v2"
+)
+
+// edgeNavigator is implemented by list-like components that support the shared
+// jump-to-edge contract: Home/gg select the first item, End/G the last, and
+// PgUp/PgDn move the selection by one screen. Implementations must notify their
+// selection-change callbacks so side panels follow the cursor.
+type edgeNavigator interface {
+	selectFirst()
+	selectLast()
+	selectPage(dir int)
+}
+
+// addEdgeNav binds the shared edge navigation keys to kb. Views where a bare g
+// already has a meaning (e.g. "go to child") pass withGG=false and keep Home
+// as their jump-to-first key.
+func addEdgeNav(kb *input.KeyBindings, nav edgeNavigator, withGG bool) *input.KeyBindings {
+	kb.On(tcell.KeyHome, func(e *tcell.EventKey) bool {
+		nav.selectFirst()
+		return true
+	}).
+		On(tcell.KeyEnd, func(e *tcell.EventKey) bool {
+			nav.selectLast()
+			return true
+		}).
+		OnRune('G', func(e *tcell.EventKey) bool {
+			nav.selectLast()
+			return true
+		}).
+		On(tcell.KeyPgUp, func(e *tcell.EventKey) bool {
+			nav.selectPage(-1)
+			return true
+		}).
+		On(tcell.KeyPgDn, func(e *tcell.EventKey) bool {
+			nav.selectPage(1)
+			return true
+		})
+	if withGG {
+		kb.AddGG(nav.selectFirst)
+	}
+	return kb
+}
+
+// tableEdgeNav adapts a table to edgeNavigator. Row indices are data rows, so
+// the header is never selected.
+type tableEdgeNav struct {
+	table *components.Table
+}
+
+func (t tableEdgeNav) selectFirst() {
+	if t.table.RowCount() > 0 {
+		t.table.SelectRow(0)
+	}
+}
+
+func (t tableEdgeNav) selectLast() {
+	if n := t.table.RowCount(); n > 0 {
+		t.table.SelectRow(n - 1)
+	}
+}
+
+func (t tableEdgeNav) selectPage(dir int) {
+	n := t.table.RowCount()
+	if n == 0 {
+		return
+	}
+	_, _, _, height := t.table.GetInnerRect()
+	page := height - 1 // Header row
+	if page < 1 {
+		page = 1
+	}
+	row := t.table.SelectedRow() + dir*page
+	if row < 0 {
+		row = 0
+	}
+	if row >= n {
+		row = n - 1
+	}
+	t.table.SelectRow(row)
+}
@@ -0,0 +1,100 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/rivo/tview"
+)
+
+// explainEvents controls whether event panels include a plain-language
+// description of the event type. It is seeded from config and toggled with w.
+var explainEvents bool
+
+// SetExplainEvents turns event explanations on or off.
+func SetExplainEvents(on bool) {
+	explainEvents = on
+}
+
+// toggleExplainEvents flips event explanations and returns the new state.
+func toggleExplainEvents() bool {
+	explainEvents = !explainEvents
+	return explainEvents
+}
+
+// eventExplanations describes what each history event type means, keyed by
+// the normalized event type.
+var eventExplanations = map[string]string{
+	"WorkflowExecutionStarted":                        "The workflow was started; this is always the first event and records its input and options.",
+	"WorkflowExecutionCompleted":                      "The workflow code returned successfully; the result is recorded here.",
+	"WorkflowExecutionFailed":                         "The workflow code returned an error or panicked in a way that fails the run.",
+	"WorkflowExecutionTimedOut":                       "The workflow ran past its execution or run timeout and was closed by the server.",
+	"WorkflowExecutionCanceled":                       "The workflow accepted a cancellation request and finished as canceled.",
+	"WorkflowExecutionTerminated":                     "The workflow was forcibly stopped; its code got no chance to clean up.",
+	"WorkflowExecutionContinuedAsNew":                 "The workflow closed this run and started a fresh run with the same ID and a new history.",
+	"WorkflowExecutionSignaled":                       "A signal was delivered to the workflow; its handler runs on the next workflow task.",
+	"WorkflowExecutionCancelRequested":                "Someone asked the workflow to cancel; the workflow code decides how to react.",
+	"WorkflowExecutionUpdateAdmitted":                 "The server received an update request and is waiting for a worker to pick it up.",
+	"WorkflowExecutionUpdateAccepted":                 "The workflow's update validator accepted the update and its handler started.",
+	"WorkflowExecutionUpdateRejected":                 "The workflow's update validator rejected the update; the history is otherwise unchanged.",
+	"WorkflowExecutionUpdateCompleted":                "The update handler finished and its result was returned to the caller.",
+	"WorkflowExecutionOptionsUpdated":                 "Execution options such as versioning overrides were changed on the running workflow.",
+	"WorkflowExecutionPaused":                         "The workflow was paused; no new workflow tasks run until it is unpaused.",
+	"WorkflowExecutionUnpaused":                       "The workflow was unpaused and resumes making progress.",
+	"WorkflowTaskScheduled":                           "The server queued a task for a worker to advance the workflow.",
+	"WorkflowTaskStarted":                             "A worker picked up the workflow task and is replaying the workflow code.",
+	"WorkflowTaskCompleted":                           "The worker finished running workflow code and sent back the commands it produced.",
+	"WorkflowTaskFailed":                              "The worker could not complete the workflow task, often due to a panic or non-determinism; it will be retried.",
+	"WorkflowTaskTimedOut":                            "The worker did not finish the workflow task in time; the server will schedule it again.",
+	"ActivityTaskScheduled":                           "The workflow asked for an activity to run; it waits on the task queue for a worker.",
+	"ActivityTaskStarted":                             "A worker picked up the activity; for retried activities this is recorded when the activity closes.",
+	"ActivityTaskCompleted":                           "The activity returned successfully and its result is available to the workflow.",
+	"ActivityTaskFailed":                              "The activity failed and its retry policy is exhausted or does not allow another attempt.",
+	"ActivityTaskTimedOut":                            "The activity exceeded one of its timeouts and will not be retried further.",
+	"ActivityTaskCancelRequested":                     "The workflow asked for the activity to be canceled; the activity learns this on its next heartbeat.",
+	"ActivityTaskCanceled":                            "The activity acknowledged the cancellation and stopped.",
+	"ActivityPropertiesModifiedExternally":            "The activity's options were changed from outside the workflow, for example by pausing it.",
+	"TimerStarted":                                    "The workflow started a durable timer, e.g. from a sleep.",
+	"TimerFired":                                      "The timer's duration elapsed and the workflow will wake up.",
+	"TimerCanceled":                                   "The workflow canceled the timer before it fired.",
+	"MarkerRecorded":                                  "The SDK recorded a marker, used for side effects, local activities, and version checks so replays stay deterministic.",
+	"UpsertWorkflowSearchAttributes":                  "The workflow changed its search attributes, which updates how it appears in visibility queries.",
+	"WorkflowPropertiesModified":                      "The workflow changed its own properties, such as its memo.",
+	"WorkflowPropertiesModifiedExternally":            "The workflow's properties were changed from outside the workflow.",
+	"StartChildWorkflowExecutionInitiated":            "The workflow asked the server to start a child workflow.",
+	"StartChildWorkflowExecutionFailed":               "The child workflow could not be started, usually because one with the same ID is already running.",
+	"ChildWorkflowExecutionStarted":                   "The child workflow is now running.",
+	"ChildWorkflowExecutionCompleted":                 "The child workflow finished successfully and its result is available to the parent.",
+	"ChildWorkflowExecutionFailed":                    "The child workflow failed.",
+	"ChildWorkflowExecutionCanceled":                  "The child workflow was canceled.",
+	"ChildWorkflowExecutionTimedOut":                  "The child workflow exceeded its timeout.",
+	"ChildWorkflowExecutionTerminated":                "The child workflow was terminated.",
+	"SignalExternalWorkflowExecutionInitiated":        "The workflow asked the server to signal another workflow.",
+	"SignalExternalWorkflowExecutionFailed":           "The signal to another workflow could not be delivered, e.g. because it was not found.",
+	"ExternalWorkflowExecutionSignaled":               "The other workflow received the signal.",
+	"RequestCancelExternalWorkflowExecutionInitiated": "The workflow asked the server to cancel another workflow.",
+	"RequestCancelExternalWorkflowExecutionFailed":    "The cancellation request to another workflow could not be delivered.",
+	"ExternalWorkflowExecutionCancelRequested":        "The other workflow received the cancellation request.",
+	"NexusOperationScheduled":                         "The workflow asked for a Nexus operation to be run by another service.",
+	"NexusOperationStarted":                           "The Nexus operation was started asynchronously by the handler.",
+	"NexusOperationCompleted":                         "The Nexus operation finished successfully.",
+	"NexusOperationFailed":                            "The Nexus operation failed.",
+	"NexusOperationCanceled":                          "The Nexus operation was canceled.",
+	"NexusOperationTimedOut":                          "The Nexus operation exceeded its timeout.",
+	"NexusOperationCancelRequested":                   "The workflow asked for the Nexus operation to be canceled.",
+	"NexusOperationCancelRequestCompleted":            "The Nexus handler accepted the cancellation request.",
+	"NexusOperationCancelRequestFailed":               "The Nexus handler rejected the cancellation request.",
+}
+
+// eventExplanationLine returns the explanation of an event type as an extra
+// line, prefixed by indent, or "" when explanations are off or the type is unknown.
+func eventExplanationLine(eventType, indent string) string {
+	if !explainEvents {
+		return ""
+	}
+	text, ok := eventExplanations[eventType]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("\n%s[%s::i]%s[-:-:-]", indent, theme.TagFgDim(), tview.Escape(text))
+}
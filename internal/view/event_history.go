@@ -3,6 +3,7 @@ package view
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,8 @@ import (
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -27,6 +30,7 @@ const (
 // EventHistory displays workflow event history with multiple view modes.
 type EventHistory struct {
 	*components.MasterDetailView
+	freshness
 	app        *App
 	workflowID string
 	runID      string
@@ -46,12 +50,16 @@ type EventHistory struct {
 
 	// Shared components
 	sidePanel *tview.TextView
+	banner    *tview.TextView // Shown above the events when history is incomplete
 
 	// Data
 	events            []temporal.HistoryEvent
 	allEnhancedEvents []temporal.EnhancedHistoryEvent // Full unfiltered list
 	enhancedEvents    []temporal.EnhancedHistoryEvent // Filtered list for display
 	loading           bool
+	incomplete        *temporal.HistoryIncompleteError // Set when only part of the history was fetched
+	historyLimit      int                              // Load only this many recent events (0 = all)
+	streamCancel      context.CancelFunc               // Stops live updates, nil while they are off
 }
 
 // NewEventHistory creates a new event history view.
@@ -60,11 +68,15 @@ func NewEventHistory(app *App, workflowID, runID string) *EventHistory {
 		app:          app,
 		workflowID:   workflowID,
 		runID:        runID,
-		viewMode:     ViewModeTree, // Default to tree view
+		viewMode:     defaultEventViewMode(app.Config()),
 		table:        components.NewTable(),
 		treeView:     NewEventTreeView(),
 		timelineView: NewTimelineView(),
 		sidePanel:    tview.NewTextView(),
+		banner:       tview.NewTextView(),
+	}
+	if cfg := app.Config(); cfg != nil {
+		eh.treeView.SetSlowDuration(cfg.GetSlowDuration())
 	}
 	eh.setup()
 
@@ -85,14 +97,18 @@ func (eh *EventHistory) setup() {
 	eh.sidePanel.SetTextAlign(tview.AlignLeft)
 	eh.sidePanel.SetBackgroundColor(theme.Bg())
 
-	// Create MasterDetailView - default to tree view
+	eh.banner.SetDynamicColors(true)
+	eh.banner.SetBackgroundColor(theme.Bg())
+
+	// Create MasterDetailView in the configured default mode
+	title, content := eh.masterForMode()
 	eh.MasterDetailView = components.NewMasterDetailView().
-		SetMasterTitle(fmt.Sprintf("%s Events (Tree)", theme.IconEvent)).
-		SetDetailTitle(fmt.Sprintf("%s Details", theme.IconInfo)).
-		SetMasterContent(eh.treeView).
+		SetMasterTitle(title).
+		SetDetailTitle(fmt.Sprintf("%s Details", icons.Info)).
+		SetMasterContent(content).
 		SetDetailContent(eh.sidePanel).
 		SetRatio(0.6).
-		ConfigureEmpty(theme.IconInfo, "No Event", "Select an event to view details").
+		ConfigureEmpty(icons.Info, "No Event", "Select an event to view details").
 		EnableSearch(func(current string, cb components.SearchCallbacks) {
 			eh.app.ShowFilterMode(current, FilterModeCallbacks{
 				OnChange: cb.OnChange,
@@ -113,6 +129,9 @@ func (eh *EventHistory) setup() {
 
 	eh.table.SetSelectedFunc(func(row, col int) {
 		if row > 0 {
+			if eh.openLinkedWorkflow() {
+				return
+			}
 			eh.toggleSidePanel()
 			if eh.IsDetailVisible() {
 				eh.updateSidePanelFromList(row - 1)
@@ -128,13 +147,16 @@ func (eh *EventHistory) setup() {
 	})
 
 	eh.treeView.SetOnSelect(func(node *temporal.EventTreeNode) {
-		// Toggle expand/collapse is handled by tree view itself
-		// Optionally toggle side panel on enter
+		// Toggle expand/collapse is handled by tree view itself; a child
+		// workflow or external signal node opens the workflow it links to
+		if node != nil {
+			eh.openLinkedWorkflow()
+		}
 	})
 
 	// Timeline view selection handler (Enter key)
 	eh.timelineView.SetOnSelect(func(lane *TimelineLane) {
-		if lane != nil && lane.Node != nil {
+		if lane != nil && lane.Node != nil && !eh.openLinkedWorkflow() {
 			eh.updateSidePanelFromTree(lane.Node)
 		}
 	})
@@ -147,19 +169,71 @@ func (eh *EventHistory) setup() {
 	})
 }
 
-func (eh *EventHistory) buildLayout() {
-	// Update panel title and content based on view mode
+// defaultEventViewMode returns the view mode configured by default_event_view.
+func defaultEventViewMode(cfg *config.Config) EventViewMode {
+	if cfg == nil {
+		return ViewModeTree
+	}
+	switch cfg.GetDefaultEventView() {
+	case "list":
+		return ViewModeList
+	case "timeline":
+		return ViewModeTimeline
+	default:
+		return ViewModeTree
+	}
+}
+
+// masterForMode returns the panel title and content for the current view mode.
+// The content is topped by a banner when the history is incomplete or only
+// its most recent events were loaded.
+func (eh *EventHistory) masterForMode() (string, tview.Primitive) {
+	var title string
+	var content tview.Primitive
 	switch eh.viewMode {
 	case ViewModeList:
-		eh.SetMasterTitle(fmt.Sprintf("%s Events (List)", theme.IconEvent))
-		eh.SetMasterContent(eh.table)
-	case ViewModeTree:
-		eh.SetMasterTitle(fmt.Sprintf("%s Events (Tree)", theme.IconEvent))
-		eh.SetMasterContent(eh.treeView)
+		title, content = fmt.Sprintf("%s Events (List)", icons.Event), eh.table
 	case ViewModeTimeline:
-		eh.SetMasterTitle(fmt.Sprintf("%s Events (Timeline)", theme.IconEvent))
-		eh.SetMasterContent(eh.timelineView)
+		title, content = fmt.Sprintf("%s Events (Timeline)", icons.Event), eh.timelineView
+	default:
+		title, content = fmt.Sprintf("%s Events (Tree)", icons.Event), eh.treeView
+	}
+
+	if eh.incomplete == nil && eh.historyLimit == 0 {
+		return title, content
+	}
+	return title, tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(eh.banner, 1, 0, false).
+		AddItem(content, 0, 1, true)
+}
+
+// setIncomplete shows or clears the banner for a partially fetched history.
+func (eh *EventHistory) setIncomplete(err *temporal.HistoryIncompleteError) {
+	if eh.incomplete == nil && err == nil && eh.historyLimit == 0 {
+		return
+	}
+	eh.incomplete = err
+	if err != nil {
+		eh.banner.SetText(fmt.Sprintf(" [%s]%s history incomplete (%d events loaded): %s, press r to retry the rest[-]",
+			theme.TagWarning(), icons.Warning, err.Loaded, tview.Escape(err.Err.Error())))
+	} else if eh.historyLimit > 0 {
+		eh.setRecentBanner()
 	}
+	_, content := eh.masterForMode()
+	eh.SetMasterContent(content)
+}
+
+// setRecentBanner notes that only the most recent events were loaded.
+func (eh *EventHistory) setRecentBanner() {
+	eh.banner.SetText(fmt.Sprintf(" [%s]%s showing the last %d events of a large history; earlier events were not loaded[-]",
+		theme.TagWarning(), icons.Warning, len(eh.allEnhancedEvents)))
+}
+
+func (eh *EventHistory) buildLayout() {
+	// Update panel title and content based on view mode
+	title, content := eh.masterForMode()
+	eh.SetMasterTitle(title)
+	eh.SetMasterContent(content)
 
 	// Set focus to the active view component
 	if eh.app != nil && eh.app.JigApp() != nil {
@@ -238,6 +312,7 @@ func (eh *EventHistory) applyFilter(query string) {
 
 	// Rebuild tree nodes from filtered events
 	eh.treeNodes = temporal.BuildEventTree(eh.enhancedEvents)
+	markSentNodes(eh.treeNodes, eh.app.SentOpEvents(eh.workflowID, eh.runID, eh.allEnhancedEvents))
 
 	// Refresh current view
 	eh.refreshCurrentView()
@@ -254,6 +329,10 @@ func (eh *EventHistory) RefreshTheme() {
 	// Update side panel
 	eh.sidePanel.SetBackgroundColor(bg)
 	eh.sidePanel.SetTextColor(fg)
+	eh.banner.SetBackgroundColor(bg)
+	if eh.incomplete != nil {
+		eh.setIncomplete(eh.incomplete)
+	}
 
 	// Re-render current view with new theme colors
 	eh.refreshCurrentView()
@@ -267,21 +346,31 @@ func (eh *EventHistory) loadData() {
 	}
 
 	eh.setLoading(true)
+	limit := eh.historyLimit
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
 		// Load enhanced events for tree/timeline views
-		enhancedEvents, err := provider.GetEnhancedWorkflowHistory(ctx, eh.app.CurrentNamespace(), eh.workflowID, eh.runID)
+		var enhancedEvents []temporal.EnhancedHistoryEvent
+		var err error
+		if limit > 0 {
+			enhancedEvents, err = provider.GetRecentWorkflowHistory(ctx, eh.app.CurrentNamespace(), eh.workflowID, eh.runID, limit)
+		} else {
+			enhancedEvents, err = provider.GetEnhancedWorkflowHistory(ctx, eh.app.CurrentNamespace(), eh.workflowID, eh.runID)
+		}
 
 		eh.app.JigApp().QueueUpdateDraw(func() {
 			eh.setLoading(false)
-			if err != nil {
+			var incomplete *temporal.HistoryIncompleteError
+			if err != nil && !errors.As(err, &incomplete) {
 				eh.showError(err)
 				return
 			}
 
 			eh.allEnhancedEvents = enhancedEvents
+			eh.setIncomplete(incomplete)
+			eh.markLoaded()
 			eh.applyFilter(eh.MasterDetailView.GetSearchText())
 		})
 	}()
@@ -312,16 +401,25 @@ func (eh *EventHistory) loadMockData() {
 }
 
 func (eh *EventHistory) populateTable() {
-	// Preserve current selection
+	// Preserve current selection, by event ID so it survives new events
 	currentRow := eh.table.SelectedRow()
+	var selectedID string
+	if data := eh.table.GetRowData(currentRow); len(data) > 0 {
+		selectedID = data[0]
+	}
 
 	eh.table.ClearRows()
 	eh.table.SetHeaders("ID", "TIME", "TYPE", "NAME", "DETAILS")
 
+	sent := eh.app.SentOpEvents(eh.workflowID, eh.runID, eh.allEnhancedEvents)
 	for _, ev := range eh.enhancedEvents {
 		icon := eventIcon(ev.Type)
 		color := eventColor(ev.Type)
 		name := getEventName(&ev)
+		if sent[ev.ID] {
+			color = theme.Accent()
+			name = strings.TrimSpace(name + " " + sentOpLabel)
+		}
 		eh.table.AddRowWithColor(color,
 			fmt.Sprintf("%d", ev.ID),
 			ev.Time.Format("15:04:05"),
@@ -332,6 +430,12 @@ func (eh *EventHistory) populateTable() {
 	}
 
 	if eh.table.RowCount() > 0 {
+		for i, ev := range eh.enhancedEvents {
+			if fmt.Sprintf("%d", ev.ID) == selectedID {
+				currentRow = i
+				break
+			}
+		}
 		// Restore previous selection if valid, otherwise select first row
 		if currentRow >= 0 && currentRow < len(eh.enhancedEvents) {
 			eh.table.SelectRow(currentRow)
@@ -345,6 +449,57 @@ func (eh *EventHistory) populateTable() {
 	}
 }
 
+// isFailureStatus reports whether a tree node or timeline lane ended in
+// failure, matching isFailureEvent for single events.
+func isFailureStatus(status string) bool {
+	switch status {
+	case "Failed", "TimedOut", "Terminated":
+		return true
+	}
+	return false
+}
+
+// jumpToFailure selects the next (dir > 0) or previous (dir < 0) failed or
+// timed-out event in the current view mode, wrapping around at either end.
+func (eh *EventHistory) jumpToFailure(dir int) {
+	found := false
+	switch eh.viewMode {
+	case ViewModeList:
+		found = eh.jumpToFailedRow(dir)
+	case ViewModeTree:
+		found = eh.treeView.JumpToFailure(dir)
+	case ViewModeTimeline:
+		found = eh.timelineView.JumpToFailure(dir)
+	}
+	if !found {
+		eh.app.ToastWarning("No failure events")
+	}
+}
+
+// jumpToFailedRow moves the list cursor to the next or previous failure event.
+func (eh *EventHistory) jumpToFailedRow(dir int) bool {
+	n := len(eh.enhancedEvents)
+	if n == 0 {
+		return false
+	}
+	start := eh.table.SelectedRow()
+	if start < 0 {
+		start = 0
+		if dir < 0 {
+			start = n - 1
+		}
+	}
+	for i := 1; i <= n; i++ {
+		row := ((start+dir*i)%n + n) % n
+		if isFailureEvent(eh.enhancedEvents[row].Type) {
+			eh.table.SelectRow(row)
+			eh.updateSidePanelFromList(row)
+			return true
+		}
+	}
+	return false
+}
+
 // getEventName returns the activity type, timer ID, or child workflow type for an event.
 func getEventName(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ActivityType != "" {
@@ -356,13 +511,19 @@ func getEventName(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ChildWorkflowType != "" {
 		return ev.ChildWorkflowType
 	}
+	if ev.SignalName != "" {
+		return "Signal: " + ev.SignalName
+	}
+	if ev.UpdateName != "" {
+		return "Update: " + ev.UpdateName
+	}
 	return ""
 }
 
 func (eh *EventHistory) populateTreeView() {
 	eh.treeView.SetNodes(eh.treeNodes)
-	if len(eh.treeNodes) > 0 {
-		eh.updateSidePanelFromTree(eh.treeNodes[0])
+	if node := eh.treeView.SelectedNode(); node != nil {
+		eh.updateSidePanelFromTree(node)
 	}
 }
 
@@ -376,7 +537,7 @@ func (eh *EventHistory) showError(err error) {
 	eh.table.AddRowWithColor(theme.Error(),
 		"",
 		"",
-		theme.IconError+" Error loading events",
+		icons.Error+" Error loading events",
 		"",
 		err.Error(),
 	)
@@ -409,13 +570,21 @@ func (eh *EventHistory) updateSidePanelFromList(index int) {
 			theme.TagAccent(),
 			theme.TagFg(), name)
 	}
+	if by := temporal.ActionBy(&ev); by != "" {
+		nameSection += fmt.Sprintf(`
+
+[%s::b]By[-:-:-]
+[%s]%s[-]`,
+			theme.TagAccent(),
+			theme.TagWarning(), tview.Escape(by))
+	}
 
 	text := fmt.Sprintf(`
 [%s::b]Event ID[-:-:-]
 [%s]%d[-]
 
 [%s::b]Type[-:-:-]
-[%s]%s %s[-]%s
+[%s]%s %s[-]%s%s
 
 [%s::b]Time[-:-:-]
 [%s]%s[-]
@@ -425,7 +594,7 @@ func (eh *EventHistory) updateSidePanelFromList(index int) {
 		theme.TagAccent(),
 		theme.TagFg(), ev.ID,
 		theme.TagAccent(),
-		colorTag, icon, ev.Type, nameSection,
+		colorTag, icon, ev.Type, eventExplanationLine(ev.Type, ""), nameSection,
 		theme.TagAccent(),
 		theme.TagFg(), ev.Time.Format("2006-01-02 15:04:05.000"),
 		theme.TagAccent(),
@@ -458,10 +627,16 @@ func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 
 	// Extract result/failure from events
 	var dataStr string
+	resultLabel := "Result"
+	if ev := childResultEvent(node); ev != nil {
+		resultLabel = "Child Result"
+		dataStr += fmt.Sprintf("\n\n[%s::b]Child Workflow[-:-:-]\n[%s]%s[-]\n[%s]%s[-]",
+			theme.TagAccent(), theme.TagFg(), tview.Escape(ev.ChildWorkflowID), theme.TagFgDim(), ev.ChildRunID)
+	}
 	for _, ev := range node.Events {
 		if ev.Result != "" {
 			formatted := formatSidePanelDetails(ev.Result)
-			dataStr += fmt.Sprintf("\n\n[%s::b]Result[-:-:-]\n%s", theme.TagAccent(), formatted)
+			dataStr += fmt.Sprintf("\n\n[%s::b]%s[-:-:-]\n%s", theme.TagAccent(), resultLabel, formatted)
 		}
 		if ev.Failure != "" {
 			dataStr += formatFailureSidePanel(ev)
@@ -475,6 +650,7 @@ func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 			evIcon := eventIcon(ev.Type)
 			eventsStr += fmt.Sprintf("\n[%s]%s %s[-] [%s](%d)[-]",
 				eventColorTag(ev.Type), evIcon, ev.Type, theme.TagFgDim(), ev.ID)
+			eventsStr += eventExplanationLine(ev.Type, "  ")
 		}
 	}
 
@@ -510,6 +686,11 @@ func (eh *EventHistory) Name() string {
 	return "events"
 }
 
+// Reload reloads the event history.
+func (eh *EventHistory) Reload() {
+	eh.loadData()
+}
+
 // Start is called when the view becomes active.
 func (eh *EventHistory) Start() {
 	// Set up input capture for the current view mode
@@ -530,6 +711,10 @@ func (eh *EventHistory) setupInputCapture() {
 			eh.cycleViewMode()
 			return true
 		}).
+		OnRune('W', func(e *tcell.EventKey) bool {
+			eh.app.showHistoryExport(eh.workflowID, eh.runID)
+			return true
+		}).
 		OnRune('/', func(e *tcell.EventKey) bool {
 			eh.MasterDetailView.ShowSearch()
 			return true
@@ -554,23 +739,45 @@ func (eh *EventHistory) setupInputCapture() {
 			eh.loadData()
 			return true
 		}).
+		OnRune('a', func(e *tcell.EventKey) bool {
+			eh.toggleLive()
+			return true
+		}).
 		OnRune('y', func(e *tcell.EventKey) bool {
-			eh.yankEventData()
+			eh.showYankMenu()
 			return true
 		}).
 		OnRune('d', func(e *tcell.EventKey) bool {
 			eh.showDetailModal()
 			return true
+		}).
+		OnRune('w', func(e *tcell.EventKey) bool {
+			toggleExplainEvents()
+			eh.refreshSidePanel()
+			return true
+		}).
+		OnRune('f', func(e *tcell.EventKey) bool {
+			eh.jumpToFailure(1)
+			return true
+		}).
+		OnRune('F', func(e *tcell.EventKey) bool {
+			eh.jumpToFailure(-1)
+			return true
 		})
 
-	// List view bindings: common + g for child workflow navigation
-	listBindings := bindings.Clone().
+	// List view bindings: common + g for child workflow navigation, so
+	// Home rather than gg jumps to the first event
+	listBindings := addEdgeNav(bindings.Clone(), tableEdgeNav{eh.table}, false).
 		OnRune('g', func(e *tcell.EventKey) bool {
 			eh.jumpToChildWorkflow()
 			return true
+		}).
+		OnRune('Y', func(e *tcell.EventKey) bool {
+			eh.app.tableToClipboard(eh.table)
+			return true
 		})
 
-	// Tree view bindings: common + tree-specific + vim gg/G navigation
+	// Tree view bindings: common + tree-specific + edge navigation
 	treeBindings := bindings.Clone().
 		OnRune('e', func(e *tcell.EventKey) bool {
 			eh.treeView.ExpandAll()
@@ -579,28 +786,11 @@ func (eh *EventHistory) setupInputCapture() {
 		OnRune('c', func(e *tcell.EventKey) bool {
 			eh.treeView.CollapseAll()
 			return true
-		}).
-		OnRune('f', func(e *tcell.EventKey) bool {
-			eh.treeView.JumpToFailed()
-			return true
-		}).
-		OnRune('G', func(e *tcell.EventKey) bool {
-			eh.treeView.JumpToLast()
-			return true
-		}).
-		AddGG(func() {
-			eh.treeView.JumpToFirst()
 		})
+	addEdgeNav(treeBindings, eh.treeView, true)
 
-	// Timeline view bindings: common + vim G navigation
-	timelineBindings := bindings.Clone().
-		OnRune('G', func(e *tcell.EventKey) bool {
-			eh.timelineView.selectLast()
-			return true
-		}).
-		AddGG(func() {
-			eh.timelineView.selectFirst()
-		})
+	// Timeline view bindings: common + edge navigation
+	timelineBindings := addEdgeNav(bindings.Clone(), eh.timelineView, true)
 
 	// Create input handlers
 	listHandler := func(event *tcell.EventKey) *tcell.EventKey {
@@ -640,6 +830,84 @@ func (eh *EventHistory) Stop() {
 	eh.table.SetInputCapture(nil)
 	eh.treeView.SetInputCapture(nil)
 	eh.timelineView.SetInputCapture(nil)
+	eh.stopLive()
+}
+
+// toggleLive turns live updates of a running workflow's history on or off.
+// While on, the history tail is long-polled and new events are added as the
+// server records them, until the workflow closes.
+func (eh *EventHistory) toggleLive() {
+	if eh.streamCancel != nil {
+		eh.stopLive()
+		eh.app.ToastSuccess("Live updates off")
+		return
+	}
+	if closeEvent(eh.allEnhancedEvents) != nil {
+		eh.app.ToastWarning("Workflow has closed; live updates only follow running workflows")
+		return
+	}
+	provider := eh.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eh.streamCancel = cancel
+	namespace := eh.app.CurrentNamespace()
+	var after int64
+	if n := len(eh.allEnhancedEvents); n > 0 {
+		after = eh.allEnhancedEvents[n-1].ID
+	}
+
+	go func() {
+		err := provider.StreamWorkflowHistory(ctx, namespace, eh.workflowID, eh.runID, after, func(events []temporal.EnhancedHistoryEvent) {
+			eh.app.JigApp().QueueUpdateDraw(func() {
+				if ctx.Err() == nil {
+					eh.appendEvents(events)
+				}
+			})
+		})
+		eh.app.JigApp().QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return // Stopped from the view
+			}
+			eh.stopLive()
+			if err != nil {
+				eh.app.ToastError(fmt.Sprintf("Live updates stopped: %v", err))
+			} else {
+				eh.app.ToastSuccess("Workflow closed, live updates stopped")
+			}
+		})
+	}()
+	eh.app.ToastSuccess("Live updates on")
+}
+
+// stopLive stops live updates if they are on.
+func (eh *EventHistory) stopLive() {
+	if eh.streamCancel != nil {
+		eh.streamCancel()
+		eh.streamCancel = nil
+	}
+}
+
+// appendEvents adds streamed events to the history, skipping any a reload
+// has already fetched.
+func (eh *EventHistory) appendEvents(events []temporal.EnhancedHistoryEvent) {
+	var last int64
+	if n := len(eh.allEnhancedEvents); n > 0 {
+		last = eh.allEnhancedEvents[n-1].ID
+	}
+	added := false
+	for _, ev := range events {
+		if ev.ID > last {
+			eh.allEnhancedEvents = append(eh.allEnhancedEvents, ev)
+			added = true
+		}
+	}
+	if added {
+		eh.markLoaded()
+		eh.applyFilter(eh.MasterDetailView.GetSearchText())
+	}
 }
 
 // Hints returns keybinding hints for this view.
@@ -649,19 +917,24 @@ func (eh *EventHistory) Hints() []KeyHint {
 		{Key: "v", Description: "Cycle View"},
 		{Key: "1/2/3", Description: "List/Tree/Timeline"},
 		{Key: "d", Description: "Detail"},
+		{Key: "f/F", Description: "Next/Prev Failure"},
 		{Key: "g", Description: "Go to Child"},
 		{Key: "y", Description: "Yank"},
 		{Key: "p", Description: "Preview"},
+		{Key: "w", Description: "Explain Events"},
+		{Key: "W", Description: "Export History"},
 		{Key: "r", Description: "Refresh"},
+		{Key: "a", Description: "Live Updates"},
 	}
 
 	// Add view-specific hints
 	switch eh.viewMode {
+	case ViewModeList:
+		hints = append(hints, KeyHint{Key: "Y", Description: "Copy Table"})
 	case ViewModeTree:
 		hints = append(hints,
 			KeyHint{Key: "e", Description: "Expand All"},
 			KeyHint{Key: "c", Description: "Collapse All"},
-			KeyHint{Key: "f", Description: "Jump to Failed"},
 		)
 	case ViewModeTimeline:
 		hints = append(hints,
@@ -672,6 +945,7 @@ func (eh *EventHistory) Hints() []KeyHint {
 
 	hints = append(hints,
 		KeyHint{Key: "j/k", Description: "Navigate"},
+		KeyHint{Key: "Home/End", Description: "First/Last"},
 		KeyHint{Key: "T", Description: "Theme"},
 		KeyHint{Key: "esc", Description: "Back"},
 	)
@@ -702,28 +976,34 @@ func (eh *EventHistory) Draw(screen tcell.Screen) {
 
 // eventIcon returns an icon for the event type.
 func eventIcon(eventType string) string {
+	if icon, ok := overrideIcon(eventType); ok {
+		return icon
+	}
 	switch {
 	case contains(eventType, "Started"):
-		return theme.IconRunning
+		return icons.Running
 	case contains(eventType, "Completed"):
-		return theme.IconCompleted
+		return icons.Completed
 	case contains(eventType, "Failed"):
-		return theme.IconError
+		return icons.Error
 	case contains(eventType, "Scheduled"):
-		return theme.IconPending
+		return icons.Pending
 	case contains(eventType, "Timer"):
-		return theme.IconTimedOut
+		return icons.TimedOut
 	case contains(eventType, "Signal"):
-		return theme.IconActivity
+		return icons.Activity
 	case contains(eventType, "Child"):
-		return theme.IconWorkflow
+		return icons.Workflow
 	default:
-		return theme.IconEvent
+		return icons.Event
 	}
 }
 
 // eventColor returns a color for the event type.
 func eventColor(eventType string) tcell.Color {
+	if color, ok := overrideColor(eventType); ok {
+		return color
+	}
 	switch {
 	case contains(eventType, "Started"):
 		return temporal.StatusRunning.Color()
@@ -740,6 +1020,9 @@ func eventColor(eventType string) tcell.Color {
 
 // eventColorTag returns a color tag for the event type.
 func eventColorTag(eventType string) string {
+	if color, ok := overrideColor(eventType); ok {
+		return theme.ColorToHex(color)
+	}
 	switch {
 	case contains(eventType, "Started"):
 		return temporal.StatusRunning.ColorTag()
@@ -765,36 +1048,43 @@ func containsHelper(s, substr string) bool {
 	return false
 }
 
-// getSelectedEventData returns the raw data for the currently selected event.
-func (eh *EventHistory) getSelectedEventData() (string, string) {
+// selectedEvent returns the event under the cursor. In the tree, that is
+// the node's last event with data; in the timeline, the lane's last event.
+func (eh *EventHistory) selectedEvent() *temporal.EnhancedHistoryEvent {
 	switch eh.viewMode {
 	case ViewModeList:
 		row := eh.table.SelectedRow()
 		if row >= 0 && row < len(eh.enhancedEvents) {
-			ev := eh.enhancedEvents[row]
-			return ev.Type, eh.formatEventDataRaw(&ev)
+			return &eh.enhancedEvents[row]
 		}
 	case ViewModeTree:
 		node := eh.treeView.SelectedNode()
 		if node != nil && len(node.Events) > 0 {
 			// Get the most relevant event (usually the last one with data)
 			for i := len(node.Events) - 1; i >= 0; i-- {
-				ev := node.Events[i]
-				if hasEventData(ev) {
-					return ev.Type, eh.formatEventDataRaw(ev)
+				if hasEventData(node.Events[i]) {
+					return node.Events[i]
 				}
 			}
 			// Fallback to first event
-			return node.Events[0].Type, eh.formatEventDataRaw(node.Events[0])
+			return node.Events[0]
 		}
 	case ViewModeTimeline:
 		lane := eh.timelineView.SelectedLane()
 		if lane != nil && lane.Node != nil && len(lane.Node.Events) > 0 {
-			ev := lane.Node.Events[len(lane.Node.Events)-1]
-			return ev.Type, eh.formatEventDataRaw(ev)
+			return lane.Node.Events[len(lane.Node.Events)-1]
 		}
 	}
-	return "", ""
+	return nil
+}
+
+// getSelectedEventData returns the raw data for the currently selected event.
+func (eh *EventHistory) getSelectedEventData() (string, string) {
+	ev := eh.selectedEvent()
+	if ev == nil {
+		return "", ""
+	}
+	return ev.Type, eh.formatEventDataRaw(ev)
 }
 
 // formatEventDataRaw formats event data as raw JSON/text for copying.
@@ -810,6 +1100,9 @@ func (eh *EventHistory) formatEventDataRaw(ev *temporal.EnhancedHistoryEvent) st
 	if ev.Failure != "" {
 		parts = append(parts, fmt.Sprintf("Failure: %s", prettyPrintJSON(ev.Failure)))
 	}
+	if len(ev.FailureChain) > 0 {
+		parts = append(parts, fmt.Sprintf("Chain: %s", temporal.FailureChainSummary(ev.Type, ev.FailureChain)))
+	}
 	if ev.FailureSource != "" {
 		parts = append(parts, fmt.Sprintf("Source: %s", ev.FailureSource))
 	}
@@ -841,6 +1134,12 @@ func formatFailureSidePanel(ev *temporal.EnhancedHistoryEvent) string {
 	}
 
 	var result strings.Builder
+	if len(ev.FailureChain) > 0 {
+		result.WriteString(fmt.Sprintf("\n\n[%s::b]Failure Chain[-:-:-]\n[%s]%s[-]\n[%s]%s[-]",
+			theme.TagAccent(),
+			theme.TagError(), tview.Escape(temporal.FailureChainSummary(ev.Type, ev.FailureChain)),
+			theme.TagFgDim(), tview.Escape(temporal.FormatFailureChain(ev.FailureChain))))
+	}
 	if ev.FailureSource != "" {
 		result.WriteString(fmt.Sprintf("\n\n[%s::b]Source[-:-:-]\n[%s]%s[-]",
 			theme.TagAccent(), theme.TagFg(), tview.Escape(ev.FailureSource)))
@@ -849,43 +1148,20 @@ func formatFailureSidePanel(ev *temporal.EnhancedHistoryEvent) string {
 		result.WriteString(fmt.Sprintf("\n\n[%s::b]Stack Trace[-:-:-]\n[%s]%s[-]",
 			theme.TagAccent(), theme.TagFgDim(), tview.Escape(ev.FailureStackTrace)))
 	}
-	if ev.FailureCause != "" {
+	// Without a chain, e.g. for events built by hand, show the causes as recorded
+	if len(ev.FailureChain) == 0 && ev.FailureCause != "" {
 		result.WriteString(fmt.Sprintf("\n\n[%s::b]Cause[-:-:-]\n[%s]%s[-]",
 			theme.TagAccent(), theme.TagFgDim(), tview.Escape(ev.FailureCause)))
 	}
 	return result.String()
 }
 
-// yankEventData copies the selected event's data to clipboard.
-func (eh *EventHistory) yankEventData() {
-	eventType, data := eh.getSelectedEventData()
-	if data == "" {
-		return
-	}
-
-	if err := copyToClipboard(data); err != nil {
-		eh.sidePanel.SetText(fmt.Sprintf("[%s]%s Failed to copy: %s[-]",
-			theme.TagError(), theme.IconError, err.Error()))
-		return
-	}
-
-	// Show success feedback
-	eh.sidePanel.SetText(fmt.Sprintf(`[%s::b]Copied to clipboard[-:-:-]
-
-[%s]%s[-]
-
-[%s]%s[-]`,
-		theme.TagAccent(),
-		theme.TagAccent(), eventType,
-		temporal.StatusCompleted.ColorTag(), "Event data copied!"))
-
-	// Restore preview after a brief delay
-	go func() {
-		time.Sleep(1500 * time.Millisecond)
-		eh.app.JigApp().QueueUpdateDraw(func() {
-			eh.refreshSidePanel()
-		})
-	}()
+// showYankMenu offers the run's IDs, type, CLI command, and link, and the
+// selected event's JSON and payload, for copying.
+func (eh *EventHistory) showYankMenu() {
+	targets := eh.app.workflowYankTargets(eh.workflowID, eh.runID, nil)
+	targets = append(targets, eventYankTargets(eh.selectedEvent())...)
+	eh.app.showYankMenu(targets)
 }
 
 // refreshSidePanel updates the side panel based on current selection.
@@ -1218,46 +1494,102 @@ func highlightJSONValue(s string) string {
 	return result
 }
 
-// jumpToChildWorkflow navigates to the child workflow if the selected event is a child workflow event.
-func (eh *EventHistory) jumpToChildWorkflow() {
-	var childWorkflowID, childRunID string
+// isChildWorkflowEvent reports whether an event belongs to a child workflow
+// started by this run, as opposed to an external workflow it signals.
+func isChildWorkflowEvent(ev temporal.EnhancedHistoryEvent) bool {
+	return ev.ChildWorkflowID != "" && strings.Contains(ev.Type, "ChildWorkflow")
+}
 
+// childExecutionOf returns the child workflow the selected events belong to.
+// An event from before the child started, such as the initiated event, has no
+// run ID, so the run is looked up in the rest of the history; a child that
+// never started has none, and "" opens its latest run.
+func childExecutionOf(selected []*temporal.EnhancedHistoryEvent, all []temporal.EnhancedHistoryEvent) (workflowID, runID string) {
+	for _, ev := range selected {
+		if !isChildWorkflowEvent(*ev) {
+			continue
+		}
+		workflowID = ev.ChildWorkflowID
+		if ev.ChildRunID != "" {
+			return workflowID, ev.ChildRunID
+		}
+	}
+	if workflowID == "" {
+		return "", ""
+	}
+	for _, ev := range all {
+		if isChildWorkflowEvent(ev) && ev.ChildWorkflowID == workflowID && ev.ChildRunID != "" {
+			return workflowID, ev.ChildRunID
+		}
+	}
+	return workflowID, ""
+}
+
+// isExternalSignalEvent reports whether an event records a signal this run
+// sent to another workflow.
+func isExternalSignalEvent(ev temporal.EnhancedHistoryEvent) bool {
+	return ev.ChildWorkflowID != "" &&
+		(ev.Type == "SignalExternalWorkflowExecutionInitiated" || ev.Type == "ExternalWorkflowExecutionSignaled")
+}
+
+// linkedExecutionOf returns the workflow the selected events lead to: a child
+// workflow, or the target of a signal sent to an external workflow. A signal
+// sent without a run ID went to the target's current run, and "" opens its
+// latest run.
+func linkedExecutionOf(selected []*temporal.EnhancedHistoryEvent, all []temporal.EnhancedHistoryEvent) (workflowID, runID string) {
+	if workflowID, runID = childExecutionOf(selected, all); workflowID != "" {
+		return workflowID, runID
+	}
+	for _, ev := range selected {
+		if isExternalSignalEvent(*ev) {
+			return ev.ChildWorkflowID, ev.ChildRunID
+		}
+	}
+	return "", ""
+}
+
+// selectedLinkedWorkflow returns the execution the selection links to: the
+// child workflow of a child workflow event or node, the target of an external
+// signal, or the new run of a continue-as-new event.
+func (eh *EventHistory) selectedLinkedWorkflow() (workflowID, runID string) {
 	switch eh.viewMode {
 	case ViewModeList:
 		row := eh.table.SelectedRow()
 		if row >= 0 && row < len(eh.enhancedEvents) {
 			ev := eh.enhancedEvents[row]
-			childWorkflowID = ev.ChildWorkflowID
-			childRunID = ev.ChildRunID
+			if ev.NewExecutionRunID != "" {
+				// Continue-as-new links to the next run of the same workflow
+				return eh.workflowID, ev.NewExecutionRunID
+			}
+			return linkedExecutionOf([]*temporal.EnhancedHistoryEvent{&ev}, eh.enhancedEvents)
 		}
 	case ViewModeTree:
-		node := eh.treeView.SelectedNode()
-		if node != nil && node.Type == temporal.GroupChildWorkflow {
-			// Find child workflow info from the node's events
-			for _, ev := range node.Events {
-				if ev.ChildWorkflowID != "" && ev.ChildRunID != "" {
-					childWorkflowID = ev.ChildWorkflowID
-					childRunID = ev.ChildRunID
-					break
-				}
-			}
+		if node := eh.treeView.SelectedNode(); node != nil {
+			return linkedExecutionOf(node.Events, eh.enhancedEvents)
 		}
 	case ViewModeTimeline:
-		lane := eh.timelineView.SelectedLane()
-		if lane != nil && lane.Node != nil && lane.Node.Type == temporal.GroupChildWorkflow {
-			// Find child workflow info from the node's events
-			for _, ev := range lane.Node.Events {
-				if ev.ChildWorkflowID != "" && ev.ChildRunID != "" {
-					childWorkflowID = ev.ChildWorkflowID
-					childRunID = ev.ChildRunID
-					break
-				}
-			}
+		if lane := eh.timelineView.SelectedLane(); lane != nil && lane.Node != nil {
+			return linkedExecutionOf(lane.Node.Events, eh.enhancedEvents)
 		}
 	}
+	return "", ""
+}
 
-	// Navigate if we have valid child workflow info
-	if childWorkflowID != "" && childRunID != "" {
-		eh.app.NavigateToWorkflowDetail(childWorkflowID, childRunID)
+// openLinkedWorkflow opens the detail of the execution the selection links
+// to, returning false if it links to none.
+func (eh *EventHistory) openLinkedWorkflow() bool {
+	workflowID, runID := eh.selectedLinkedWorkflow()
+	if workflowID == "" {
+		return false
+	}
+	eh.app.NavigateToWorkflowDetail(workflowID, runID)
+	return true
+}
+
+// jumpToChildWorkflow navigates to the child workflow if the selected event is a child workflow event,
+// to the signaled workflow if it is an external signal, or to the new run if it is a continue-as-new event.
+func (eh *EventHistory) jumpToChildWorkflow() {
+	if !eh.openLinkedWorkflow() {
+		eh.app.ToastWarning("Event does not link to another workflow")
 	}
 }
@@ -0,0 +1,77 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/gdamore/tcell/v2"
+)
+
+// eventStyleOverrides holds user-configured icons and colors keyed by event type.
+var eventStyleOverrides map[string]config.EventStyle
+
+// SetEventStyles installs user-configured event icon and color overrides.
+// Keys are event types such as "ActivityTaskFailed" and match case-insensitively.
+func SetEventStyles(styles map[string]config.EventStyle) {
+	eventStyleOverrides = make(map[string]config.EventStyle, len(styles))
+	for eventType, style := range styles {
+		eventStyleOverrides[strings.ToLower(eventType)] = style
+	}
+}
+
+// eventStyleOverride returns the configured style for an event type, if any.
+func eventStyleOverride(eventType string) (config.EventStyle, bool) {
+	if len(eventStyleOverrides) == 0 {
+		return config.EventStyle{}, false
+	}
+	style, ok := eventStyleOverrides[strings.ToLower(eventType)]
+	return style, ok
+}
+
+// resolveStyleColor converts a configured color into a tcell color.
+// Theme roles are resolved at render time so overrides follow theme changes.
+func resolveStyleColor(name string) (tcell.Color, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "":
+		return tcell.ColorDefault, false
+	case "fg":
+		return theme.Fg(), true
+	case "dim":
+		return theme.FgDim(), true
+	case "accent":
+		return theme.Accent(), true
+	case "success":
+		return theme.Success(), true
+	case "warning":
+		return theme.Warning(), true
+	case "error":
+		return theme.Error(), true
+	case "info":
+		return theme.Info(), true
+	}
+
+	color := tcell.GetColor(name)
+	if color == tcell.ColorDefault {
+		return tcell.ColorDefault, false
+	}
+	return color, true
+}
+
+// overrideIcon returns the configured icon for an event type.
+func overrideIcon(eventType string) (string, bool) {
+	style, ok := eventStyleOverride(eventType)
+	if !ok || style.Icon == "" {
+		return "", false
+	}
+	return style.Icon, true
+}
+
+// overrideColor returns the configured color for an event type.
+func overrideColor(eventType string) (tcell.Color, bool) {
+	style, ok := eventStyleOverride(eventType)
+	if !ok {
+		return tcell.ColorDefault, false
+	}
+	return resolveStyleColor(style.Color)
+}
@@ -0,0 +1,99 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// externalViewer returns the command used to open files outside the TUI:
+// $EDITOR, then $PAGER, then less.
+func externalViewer() string {
+	for _, env := range []string{"EDITOR", "PAGER"} {
+		if cmd := strings.TrimSpace(os.Getenv(env)); cmd != "" {
+			return cmd
+		}
+	}
+	return "less"
+}
+
+// shellQuote quotes s as a single POSIX shell word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// openInExternalViewer writes content to a temporary file and opens it in
+// the external viewer. The TUI is suspended while the viewer runs and
+// redrawn once it exits; the file is removed afterwards. It must be called
+// from the UI goroutine.
+func (a *App) openInExternalViewer(name string, content []byte) error {
+	f, err := os.CreateTemp("", "tempo-*-"+name)
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	// $EDITOR often carries arguments (e.g. "code --wait"), so run it through the shell
+	viewer := externalViewer()
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", viewer+` "`+path+`"`)
+	} else {
+		cmd = exec.Command("sh", "-c", viewer+" "+shellQuote(path))
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	var runErr error
+	if !a.app.Suspend(func() {
+		runErr = cmd.Run()
+	}) {
+		return fmt.Errorf("could not suspend the terminal")
+	}
+	if runErr != nil {
+		return fmt.Errorf("%s: %w", viewer, runErr)
+	}
+	return nil
+}
+
+// openHistoryExternally fetches the raw history as JSON and opens it in
+// $EDITOR or $PAGER.
+func (wd *WorkflowDetail) openHistoryExternally() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		wd.app.ToastWarning("Raw history is not available without a connection")
+		return
+	}
+	namespace := wd.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		data, err := provider.GetWorkflowHistoryJSON(ctx, namespace, wd.workflowID, wd.runID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.app.ToastError(fmt.Sprintf("Failed to load history: %v", err))
+				return
+			}
+			if err := wd.app.openInExternalViewer(payloadFileName(wd.workflowID, "history"), data); err != nil {
+				wd.app.ToastError(err.Error())
+			}
+		})
+	}()
+}
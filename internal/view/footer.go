@@ -0,0 +1,97 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/rivo/tview"
+)
+
+// compactHintCount is how many view hints the compact footer shows.
+const compactHintCount = 4
+
+// genericHintKeys are hints every view repeats. The compact footer skips them
+// in favor of the view's own actions.
+var genericHintKeys = map[string]bool{
+	"j/k": true,
+	"esc": true,
+	"t":   true,
+	"?":   true,
+}
+
+// compactHints picks the first view-specific hints and appends "? Help".
+// Views list their most important actions first.
+func compactHints(hints []KeyHint) []KeyHint {
+	compact := make([]KeyHint, 0, compactHintCount+1)
+	for _, h := range hints {
+		if len(compact) == compactHintCount {
+			break
+		}
+		if genericHintKeys[strings.ToLower(h.Key)] {
+			continue
+		}
+		compact = append(compact, h)
+	}
+	return append(compact, KeyHint{Key: "?", Description: "Help"})
+}
+
+// footerMode returns the configured footer mode.
+func (a *App) footerMode() string {
+	if a.config == nil {
+		return "full"
+	}
+	return a.config.GetFooter()
+}
+
+// setFooterHints shows a view's hints in the footer, trimmed to the top few
+// in compact mode.
+func (a *App) setFooterHints(hints []KeyHint) {
+	if a.footerMode() == "compact" {
+		hints = compactHints(hints)
+	}
+	a.menu.SetHints(hints)
+}
+
+// footerBar returns the bottom bar for the configured mode, or nil when the
+// footer is off.
+func (a *App) footerBar() tview.Primitive {
+	if a.footerMode() == "off" {
+		return nil
+	}
+	return a.menu
+}
+
+// applyFooterMode adds or removes the footer to match the configured mode.
+func (a *App) applyFooterMode() {
+	a.app.SetBottomBar(a.footerBar())
+	if current := a.app.Pages().Current(); current != nil {
+		a.setFooterHints(current.Hints())
+	}
+	a.updateCrumbs()
+}
+
+// cycleFooter switches the footer between full, compact, and off, and saves
+// the choice to the config.
+func (a *App) cycleFooter() {
+	if a.config == nil {
+		a.ToastWarning("The footer setting needs a config file")
+		return
+	}
+
+	next := config.FooterModes[0]
+	for i, mode := range config.FooterModes {
+		if mode == a.footerMode() {
+			next = config.FooterModes[(i+1)%len(config.FooterModes)]
+			break
+		}
+	}
+	a.config.Footer = next
+	a.applyFooterMode()
+
+	if err := a.config.Save(); err != nil {
+		a.ToastError(fmt.Sprintf("Failed to save footer setting: %v", err))
+		return
+	}
+	a.ToastSuccess(fmt.Sprintf("Footer: %s", next))
+}
@@ -0,0 +1,119 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/layout"
+	"github.com/atterpac/jig/theme"
+)
+
+// staleAfter is how old a view's data can get before the staleness
+// indicator switches to the warning color.
+const staleAfter = time.Minute
+
+// Reloader is implemented by views that can reload their data. Ctrl+R calls
+// it on the active view.
+type Reloader interface {
+	Reload()
+}
+
+// FreshnessReporter is implemented by views that show server data and know
+// when it was last loaded successfully.
+type FreshnessReporter interface {
+	LastLoaded() time.Time
+}
+
+// freshness records when a view last loaded its data. Embed it in a view and
+// call markLoaded after each successful load to get a staleness indicator.
+type freshness struct {
+	loadedAt time.Time
+}
+
+// markLoaded records a successful load.
+func (f *freshness) markLoaded() {
+	f.loadedAt = time.Now()
+}
+
+// LastLoaded returns the time of the last successful load, or the zero time
+// if nothing has loaded yet.
+func (f *freshness) LastLoaded() time.Time {
+	return f.loadedAt
+}
+
+// formatStaleness describes the age of data, e.g. "updated 12s ago".
+func formatStaleness(age time.Duration) string {
+	switch {
+	case age < 5*time.Second:
+		return "updated just now"
+	case age < time.Minute:
+		return fmt.Sprintf("updated %ds ago", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("updated %dm ago", int(age.Minutes()))
+	}
+	return fmt.Sprintf("updated %dh ago", int(age.Hours()))
+}
+
+// reloadCurrentView reloads the active view's data, if it supports it.
+func (a *App) reloadCurrentView() {
+	current := a.app.Pages().Current()
+	if reloader, ok := current.(Reloader); ok {
+		reloader.Reload()
+	}
+}
+
+// stalenessMonitor redraws the staleness indicator every second.
+func (a *App) stalenessMonitor() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stopMonitor:
+			return
+		case <-ticker.C:
+			a.app.QueueUpdateDraw(a.updateStaleness)
+		}
+	}
+}
+
+// updateStaleness shows how long ago the active view loaded its data as
+// status bar section 3, or removes the section for views without server data.
+func (a *App) updateStaleness() {
+	// Section 3 follows the connection status; wait until that exists
+	count := a.statusBar.SectionCount()
+	if count < 3 {
+		return
+	}
+
+	var loadedAt time.Time
+	if reporter, ok := a.app.Pages().Current().(FreshnessReporter); ok {
+		loadedAt = reporter.LastLoaded()
+	}
+
+	if loadedAt.IsZero() {
+		if count > 3 {
+			a.statusBar.SetSections([]layout.StatusSection{
+				a.statusBar.GetSection(0),
+				a.statusBar.GetSection(1),
+				a.statusBar.GetSection(2),
+			})
+		}
+		return
+	}
+
+	age := time.Since(loadedAt)
+	colorFunc := theme.FgDim
+	if age >= staleAfter {
+		colorFunc = theme.Warning
+	}
+	section := layout.StatusSection{
+		Text:      formatStaleness(age),
+		ColorFunc: colorFunc,
+	}
+	if count > 3 {
+		a.statusBar.UpdateSection(3, section)
+	} else {
+		a.statusBar.AddSection(section)
+	}
+}
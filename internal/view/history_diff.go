@@ -0,0 +1,346 @@
+package view
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/input"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// HistoryDiffView aligns the event sequences of two exported histories and
+// highlights where their shape diverges.
+type HistoryDiffView struct {
+	*components.MasterDetailView
+	app     *App
+	pathA   string
+	pathB   string
+	table   *components.Table
+	preview *tview.TextView
+	eventsA []temporal.EnhancedHistoryEvent
+	eventsB []temporal.EnhancedHistoryEvent
+	rows    []temporal.ShapeRow
+	first   int
+}
+
+// NewHistoryDiffView creates a shape diff of the histories at pathA and pathB.
+func NewHistoryDiffView(app *App, pathA, pathB string) *HistoryDiffView {
+	hd := &HistoryDiffView{
+		app:     app,
+		pathA:   pathA,
+		pathB:   pathB,
+		table:   components.NewTable(),
+		preview: tview.NewTextView(),
+		first:   -1,
+	}
+	hd.setup()
+
+	// Register for automatic theme refresh
+	theme.RegisterRefreshable(hd)
+
+	return hd
+}
+
+func (hd *HistoryDiffView) setup() {
+	hd.table.SetHeaders("A", "EVENT A", "B", "EVENT B")
+	hd.table.SetBorder(false)
+	hd.table.SetBackgroundColor(theme.Bg())
+
+	hd.preview.SetDynamicColors(true)
+	hd.preview.SetBackgroundColor(theme.Bg())
+	hd.preview.SetTextColor(theme.Fg())
+	hd.preview.SetWordWrap(true)
+
+	hd.MasterDetailView = components.NewMasterDetailView().
+		SetMasterTitle(fmt.Sprintf("%s History Diff: %s ↔ %s", icons.Event, filepath.Base(hd.pathA), filepath.Base(hd.pathB))).
+		SetDetailTitle(fmt.Sprintf("%s Details", icons.Info)).
+		SetMasterContent(hd.table).
+		SetDetailContent(hd.preview).
+		SetRatio(0.6).
+		ConfigureEmpty(icons.Info, "No Events", "Neither history has any events")
+
+	hd.table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(hd.rows) {
+			hd.updatePreview(row - 1)
+		}
+	})
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (hd *HistoryDiffView) RefreshTheme() {
+	bg := theme.Bg()
+	hd.table.SetBackgroundColor(bg)
+	hd.preview.SetBackgroundColor(bg)
+	hd.preview.SetTextColor(theme.Fg())
+	hd.populateTable()
+}
+
+// loadData reads both history files and aligns them.
+func (hd *HistoryDiffView) loadData() {
+	eventsA, err := temporal.LoadHistoryFile(hd.pathA)
+	if err != nil {
+		hd.showError(err)
+		return
+	}
+	eventsB, err := temporal.LoadHistoryFile(hd.pathB)
+	if err != nil {
+		hd.showError(err)
+		return
+	}
+	hd.eventsA, hd.eventsB = eventsA, eventsB
+	hd.rows, hd.first = temporal.DiffHistoryShape(eventsA, eventsB)
+	hd.populateTable()
+	if hd.first >= 0 {
+		hd.table.SelectRow(hd.first)
+		hd.updatePreview(hd.first)
+	}
+}
+
+// shapeLabel renders an event as its type plus what it acts on.
+func shapeLabel(ev *temporal.EnhancedHistoryEvent) string {
+	if ev == nil {
+		return ""
+	}
+	if name := getEventName(ev); name != "" {
+		return fmt.Sprintf("%s (%s)", ev.Type, name)
+	}
+	return ev.Type
+}
+
+func shapeEventID(ev *temporal.EnhancedHistoryEvent) string {
+	if ev == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", ev.ID)
+}
+
+func (hd *HistoryDiffView) populateTable() {
+	currentRow := hd.table.SelectedRow()
+
+	hd.table.ClearRows()
+	hd.table.SetHeaders("A", "EVENT A", "B", "EVENT B")
+
+	for _, r := range hd.rows {
+		cells := []string{shapeEventID(r.A), shapeLabel(r.A), shapeEventID(r.B), shapeLabel(r.B)}
+		switch r.Op {
+		case temporal.ShapeOnlyA:
+			hd.table.AddRowWithColor(theme.Error(), cells...)
+		case temporal.ShapeOnlyB:
+			hd.table.AddRowWithColor(theme.Success(), cells...)
+		default:
+			hd.table.AddRow(cells...)
+		}
+	}
+
+	if len(hd.rows) == 0 {
+		hd.preview.SetText(hd.summary())
+		return
+	}
+	if currentRow < 0 || currentRow >= len(hd.rows) {
+		currentRow = 0
+	}
+	hd.table.SelectRow(currentRow)
+	hd.updatePreview(currentRow)
+}
+
+// summary describes both histories and where they first diverge.
+func (hd *HistoryDiffView) summary() string {
+	var onlyA, onlyB int
+	for _, r := range hd.rows {
+		switch r.Op {
+		case temporal.ShapeOnlyA:
+			onlyA++
+		case temporal.ShapeOnlyB:
+			onlyB++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[%s]A[-] [%s]%s[-] [%s](%d events)[-]\n", theme.TagFgDim(), theme.TagFg(), tview.Escape(hd.pathA), theme.TagFgDim(), len(hd.eventsA)))
+	sb.WriteString(fmt.Sprintf("[%s]B[-] [%s]%s[-] [%s](%d events)[-]\n\n", theme.TagFgDim(), theme.TagFg(), tview.Escape(hd.pathB), theme.TagFgDim(), len(hd.eventsB)))
+
+	if hd.first < 0 {
+		sb.WriteString(fmt.Sprintf("[%s]%s Same event shape[-]", theme.TagSuccess(), icons.Check))
+		return sb.String()
+	}
+	r := hd.rows[hd.first]
+	sb.WriteString(fmt.Sprintf("[%s::b]First divergence[-:-:-]\n", theme.TagWarning()))
+	switch {
+	case r.A != nil && r.B == nil:
+		sb.WriteString(fmt.Sprintf("[%s]A #%d %s is missing from B[-]", theme.TagFg(), r.A.ID, tview.Escape(shapeLabel(r.A))))
+	case r.B != nil && r.A == nil:
+		sb.WriteString(fmt.Sprintf("[%s]B #%d %s is not in A[-]", theme.TagFg(), r.B.ID, tview.Escape(shapeLabel(r.B))))
+	}
+	sb.WriteString(fmt.Sprintf("\n\n[%s]%d only in A, %d only in B[-]", theme.TagFgDim(), onlyA, onlyB))
+	return sb.String()
+}
+
+func (hd *HistoryDiffView) updatePreview(index int) {
+	if index < 0 || index >= len(hd.rows) {
+		return
+	}
+	r := hd.rows[index]
+
+	var sb strings.Builder
+	sb.WriteString(hd.summary())
+	side := func(label string, ev *temporal.EnhancedHistoryEvent) {
+		sb.WriteString(fmt.Sprintf("\n\n[%s::b]%s[-:-:-]\n", theme.TagAccent(), label))
+		if ev == nil {
+			sb.WriteString(fmt.Sprintf("[%s]No matching event[-]", theme.TagFgDim()))
+			return
+		}
+		sb.WriteString(fmt.Sprintf("[%s]#%d %s[-]\n", eventColorTag(ev.Type), ev.ID, tview.Escape(shapeLabel(ev))))
+		sb.WriteString(formatEventDetails(ev.Details, false, "open the history file to see the rest"))
+	}
+	side("Event A", r.A)
+	side("Event B", r.B)
+	hd.preview.SetText(sb.String())
+}
+
+// jumpToDifference selects the next (dir > 0) or previous row that is not
+// in both histories.
+func (hd *HistoryDiffView) jumpToDifference(dir int) {
+	row := hd.table.SelectedRow()
+	for i := row + dir; i >= 0 && i < len(hd.rows); i += dir {
+		if hd.rows[i].Op != temporal.ShapeSame {
+			hd.table.SelectRow(i)
+			hd.updatePreview(i)
+			return
+		}
+	}
+	hd.app.ToastWarning("No more differences")
+}
+
+func (hd *HistoryDiffView) showError(err error) {
+	hd.rows = nil
+	hd.table.ClearRows()
+	hd.table.SetHeaders("A", "EVENT A", "B", "EVENT B")
+	hd.table.AddRowWithColor(theme.Error(),
+		"",
+		icons.Error+" Error loading history",
+		"",
+		err.Error(),
+	)
+	hd.preview.SetText("")
+}
+
+// Name returns the view name.
+func (hd *HistoryDiffView) Name() string {
+	return "history-diff"
+}
+
+// Reload rereads both history files.
+func (hd *HistoryDiffView) Reload() {
+	hd.loadData()
+}
+
+// Start is called when the view becomes active.
+func (hd *HistoryDiffView) Start() {
+	bindings := input.NewKeyBindings().
+		OnRune('n', func(e *tcell.EventKey) bool {
+			hd.jumpToDifference(1)
+			return true
+		}).
+		OnRune('N', func(e *tcell.EventKey) bool {
+			hd.jumpToDifference(-1)
+			return true
+		}).
+		OnRune('r', func(e *tcell.EventKey) bool {
+			hd.loadData()
+			return true
+		}).
+		OnRune('p', func(e *tcell.EventKey) bool {
+			hd.ToggleDetail()
+			return true
+		})
+	addEdgeNav(bindings, tableEdgeNav{hd.table}, true)
+
+	hd.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if bindings.Handle(event) {
+			return nil
+		}
+		return event
+	})
+	hd.loadData()
+}
+
+// Stop is called when the view is deactivated.
+func (hd *HistoryDiffView) Stop() {
+	hd.table.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (hd *HistoryDiffView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "n/N", Description: "Next/Prev Difference"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "r", Description: "Reload Files"},
+		{Key: "p", Description: "Preview"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the table.
+func (hd *HistoryDiffView) Focus(delegate func(p tview.Primitive)) {
+	delegate(hd.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (hd *HistoryDiffView) Draw(screen tcell.Screen) {
+	bg := theme.Bg()
+	hd.preview.SetBackgroundColor(bg)
+	hd.preview.SetTextColor(theme.Fg())
+	hd.MasterDetailView.Draw(screen)
+}
+
+// promptHistoryFiles asks for two exported history files and opens their
+// shape diff.
+func (wd *WorkflowDiff) promptHistoryFiles() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Diff History Files", icons.Event),
+		Width:    80,
+		Height:   14,
+		Backdrop: true,
+	})
+
+	form := components.NewFormBuilder().
+		Text("historyA", "History A").
+		Value(payloadExportDir() + string(filepath.Separator)).
+		Validate(validators.Required()).
+		Done().
+		Text("historyB", "History B").
+		Value(payloadExportDir() + string(filepath.Separator)).
+		Validate(validators.Required()).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			wd.closeModal()
+			pathA, errA := resolvePayloadPath(values["historyA"].(string))
+			pathB, errB := resolvePayloadPath(values["historyB"].(string))
+			if errA != nil || errB != nil {
+				wd.app.ToastError("Both history paths are required")
+				return
+			}
+			wd.app.NavigateToHistoryDiff(pathA, pathB)
+		}).
+		OnCancel(func() {
+			wd.closeModal()
+		}).
+		Build()
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Compare"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(form)
+}
@@ -0,0 +1,184 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// historyFormatLabels names the export formats in the picker.
+var historyFormatLabels = map[temporal.HistoryFormat]string{
+	temporal.HistoryFormatJSON:  "JSON (temporal workflow show --output json)",
+	temporal.HistoryFormatProto: "Protobuf (binary History message)",
+}
+
+// showHistoryExport asks for a format and a path, then writes the run's full
+// history there.
+func (a *App) showHistoryExport(workflowID, runID string) {
+	if a.Provider() == nil {
+		a.ToastWarning("History export needs a server connection")
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Export History", icons.Database),
+		Width:    70,
+		Height:   8,
+		Backdrop: true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("FORMAT")
+	table.SetBorder(false)
+	for _, format := range temporal.HistoryFormats {
+		table.AddRow(historyFormatLabels[format])
+	}
+	table.SelectRow(0)
+
+	table.SetOnSelect(func(row int) {
+		if row < 0 || row >= len(temporal.HistoryFormats) {
+			return
+		}
+		a.app.Pages().DismissModal()
+		a.showHistoryExportPath(workflowID, runID, temporal.HistoryFormats[row])
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Choose"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		a.app.Pages().DismissModal()
+	})
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(table)
+}
+
+// showHistoryExportPath prompts for the file to export to, defaulting to the
+// exports directory under the config dir.
+func (a *App) showHistoryExportPath(workflowID, runID string, format temporal.HistoryFormat) {
+	closePrompt := func() {
+		a.app.Pages().DismissModal()
+	}
+
+	fileName := safeFileName(workflowID, runID, "history") + format.Ext()
+	form := components.NewFormBuilder().
+		Text("path", "Path").
+		Value(filepath.Join(payloadExportDir(), fileName)).
+		Validate(validators.Required()).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			path := values["path"].(string)
+			closePrompt()
+			a.exportHistory(workflowID, runID, format, path)
+		}).
+		OnCancel(closePrompt).
+		Build()
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Export History", icons.Database),
+		Width:    80,
+		Height:   9,
+		Backdrop: true,
+	})
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Export"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(form)
+}
+
+// exportHistory fetches the full history in format and writes it to path.
+func (a *App) exportHistory(workflowID, runID string, format temporal.HistoryFormat, path string) {
+	provider := a.Provider()
+	if provider == nil {
+		return
+	}
+	resolved, err := resolvePayloadPath(path)
+	if err != nil {
+		a.ToastError(err.Error())
+		return
+	}
+	namespace := a.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		data, err := provider.ExportWorkflowHistory(ctx, namespace, workflowID, runID, format)
+		if err == nil {
+			err = writeHistoryFile(resolved, data)
+		}
+
+		a.app.QueueUpdateDraw(func() {
+			if err != nil {
+				a.ToastError(fmt.Sprintf("Failed to export history: %v", err))
+				return
+			}
+			a.showHistoryExported(resolved, len(data))
+		})
+	}()
+}
+
+// writeHistoryFile writes exported history bytes, creating parent
+// directories as needed.
+func writeHistoryFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// showHistoryExported confirms where an exported history was written.
+func (a *App) showHistoryExported(path string, size int) {
+	text := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true)
+	text.SetBackgroundColor(theme.Bg())
+	text.SetText(fmt.Sprintf("[%s]Wrote %s to[-]\n\n[%s]%s[-]",
+		theme.TagFgDim(), formatByteSize(int64(size)), theme.TagFg(), tview.Escape(path)))
+
+	closeModal := func() {
+		a.app.Pages().DismissModal()
+	}
+	text.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEnter {
+			closeModal()
+			return nil
+		}
+		return event
+	})
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s History Exported", icons.Completed),
+		Width:    80,
+		Height:   9,
+		Backdrop: true,
+	})
+	modal.SetContent(text)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter/Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(closeModal)
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(text)
+}
@@ -0,0 +1,102 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/components"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// recentHistoryLimit is how many events "load recent" fetches from a large
+// history.
+const recentHistoryLimit = 1000
+
+// isLargeHistory reports whether loading a workflow's whole history should be
+// confirmed first.
+func (a *App) isLargeHistory(w *temporal.Workflow) bool {
+	threshold := config.DefaultLargeHistoryEvents
+	if a.config != nil {
+		threshold = a.config.GetLargeHistoryEvents()
+	}
+	return w != nil && w.HistoryLength > int64(threshold)
+}
+
+// formatHistorySize describes a history's length and size, e.g.
+// "250000 events, 1.2 GB".
+func formatHistorySize(w *temporal.Workflow) string {
+	text := fmt.Sprintf("%d events", w.HistoryLength)
+	if w.HistorySizeBytes > 0 {
+		text += ", " + formatByteSize(w.HistorySizeBytes)
+	}
+	return text
+}
+
+// formatByteSize formats a byte count with a decimal unit, e.g. "3.4 MB".
+func formatByteSize(n int64) string {
+	const unit = 1000
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "kMGTPE"[exp])
+}
+
+// showLargeHistoryPrompt asks how much of a large history to load. onLoad is
+// called with 0 to load everything or with the number of recent events to
+// load; onCancel is called if nothing should be loaded.
+func (a *App) showLargeHistoryPrompt(w *temporal.Workflow, onLoad func(limit int), onCancel func()) {
+	choices := []struct {
+		label string
+		limit int
+	}{
+		{fmt.Sprintf("Load the last %d events", recentHistoryLimit), recentHistoryLimit},
+		{fmt.Sprintf("Load all %d events", w.HistoryLength), 0},
+		{"Cancel", -1},
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Large History (%s)", icons.Warning, formatHistorySize(w)),
+		Width:    70,
+		Height:   9,
+		Backdrop: true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("LOAD")
+	table.SetBorder(false)
+	for _, c := range choices {
+		table.AddRow(c.label)
+	}
+	table.SelectRow(0)
+
+	table.SetOnSelect(func(row int) {
+		if row < 0 || row >= len(choices) {
+			return
+		}
+		a.app.Pages().DismissModal()
+		if choices[row].limit < 0 {
+			onCancel()
+			return
+		}
+		onLoad(choices[row].limit)
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Choose"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		a.app.Pages().DismissModal()
+		onCancel()
+	})
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(table)
+}
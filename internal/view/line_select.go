@@ -0,0 +1,146 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// lineSelect adds a visual line-selection mode to a scrollable TextView:
+// press v to mark the top line, move with j/k to extend the range, and y to
+// copy the selected lines as plain text.
+//
+// While selecting, every line is wrapped in its own region so the range can
+// be highlighted, and wrapping is turned off so scroll rows match lines.
+type lineSelect struct {
+	view   *tview.TextView
+	wrap   bool
+	text   string
+	lines  []string
+	active bool
+	anchor int
+	cursor int
+}
+
+// newLineSelect creates a line selector for view. wrap is the view's normal
+// wrapping mode, restored when selection ends.
+func newLineSelect(view *tview.TextView, wrap bool) *lineSelect {
+	return &lineSelect{view: view, wrap: wrap}
+}
+
+// Active returns true while a selection is in progress.
+func (ls *lineSelect) Active() bool {
+	return ls.active
+}
+
+// Start enters selection mode with the mark on the top visible line.
+func (ls *lineSelect) Start() {
+	if ls.active {
+		return
+	}
+	ls.text = ls.view.GetText(false)
+	ls.lines = strings.Split(ls.view.GetText(true), "\n")
+
+	rendered := strings.Split(ls.text, "\n")
+	var sb strings.Builder
+	for i, line := range rendered {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf(`["%d"]%s[""]`, i, line))
+	}
+
+	row, _ := ls.view.GetScrollOffset()
+	ls.active = true
+	ls.anchor = max(0, min(row, len(ls.lines)-1))
+	ls.cursor = ls.anchor
+
+	ls.view.SetRegions(true).SetWrap(false).SetText(sb.String())
+	ls.view.ScrollTo(ls.anchor, 0)
+	ls.highlight()
+}
+
+// Stop leaves selection mode and restores the original text.
+func (ls *lineSelect) Stop() {
+	if !ls.active {
+		return
+	}
+	ls.active = false
+	row, _ := ls.view.GetScrollOffset()
+	ls.view.Highlight()
+	ls.view.SetRegions(false).SetWrap(ls.wrap).SetText(ls.text)
+	ls.view.ScrollTo(row, 0)
+}
+
+// Move moves the cursor by delta lines, extending the selection.
+func (ls *lineSelect) Move(delta int) {
+	ls.cursor = max(0, min(ls.cursor+delta, len(ls.lines)-1))
+	ls.highlight()
+}
+
+// Selected returns the selected lines with all style tags removed.
+func (ls *lineSelect) Selected() string {
+	from, to := min(ls.anchor, ls.cursor), max(ls.anchor, ls.cursor)
+	return strings.Join(ls.lines[from:to+1], "\n")
+}
+
+// highlight marks the selected lines and keeps the cursor on screen.
+func (ls *lineSelect) highlight() {
+	from, to := min(ls.anchor, ls.cursor), max(ls.anchor, ls.cursor)
+	ids := make([]string, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		ids = append(ids, fmt.Sprintf("%d", i))
+	}
+	ls.view.Highlight(ids...)
+
+	row, col := ls.view.GetScrollOffset()
+	_, _, _, height := ls.view.GetInnerRect()
+	switch {
+	case ls.cursor < row:
+		ls.view.ScrollTo(ls.cursor, col)
+	case height > 0 && ls.cursor >= row+height:
+		ls.view.ScrollTo(ls.cursor-height+1, col)
+	}
+}
+
+// HandleKey handles a key press while selecting. It returns the copied text
+// when the selection is yanked, and whether the key was consumed.
+// All keys are consumed during selection so the modal's own bindings
+// cannot change the text underneath it.
+func (ls *lineSelect) HandleKey(event *tcell.EventKey) (copied string, handled bool) {
+	if !ls.active {
+		return "", false
+	}
+	_, _, _, height := ls.view.GetInnerRect()
+	switch event.Key() {
+	case tcell.KeyEscape:
+		ls.Stop()
+	case tcell.KeyDown:
+		ls.Move(1)
+	case tcell.KeyUp:
+		ls.Move(-1)
+	case tcell.KeyPgDn:
+		ls.Move(max(height, 1))
+	case tcell.KeyPgUp:
+		ls.Move(-max(height, 1))
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'j':
+			ls.Move(1)
+		case 'k':
+			ls.Move(-1)
+		case 'g':
+			ls.Move(-len(ls.lines))
+		case 'G':
+			ls.Move(len(ls.lines))
+		case 'v', 'q':
+			ls.Stop()
+		case 'y':
+			copied = ls.Selected()
+			ls.Stop()
+		}
+	}
+	return copied, true
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/atterpac/jig/util"
 	"github.com/atterpac/jig/validators"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -108,7 +109,7 @@ type HelpModal struct {
 func NewHelpModal() *HelpModal {
 	m := &HelpModal{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s Help", theme.IconInfo),
+			Title:    fmt.Sprintf("%s Help", icons.Info),
 			Width:    65,
 			Height:   25,
 			Backdrop: true,
@@ -166,6 +167,8 @@ func (m *HelpModal) updateContent() {
 [%s]?[-]          Show help
 [%s]T[-]          Change theme
 [%s]P[-]          Switch profile
+[%s]ctrl+r[-]     Reload current view
+[%s]F2[-]         Footer: full, compact, off
 [%s]esc[-]        Go back / Close modal
 [%s]q[-]          Quit application
 
@@ -174,6 +177,8 @@ func (m *HelpModal) updateContent() {
 		theme.TagAccent(),
 		theme.TagAccent(),
 		theme.TagAccent(),
+		theme.TagAccent(),
+		theme.TagAccent(),
 		theme.TagAccent())
 
 	// View-specific hints
@@ -229,7 +234,7 @@ type ThemeSelectorModal struct {
 func NewThemeSelectorModal() *ThemeSelectorModal {
 	m := &ThemeSelectorModal{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s Select Theme", theme.IconInfo),
+			Title:    fmt.Sprintf("%s Select Theme", icons.Info),
 			Width:    50,
 			Height:   20,
 			Backdrop: true,
@@ -328,8 +333,8 @@ func (m *ProfileModal) isExternal(name string) bool {
 func NewProfileModal() *ProfileModal {
 	m := &ProfileModal{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s Connection Profiles", theme.IconInfo),
-			Width:    55,
+			Title:    fmt.Sprintf("%s Connection Profiles", icons.Info),
+			Width:    75,
 			Height:   20,
 			Backdrop: true,
 		}),
@@ -340,7 +345,7 @@ func NewProfileModal() *ProfileModal {
 
 func (m *ProfileModal) setup() {
 	m.table = components.NewTable()
-	m.table.SetHeaders("", "PROFILE", "ADDRESS")
+	m.table.SetHeaders("", "PROFILE", "ADDRESS", "NAMESPACE")
 	m.table.SetBorder(false)
 
 	m.table.SetOnSelect(func(row int) {
@@ -413,13 +418,13 @@ func (m *ProfileModal) SetProfiles(profiles []string, active string, cfg *config
 		if m.isExternal(name) {
 			displayName = fmt.Sprintf("[%s]%s[-]", theme.TagFgDim(), name)
 		}
-		address := ""
+		address, namespace := "", ""
 		if cfg != nil {
 			if profile, ok := cfg.GetProfile(name); ok {
-				address = profile.Address
+				address, namespace = profile.Address, profile.Namespace
 			}
 		}
-		m.table.AddRow(marker, displayName, truncateMiddle(address, 25))
+		m.table.AddRow(marker, displayName, truncateMiddle(address, 25), truncateMiddle(namespace, 18))
 	}
 
 	if len(profiles) > 0 {
@@ -450,7 +455,7 @@ type ProfileForm struct {
 func NewProfileForm() *ProfileForm {
 	f := &ProfileForm{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s New Profile", theme.IconInfo),
+			Title:    fmt.Sprintf("%s New Profile", icons.Info),
 			Width:    60,
 			Height:   22,
 			Backdrop: true,
@@ -549,8 +554,13 @@ func (f *ProfileForm) buildForm(name string, cfg config.ConnectionConfig, isEdit
 				ServerName: values["tlsServerName"].(string),
 				SkipVerify: skipVerify,
 			},
-			APIKey:   cfg.APIKey,
-			GRPCMeta: cfg.GRPCMeta,
+			APIKey:    cfg.APIKey,
+			GRPCMeta:  cfg.GRPCMeta,
+			WebUI:     cfg.WebUI,
+			AuthToken: cfg.AuthToken,
+
+			CodecEndpoint: cfg.CodecEndpoint,
+			CodecAuth:     cfg.CodecAuth,
 		}
 
 		if f.onSave != nil {
@@ -572,9 +582,9 @@ func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
 	f.editName = name
 
 	if f.isEdit {
-		f.Modal.SetTitle(fmt.Sprintf("%s Edit Profile: %s", theme.IconInfo, name))
+		f.Modal.SetTitle(fmt.Sprintf("%s Edit Profile: %s", icons.Info, name))
 	} else {
-		f.Modal.SetTitle(fmt.Sprintf("%s New Profile", theme.IconInfo))
+		f.Modal.SetTitle(fmt.Sprintf("%s New Profile", icons.Info))
 	}
 
 	f.form = f.buildForm(name, cfg, f.isEdit)
@@ -612,7 +622,7 @@ type DeleteConfirmModal struct {
 func NewDeleteConfirmModal(itemType, itemName string) *DeleteConfirmModal {
 	m := &DeleteConfirmModal{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s Delete %s", theme.IconError, itemType),
+			Title:    fmt.Sprintf("%s Delete %s", icons.Error, itemType),
 			Width:    50,
 			Height:   10,
 			Backdrop: true,
@@ -681,7 +691,7 @@ type ErrorModal struct {
 func NewErrorModal(title, message string) *ErrorModal {
 	m := &ErrorModal{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s %s", theme.IconError, title),
+			Title:    fmt.Sprintf("%s %s", icons.Error, title),
 			Width:    55,
 			Height:   12,
 			Backdrop: true,
@@ -732,7 +742,7 @@ type InfoModal struct {
 func NewInfoModal(title, message string) *InfoModal {
 	m := &InfoModal{
 		Modal: components.NewModal(components.ModalConfig{
-			Title:    fmt.Sprintf("%s %s", theme.IconInfo, title),
+			Title:    fmt.Sprintf("%s %s", icons.Info, title),
 			Width:    55,
 			Height:   12,
 			Backdrop: true,
@@ -773,6 +783,28 @@ func (m *InfoModal) InputHandler() func(*tcell.EventKey, func(tview.Primitive))
 	})
 }
 
+// newFormError returns the line a form shows a failed submission on, so the
+// form stays open to be corrected.
+func newFormError() *tview.TextView {
+	errView := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	errView.SetBackgroundColor(theme.Bg())
+	return errView
+}
+
+// formWithError lays out a form above its error line.
+func formWithError(form tview.Primitive, errView *tview.TextView) *tview.Flex {
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(errView, 2, 0, false)
+	content.SetBackgroundColor(theme.Bg())
+	return content
+}
+
+// showFormError shows why a form's submission failed.
+func showFormError(errView *tview.TextView, err error) {
+	errView.SetText(fmt.Sprintf("[%s]%s %s[-]", theme.TagError(), icons.Error, tview.Escape(err.Error())))
+}
+
 // ShowErrorModal displays an error modal and handles cleanup on close.
 func ShowErrorModal(app *layout.App, title, message string) {
 	modal := NewErrorModal(title, message)
@@ -944,7 +976,7 @@ func (v *SplashTestView) updateDisplay() {
 	// Update sponsor
 	v.sponsorView.SetText(fmt.Sprintf(
 		"[%s]Made with %s  by getgalaxy.io[-]",
-		theme.TagFgDim(), theme.IconHeart,
+		theme.TagFgDim(), icons.Heart,
 	))
 
 	// Update all backgrounds
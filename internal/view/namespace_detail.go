@@ -11,6 +11,7 @@ import (
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -19,6 +20,7 @@ import (
 // NamespaceDetail displays detailed information about a namespace.
 type NamespaceDetail struct {
 	*tview.Flex
+	freshness
 	app       *App
 	namespace string
 	detail    *temporal.NamespaceDetail
@@ -70,13 +72,13 @@ func (nd *NamespaceDetail) setup() {
 	nd.clusterView.SetBackgroundColor(theme.Bg())
 
 	// Create panels with icons (blubber pattern)
-	nd.infoPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Namespace Info", theme.IconNamespace))
+	nd.infoPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Namespace Info", icons.Namespace))
 	nd.infoPanel.SetContent(nd.infoView)
 
-	nd.archivalPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Archival Configuration", theme.IconDatabase))
+	nd.archivalPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Archival Configuration", icons.Database))
 	nd.archivalPanel.SetContent(nd.archivalView)
 
-	nd.clusterPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Cluster & Replication", theme.IconServer))
+	nd.clusterPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Cluster & Replication", icons.Server))
 	nd.clusterPanel.SetContent(nd.clusterView)
 
 	// Left side: Info panel
@@ -119,6 +121,7 @@ func (nd *NamespaceDetail) loadData() {
 				return
 			}
 			nd.detail = detail
+			nd.markLoaded()
 			nd.render()
 		})
 	}()
@@ -130,6 +133,7 @@ func (nd *NamespaceDetail) loadMockData() {
 			Name:            nd.namespace,
 			State:           "Active",
 			RetentionPeriod: "30 days",
+			RetentionDays:   30,
 			Description:     "Mock namespace for development",
 			OwnerEmail:      "dev@example.com",
 		},
@@ -138,7 +142,9 @@ func (nd *NamespaceDetail) loadMockData() {
 		FailoverVersion:    0,
 		HistoryArchival:    "Disabled",
 		VisibilityArchival: "Disabled",
+		ActiveCluster:      "active",
 		Clusters:           []string{"active"},
+		ReplicationState:   "Normal",
 	}
 	nd.render()
 }
@@ -210,22 +216,67 @@ func (nd *NamespaceDetail) render() {
 		globalStr = "Yes"
 	}
 
-	clustersStr := "None"
-	if len(d.Clusters) > 0 {
-		clustersStr = strings.Join(d.Clusters, ", ")
-	}
-
 	clusterText := fmt.Sprintf(`
-[%s::b]Global Namespace[-:-:-]  [%s]%s[-]
-[%s::b]Failover Version[-:-:-]  [%s]%d[-]
-[%s::b]Clusters[-:-:-]          [%s]%s[-]`,
+[%s::b]Global Namespace[-:-:-]   [%s]%s[-]
+[%s::b]Active Cluster[-:-:-]     [%s]%s[-]
+[%s::b]Failover Version[-:-:-]   [%s]%d[-]
+[%s::b]Replication[-:-:-]        [%s]%s[-]`,
 		theme.TagFgDim(), theme.TagFg(), globalStr,
+		theme.TagFgDim(), theme.TagAccent(), nd.valueOrNA(d.ActiveCluster),
 		theme.TagFgDim(), theme.TagFg(), d.FailoverVersion,
-		theme.TagFgDim(), theme.TagFg(), clustersStr,
+		theme.TagFgDim(), nd.replicationStateColorTag(d.ReplicationState), nd.valueOrNA(d.ReplicationState),
 	)
+	clusterText += fmt.Sprintf("\n\n[%s::b]Clusters[-:-:-]\n", theme.TagFgDim()) + nd.formatClusters(d)
+	clusterText += fmt.Sprintf("\n\n[%s::b]Failover History[-:-:-]\n", theme.TagFgDim()) + nd.formatFailovers(d.FailoverHistory)
 	nd.clusterView.SetText(clusterText)
 }
 
+// namespaceFailoversShown is how many recent failovers the detail view lists.
+const namespaceFailoversShown = 5
+
+// formatClusters lists the replication clusters, marking the active one.
+func (nd *NamespaceDetail) formatClusters(d *temporal.NamespaceDetail) string {
+	if len(d.Clusters) == 0 {
+		return fmt.Sprintf("  [%s]None[-]", theme.TagFgDim())
+	}
+	lines := make([]string, 0, len(d.Clusters))
+	for _, cluster := range d.Clusters {
+		if cluster == d.ActiveCluster {
+			lines = append(lines, fmt.Sprintf("  [%s]● %s[-] [%s](active)[-]", theme.TagAccent(), cluster, theme.TagFgDim()))
+		} else {
+			lines = append(lines, fmt.Sprintf("  [%s]○ %s[-]", theme.TagFg(), cluster))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatFailovers lists the most recent failovers, newest first.
+func (nd *NamespaceDetail) formatFailovers(failovers []temporal.NamespaceFailover) string {
+	if len(failovers) == 0 {
+		return fmt.Sprintf("  [%s]No failovers[-]", theme.TagFgDim())
+	}
+	now := time.Now()
+	lines := make([]string, 0, namespaceFailoversShown+1)
+	for i, f := range failovers {
+		if i == namespaceFailoversShown {
+			lines = append(lines, fmt.Sprintf("  [%s]+%d earlier[-]", theme.TagFgDim(), len(failovers)-i))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  [%s]%s[-]  [%s]version %d[-]  [%s]%s[-]",
+			theme.TagFg(), f.Time.Local().Format("2006-01-02 15:04:05"),
+			theme.TagFg(), f.Version,
+			theme.TagFgDim(), formatRelativeTime(now, f.Time)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (nd *NamespaceDetail) replicationStateColorTag(state string) string {
+	if state == "Handover" {
+		return theme.TagWarning()
+	}
+	return theme.TagFg()
+}
+
 func (nd *NamespaceDetail) valueOrNA(s string) string {
 	if s == "" {
 		return "N/A"
@@ -264,6 +315,11 @@ func (nd *NamespaceDetail) Name() string {
 	return "namespace-detail"
 }
 
+// Reload reloads the namespace details.
+func (nd *NamespaceDetail) Reload() {
+	nd.loadData()
+}
+
 // Start is called when the view becomes active.
 func (nd *NamespaceDetail) Start() {
 	bindings := input.NewKeyBindings().
@@ -275,6 +331,10 @@ func (nd *NamespaceDetail) Start() {
 			nd.showEditForm()
 			return true
 		}).
+		OnRune('R', func(e *tcell.EventKey) bool {
+			nd.showRetentionEditor()
+			return true
+		}).
 		OnRune('D', func(e *tcell.EventKey) bool {
 			nd.showDeprecateConfirm()
 			return true
@@ -299,6 +359,7 @@ func (nd *NamespaceDetail) Hints() []KeyHint {
 	hints := []KeyHint{
 		{Key: "r", Description: "Refresh"},
 		{Key: "e", Description: "Edit"},
+		{Key: "R", Description: "Retention"},
 	}
 
 	// Only show deprecate for active namespaces
@@ -335,20 +396,21 @@ func (nd *NamespaceDetail) showEditForm() {
 	if nd.detail == nil {
 		return
 	}
+	if nd.app.blockReadOnlyNamespace(nd.namespace) {
+		return
+	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Edit Namespace", theme.IconNamespace),
+		Title:    fmt.Sprintf("%s Edit Namespace", icons.Namespace),
 		Width:    70,
 		Height:   18,
 		Backdrop: true,
 	})
 
-	// Parse current retention period (e.g., "72h0m0s" -> 3)
-	currentRetention := 3
-	if nd.detail.RetentionPeriod != "" {
-		if dur, err := time.ParseDuration(nd.detail.RetentionPeriod); err == nil {
-			currentRetention = int(dur.Hours() / 24)
-		}
+	// Start from the current effective retention
+	currentRetention := nd.detail.RetentionDays
+	if currentRetention < temporal.MinRetentionDays {
+		currentRetention = 3
 	}
 
 	form := components.NewFormBuilder().
@@ -362,12 +424,12 @@ func (nd *NamespaceDetail) showEditForm() {
 			Done().
 		Text("retention", "Retention (days)").
 			Value(strconv.Itoa(currentRetention)).
-			Validate(validators.Required()).
+			Validate(validators.Required(), retentionValidator()).
 			Done().
 		OnSubmit(func(values map[string]any) {
 			retentionStr := values["retention"].(string)
 			retentionDays, err := strconv.Atoi(retentionStr)
-			if err != nil || retentionDays < 1 {
+			if err != nil || temporal.ValidateRetentionDays(retentionDays) != nil {
 				return // Invalid retention
 			}
 
@@ -398,7 +460,7 @@ func (nd *NamespaceDetail) showEditForm() {
 
 func (nd *NamespaceDetail) showUpdateConfirm(req temporal.NamespaceUpdateRequest) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Confirm Update", theme.IconWarning),
+		Title:    fmt.Sprintf("%s Confirm Update", icons.Warning),
 		Width:    65,
 		Height:   14,
 		Backdrop: true,
@@ -422,6 +484,14 @@ func (nd *NamespaceDetail) showUpdateConfirm(req temporal.NamespaceUpdateRequest
 		theme.TagFgDim(), theme.TagFg(), req.OwnerEmail,
 		theme.TagFgDim(), theme.TagFg(), req.RetentionDays))
 
+	// Warn when retention is lowered, since older closed workflows will be deleted
+	if nd.detail != nil && req.RetentionDays < nd.detail.RetentionDays {
+		changesText.SetText(changesText.GetText(false) + fmt.Sprintf(`
+
+[%s]%s Lowering retention from %d days may make closed workflows disappear from visibility[-]`,
+			theme.TagError(), icons.Warning, nd.detail.RetentionDays))
+	}
+
 	contentFlex.AddItem(changesText, 0, 1, true)
 
 	modal.SetContent(contentFlex)
@@ -466,9 +536,12 @@ func (nd *NamespaceDetail) showDeprecateConfirm() {
 	if nd.detail == nil {
 		return
 	}
+	if nd.app.blockReadOnlyNamespace(nd.namespace) {
+		return
+	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Deprecate Namespace", theme.IconError),
+		Title:    fmt.Sprintf("%s Deprecate Namespace", icons.Error),
 		Width:    70,
 		Height:   16,
 		Backdrop: true,
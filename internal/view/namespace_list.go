@@ -12,6 +12,7 @@ import (
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -20,6 +21,7 @@ import (
 // NamespaceList displays a list of Temporal namespaces with a preview panel.
 type NamespaceList struct {
 	*components.MasterDetailView
+	freshness
 	table         *components.Table
 	preview       *tview.TextView
 	emptyState    *components.EmptyState
@@ -30,6 +32,7 @@ type NamespaceList struct {
 	autoRefresh   bool
 	refreshTicker *time.Ticker
 	stopRefresh   chan struct{}
+	submitting    bool // A create or update form's request is in flight
 }
 
 // NewNamespaceList creates a new namespace list view.
@@ -63,18 +66,18 @@ func (nl *NamespaceList) setup() {
 
 	// Create empty state
 	nl.emptyState = components.NewEmptyState().
-		SetIcon(theme.IconDatabase).
+		SetIcon(icons.Database).
 		SetTitle("No Namespaces").
 		SetMessage("No namespaces found")
 
 	// Create MasterDetailView
 	nl.MasterDetailView = components.NewMasterDetailView().
-		SetMasterTitle(fmt.Sprintf("%s Namespaces", theme.IconNamespace)).
-		SetDetailTitle(fmt.Sprintf("%s Details", theme.IconInfo)).
+		SetMasterTitle(fmt.Sprintf("%s Namespaces", icons.Namespace)).
+		SetDetailTitle(fmt.Sprintf("%s Details", icons.Info)).
 		SetMasterContent(nl.table).
 		SetDetailContent(nl.preview).
 		SetRatio(0.6).
-		ConfigureEmpty(theme.IconInfo, "No Selection", "Select a namespace to view details").
+		ConfigureEmpty(icons.Info, "No Selection", "Select a namespace to view details").
 		EnableSearch(func(current string, cb components.SearchCallbacks) {
 			nl.app.ShowFilterMode(current, FilterModeCallbacks{
 				OnChange: cb.OnChange,
@@ -91,7 +94,7 @@ func (nl *NamespaceList) setup() {
 		dataRow := row - 1
 		if dataRow >= 0 && dataRow < len(nl.namespaces) {
 			nl.updatePreview(nl.namespaces[dataRow])
-			nl.app.JigApp().Menu().SetHints(nl.Hints())
+			nl.app.setFooterHints(nl.Hints())
 		}
 	})
 
@@ -123,11 +126,11 @@ func (nl *NamespaceList) RefreshTheme() {
 }
 
 func (nl *NamespaceList) updatePreview(ns temporal.Namespace) {
-	stateIcon := theme.IconConnected
+	stateIcon := icons.Connected
 	stateStatus := temporal.GetNamespaceState(ns.State)
 	stateColor := stateStatus.ColorTag()
 	if ns.State == "Deprecated" {
-		stateIcon = theme.IconDisconnected
+		stateIcon = icons.Disconnected
 	}
 
 	text := fmt.Sprintf(`[%s::b]Name[-:-:-]
@@ -180,7 +183,9 @@ func (nl *NamespaceList) loadData() {
 	async.NewLoader[[]temporal.Namespace]().
 		WithTimeout(10 * time.Second).
 		OnSuccess(func(namespaces []temporal.Namespace) {
+			namespaces = nl.app.withSystemNamespace(namespaces)
 			nl.allNamespaces = namespaces
+			nl.markLoaded()
 			// Re-apply current filter
 			if nl.GetSearchText() != "" {
 				nl.applyFilter(nl.GetSearchText())
@@ -228,8 +233,12 @@ func (nl *NamespaceList) populateTable() {
 
 	for _, ns := range nl.namespaces {
 		stateStatus := temporal.GetNamespaceState(ns.State)
+		name := icons.Database + " " + ns.Name
+		if ns.Name == temporal.SystemNamespace {
+			name += " (system, read-only)"
+		}
 		nl.table.AddRowWithStatus(stateStatus, 1, // status column is index 1
-			theme.IconDatabase+" "+ns.Name,
+			name,
 			ns.State,
 			ns.RetentionPeriod,
 		)
@@ -252,7 +261,7 @@ func (nl *NamespaceList) showError(err error) {
 	nl.table.ClearRows()
 	nl.table.SetHeaders("NAME", "STATE", "RETENTION")
 	nl.table.AddRowWithColor(theme.Error(),
-		theme.IconError+" Error loading namespaces",
+		icons.Error+" Error loading namespaces",
 		err.Error(),
 		"",
 	)
@@ -323,6 +332,11 @@ func (nl *NamespaceList) Name() string {
 	return "namespaces"
 }
 
+// Reload reloads the namespace list.
+func (nl *NamespaceList) Reload() {
+	nl.loadData()
+}
+
 // Start is called when the view becomes active.
 func (nl *NamespaceList) Start() {
 	bindings := input.NewKeyBindings().
@@ -346,6 +360,11 @@ func (nl *NamespaceList) Start() {
 			nl.togglePreview()
 			return true
 		}).
+		OnRune('.', func(e *tcell.EventKey) bool {
+			nl.app.toggleSystemWorkflows()
+			nl.loadData()
+			return true
+		}).
 		OnRune('i', func(e *tcell.EventKey) bool {
 			ns := nl.getSelectedNamespace()
 			if ns != nil {
@@ -375,6 +394,13 @@ func (nl *NamespaceList) Start() {
 			}
 			return true
 		}).
+		OnRune('J', func(e *tcell.EventKey) bool {
+			ns := nl.getSelectedNamespace()
+			if ns != nil {
+				nl.app.NavigateToBatchOperations(ns.Name)
+			}
+			return true
+		}).
 		OnRune('S', func(e *tcell.EventKey) bool {
 			ns := nl.getSelectedNamespace()
 			if ns != nil {
@@ -425,7 +451,9 @@ func (nl *NamespaceList) Hints() []KeyHint {
 
 	hints = append(hints,
 		KeyHint{Key: "S", Description: "Signal+Start"},
+		KeyHint{Key: "J", Description: "Batch Jobs"},
 		KeyHint{Key: "p", Description: "Preview"},
+		KeyHint{Key: ".", Description: "System Namespace"},
 		KeyHint{Key: "r", Description: "Refresh"},
 		KeyHint{Key: "a", Description: autoHint},
 		KeyHint{Key: "T", Description: "Theme"},
@@ -463,10 +491,13 @@ func (nl *NamespaceList) getSelectedNamespace() *temporal.Namespace {
 
 // showSignalWithStart displays a modal for SignalWithStart operation.
 func (nl *NamespaceList) showSignalWithStart(namespace string) {
+	if nl.app.blockReadOnlyNamespace(namespace) {
+		return
+	}
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Signal With Start (%s)", theme.IconInfo, namespace),
+		Title:    fmt.Sprintf("%s Signal With Start (%s)", icons.Info, namespace),
 		Width:    70,
-		Height:   20,
+		Height:   21,
 		Backdrop: true,
 	})
 
@@ -509,9 +540,16 @@ func (nl *NamespaceList) showSignalWithStart(namespace string) {
 		}).
 		Build()
 
-	modal.SetContent(form)
+	suggestions := attachTypeCompleter(form, "workflowType", nl.app.KnownWorkflowTypes(namespace), wholeValueType)
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(suggestions, 1, 0, false)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal.SetContent(content)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+N/P", Description: "Complete type"},
 		{Key: "Enter", Description: "Execute"},
 		{Key: "Esc", Description: "Cancel"},
 	})
@@ -562,11 +600,12 @@ func (nl *NamespaceList) closeModal() {
 // showCreateNamespaceForm displays a modal for creating a new namespace.
 func (nl *NamespaceList) showCreateNamespaceForm() {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Create Namespace", theme.IconNamespace),
+		Title:    fmt.Sprintf("%s Create Namespace", icons.Namespace),
 		Width:    70,
-		Height:   18,
+		Height:   20,
 		Backdrop: true,
 	})
+	errView := newFormError()
 
 	form := components.NewFormBuilder().
 		Text("name", "Namespace Name").
@@ -581,32 +620,29 @@ func (nl *NamespaceList) showCreateNamespaceForm() {
 			Done().
 		Text("retention", "Retention (days)").
 			Value("3").
-			Validate(validators.Required()).
+			Placeholder(fmt.Sprintf("%d-%d", temporal.MinRetentionDays, temporal.MaxRetentionDays)).
+			Validate(validators.Required(), retentionValidator()).
 			Done().
 		OnSubmit(func(values map[string]any) {
-			name := values["name"].(string)
-
-			retentionStr := values["retention"].(string)
-			retentionDays, err := strconv.Atoi(retentionStr)
-			if err != nil || retentionDays < 1 {
-				retentionDays = 3 // Default to 3 days
+			retentionDays, err := strconv.Atoi(strings.TrimSpace(values["retention"].(string)))
+			if err != nil || temporal.ValidateRetentionDays(retentionDays) != nil {
+				return // Invalid retention
 			}
 
 			createReq := temporal.NamespaceCreateRequest{
-				Name:          name,
+				Name:          strings.TrimSpace(values["name"].(string)),
 				Description:   values["description"].(string),
 				OwnerEmail:    values["ownerEmail"].(string),
 				RetentionDays: retentionDays,
 			}
-			nl.closeModal()
-			nl.executeCreateNamespace(createReq)
+			nl.executeCreateNamespace(createReq, errView)
 		}).
 		OnCancel(func() {
 			nl.closeModal()
 		}).
 		Build()
 
-	modal.SetContent(form)
+	modal.SetContent(formWithError(form, errView))
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
 		{Key: "Enter", Description: "Create"},
@@ -617,22 +653,32 @@ func (nl *NamespaceList) showCreateNamespaceForm() {
 	nl.app.JigApp().SetFocus(form)
 }
 
-// executeCreateNamespace performs the namespace creation asynchronously.
-func (nl *NamespaceList) executeCreateNamespace(req temporal.NamespaceCreateRequest) {
+// executeCreateNamespace creates the namespace, closing the form when it
+// succeeds and showing the error in it when it does not.
+func (nl *NamespaceList) executeCreateNamespace(req temporal.NamespaceCreateRequest, errView *tview.TextView) {
 	provider := nl.app.Provider()
 	if provider == nil {
-		ShowErrorModal(nl.app.JigApp(), "Create Failed", "No provider connected")
+		showFormError(errView, fmt.Errorf("no provider connected"))
 		return
 	}
+	if nl.submitting {
+		return // Ignore repeated submits while the first is in flight
+	}
+	nl.submitting = true
+	errView.SetText(fmt.Sprintf("[%s]Creating namespace...[-]", theme.TagFgDim()))
 
 	async.NewLoader[struct{}]().
 		WithTimeout(10 * time.Second).
 		OnSuccess(func(_ struct{}) {
+			nl.closeModal()
 			nl.app.ToastSuccess(fmt.Sprintf("Namespace '%s' created", req.Name))
 			nl.loadData()
 		}).
 		OnError(func(err error) {
-			ShowErrorModal(nl.app.JigApp(), "Create Namespace Failed", err.Error())
+			showFormError(errView, err)
+		}).
+		OnFinally(func() {
+			nl.submitting = false
 		}).
 		Run(func(ctx context.Context) (struct{}, error) {
 			return struct{}{}, provider.CreateNamespace(ctx, req)
@@ -645,22 +691,25 @@ func (nl *NamespaceList) showEditNamespaceForm() {
 	if ns == nil {
 		return
 	}
+	if nl.app.blockReadOnlyNamespace(ns.Name) {
+		return
+	}
 
 	// First, fetch the full details to get current values
 	provider := nl.app.Provider()
 	if provider == nil {
 		// Use available data from list
-		nl.showEditFormWithData(ns.Name, ns.Description, ns.OwnerEmail, ns.RetentionPeriod)
+		nl.showEditFormWithData(ns.Name, ns.Description, ns.OwnerEmail, ns.RetentionDays)
 		return
 	}
 
 	// Capture values for closure
-	name, desc, owner, retention := ns.Name, ns.Description, ns.OwnerEmail, ns.RetentionPeriod
+	name, desc, owner, retention := ns.Name, ns.Description, ns.OwnerEmail, ns.RetentionDays
 
 	async.NewLoader[*temporal.NamespaceDetail]().
 		WithTimeout(10 * time.Second).
 		OnSuccess(func(detail *temporal.NamespaceDetail) {
-			nl.showEditFormWithData(detail.Name, detail.Description, detail.OwnerEmail, detail.RetentionPeriod)
+			nl.showEditFormWithData(detail.Name, detail.Description, detail.OwnerEmail, detail.RetentionDays)
 		}).
 		OnError(func(_ error) {
 			// Fall back to list data
@@ -672,20 +721,19 @@ func (nl *NamespaceList) showEditNamespaceForm() {
 }
 
 // showEditFormWithData displays the edit form with pre-populated values.
-func (nl *NamespaceList) showEditFormWithData(name, description, ownerEmail, retentionPeriod string) {
+func (nl *NamespaceList) showEditFormWithData(name, description, ownerEmail string, retentionDays int) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Edit Namespace: %s", theme.IconNamespace, name),
+		Title:    fmt.Sprintf("%s Edit Namespace: %s", icons.Namespace, name),
 		Width:    70,
-		Height:   16,
+		Height:   18,
 		Backdrop: true,
 	})
+	errView := newFormError()
 
-	// Parse retention period (e.g., "72h0m0s" or "3 days" -> 3)
-	currentRetention := 3
-	if retentionPeriod != "" {
-		if dur, err := time.ParseDuration(retentionPeriod); err == nil {
-			currentRetention = int(dur.Hours() / 24)
-		}
+	// Start from the current effective retention
+	currentRetention := retentionDays
+	if currentRetention < temporal.MinRetentionDays {
+		currentRetention = 3
 	}
 
 	form := components.NewFormBuilder().
@@ -699,12 +747,12 @@ func (nl *NamespaceList) showEditFormWithData(name, description, ownerEmail, ret
 			Done().
 		Text("retention", "Retention (days)").
 			Value(strconv.Itoa(currentRetention)).
-			Validate(validators.Required()).
+			Placeholder(fmt.Sprintf("%d-%d", temporal.MinRetentionDays, temporal.MaxRetentionDays)).
+			Validate(validators.Required(), retentionValidator()).
 			Done().
 		OnSubmit(func(values map[string]any) {
-			retentionStr := values["retention"].(string)
-			retentionDays, err := strconv.Atoi(retentionStr)
-			if err != nil || retentionDays < 1 {
+			retentionDays, err := strconv.Atoi(strings.TrimSpace(values["retention"].(string)))
+			if err != nil || temporal.ValidateRetentionDays(retentionDays) != nil {
 				return // Invalid retention
 			}
 
@@ -714,15 +762,14 @@ func (nl *NamespaceList) showEditFormWithData(name, description, ownerEmail, ret
 				OwnerEmail:    values["ownerEmail"].(string),
 				RetentionDays: retentionDays,
 			}
-			nl.closeModal()
-			nl.executeUpdateNamespace(updateReq)
+			nl.executeUpdateNamespace(updateReq, errView)
 		}).
 		OnCancel(func() {
 			nl.closeModal()
 		}).
 		Build()
 
-	modal.SetContent(form)
+	modal.SetContent(formWithError(form, errView))
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
 		{Key: "Enter", Description: "Save"},
@@ -733,22 +780,32 @@ func (nl *NamespaceList) showEditFormWithData(name, description, ownerEmail, ret
 	nl.app.JigApp().SetFocus(form)
 }
 
-// executeUpdateNamespace performs the namespace update asynchronously.
-func (nl *NamespaceList) executeUpdateNamespace(req temporal.NamespaceUpdateRequest) {
+// executeUpdateNamespace updates the namespace, closing the form when it
+// succeeds and showing the error in it when it does not.
+func (nl *NamespaceList) executeUpdateNamespace(req temporal.NamespaceUpdateRequest, errView *tview.TextView) {
 	provider := nl.app.Provider()
 	if provider == nil {
-		ShowErrorModal(nl.app.JigApp(), "Update Failed", "No provider connected")
+		showFormError(errView, fmt.Errorf("no provider connected"))
 		return
 	}
+	if nl.submitting {
+		return // Ignore repeated submits while the first is in flight
+	}
+	nl.submitting = true
+	errView.SetText(fmt.Sprintf("[%s]Saving namespace...[-]", theme.TagFgDim()))
 
 	async.NewLoader[struct{}]().
 		WithTimeout(10 * time.Second).
 		OnSuccess(func(_ struct{}) {
+			nl.closeModal()
 			nl.app.ToastSuccess(fmt.Sprintf("Namespace '%s' updated", req.Name))
 			nl.loadData()
 		}).
 		OnError(func(err error) {
-			ShowErrorModal(nl.app.JigApp(), "Update Namespace Failed", err.Error())
+			showFormError(errView, err)
+		}).
+		OnFinally(func() {
+			nl.submitting = false
 		}).
 		Run(func(ctx context.Context) (struct{}, error) {
 			return struct{}{}, provider.UpdateNamespace(ctx, req)
@@ -761,6 +818,9 @@ func (nl *NamespaceList) showDeprecateConfirm() {
 	if ns == nil {
 		return
 	}
+	if nl.app.blockReadOnlyNamespace(ns.Name) {
+		return
+	}
 
 	// Capture name as value to avoid pointer issues with slice reallocation
 	name := ns.Name
@@ -772,7 +832,7 @@ func (nl *NamespaceList) showDeprecateConfirm() {
 	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Deprecate Namespace", theme.IconError),
+		Title:    fmt.Sprintf("%s Deprecate Namespace", icons.Error),
 		Width:    70,
 		Height:   16,
 		Backdrop: true,
@@ -856,27 +916,67 @@ func (nl *NamespaceList) executeDeprecateNamespace(name string) {
 		})
 }
 
-// showDeleteConfirm displays a confirmation modal for deleting a deprecated namespace.
+// showDeleteConfirm checks that a deprecated namespace has no running
+// workflows, then asks for its name to be typed before deleting it.
 func (nl *NamespaceList) showDeleteConfirm() {
 	ns := nl.getSelectedNamespace()
 	if ns == nil || ns.State != "Deprecated" {
 		return
 	}
+	if nl.app.blockReadOnlyNamespace(ns.Name) {
+		return
+	}
 
 	// Capture values to avoid pointer issues with slice reallocation
 	name := ns.Name
 	state := ns.State
 
+	provider := nl.app.Provider()
+	if provider == nil {
+		nl.showDeleteConfirmModal(name, state, nil)
+		return
+	}
+
+	async.NewLoader[*temporal.WorkflowCounts]().
+		WithTimeout(10 * time.Second).
+		OnSuccess(func(counts *temporal.WorkflowCounts) {
+			if running := counts.ByStatus["Running"]; running > 0 {
+				ShowErrorModal(nl.app.JigApp(), "Namespace Has Running Workflows",
+					fmt.Sprintf("%s still has %d running workflow(s). Cancel or terminate them before deleting the namespace.", name, running))
+				return
+			}
+			nl.showDeleteConfirmModal(name, state, nil)
+		}).
+		OnError(func(err error) {
+			// Visibility may not support counting; let the typed name guard it
+			nl.showDeleteConfirmModal(name, state, err)
+		}).
+		Run(func(ctx context.Context) (*temporal.WorkflowCounts, error) {
+			return provider.CountWorkflows(ctx, name, "ExecutionStatus = 'Running'")
+		})
+}
+
+// showDeleteConfirmModal displays the confirmation for deleting a namespace.
+// countErr is why running workflows could not be checked, if they were not.
+func (nl *NamespaceList) showDeleteConfirmModal(name, state string, countErr error) {
 	// Pause auto-refresh while modal is open
 	wasAutoRefresh := nl.autoRefresh
 	if wasAutoRefresh {
 		nl.stopAutoRefresh()
 	}
 
+	confirm := nl.app.confirmationFor("delete", name, name)
+
+	running := fmt.Sprintf("[%s]Running workflows:[-] [%s]none[-]", theme.TagFgDim(), theme.TagFg())
+	if countErr != nil {
+		running = fmt.Sprintf("[%s]%s Could not check for running workflows: %s[-]",
+			theme.TagWarning(), icons.Warning, tview.Escape(countErr.Error()))
+	}
+
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Delete Namespace", theme.IconError),
+		Title:    fmt.Sprintf("%s Delete Namespace", icons.Error),
 		Width:    70,
-		Height:   18,
+		Height:   21,
 		Backdrop: true,
 	})
 
@@ -885,6 +985,7 @@ func (nl *NamespaceList) showDeleteConfirm() {
 
 	warningText := tview.NewTextView().
 		SetDynamicColors(true).
+		SetWrap(true).
 		SetTextAlign(tview.AlignLeft)
 	warningText.SetBackgroundColor(theme.Bg())
 	warningText.SetText(fmt.Sprintf(`[%s]DANGER: This action is irreversible![-]
@@ -895,19 +996,16 @@ Deleting a namespace will permanently remove:
 • All configuration
 
 [%s]Namespace:[-] [%s]%s[-]
-[%s]State:[-] [%s]%s[-]`,
+[%s]State:[-] [%s]%s[-]
+%s%s`,
 		theme.TagError(),
 		theme.TagFgDim(), theme.TagFg(), name,
-		theme.TagFgDim(), theme.TagError(), state))
+		theme.TagFgDim(), theme.TagError(), state,
+		running, confirm.warning(name)))
 
-	form := components.NewFormBuilder().
-		Text("confirm", "Type namespace name to confirm").
-			Placeholder(name).
-			Validate(validators.Required()).
-			Done().
+	form := confirm.addField(components.NewFormBuilder(), "namespace name").
 		OnSubmit(func(values map[string]any) {
-			confirm := values["confirm"].(string)
-			if confirm != name {
+			if !confirm.matches(values["confirm"].(string)) {
 				return // Must match namespace name
 			}
 			nl.closeModal()
@@ -926,7 +1024,7 @@ Deleting a namespace will permanently remove:
 		}).
 		Build()
 
-	contentFlex.AddItem(warningText, 10, 0, false)
+	contentFlex.AddItem(warningText, 13, 0, false)
 	contentFlex.AddItem(form, 0, 1, true)
 
 	modal.SetContent(contentFlex)
@@ -0,0 +1,137 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// retentionValidator validates a retention value entered as a number of days.
+func retentionValidator() validators.Validator {
+	return validators.Custom(func(value any) error {
+		s, _ := value.(string)
+		days, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("must be a whole number of days")
+		}
+		return temporal.ValidateRetentionDays(days)
+	})
+}
+
+// showRetentionEditor opens an editor for the namespace retention period,
+// starting from the current effective retention.
+func (nd *NamespaceDetail) showRetentionEditor() {
+	if nd.detail == nil {
+		return
+	}
+	current := nd.detail.RetentionDays
+
+	initial := ""
+	if current > 0 {
+		initial = strconv.Itoa(current)
+	}
+
+	form := components.NewFormBuilder().
+		Text("retention", "Retention (days)").
+		Value(initial).
+		Placeholder(fmt.Sprintf("%d-%d", temporal.MinRetentionDays, temporal.MaxRetentionDays)).
+		Validate(validators.Required(), retentionValidator()).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			days, err := strconv.Atoi(strings.TrimSpace(values["retention"].(string)))
+			if err != nil || temporal.ValidateRetentionDays(days) != nil {
+				return
+			}
+			nd.closeModal()
+			if days == current {
+				return
+			}
+			nd.showRetentionConfirm(current, days)
+		}).
+		OnCancel(func() {
+			nd.closeModal()
+		}).
+		Build()
+
+	info := tview.NewTextView().SetDynamicColors(true)
+	info.SetBackgroundColor(theme.Bg())
+	info.SetText(fmt.Sprintf(`[%s]Current retention:[-] [%s]%s[-]
+[%s]Allowed range:[-] [%s]%d to %d days[-]`,
+		theme.TagFgDim(), theme.TagFg(), nd.detail.RetentionPeriod,
+		theme.TagFgDim(), theme.TagFg(), temporal.MinRetentionDays, temporal.MaxRetentionDays))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(info, 3, 0, false).
+		AddItem(form, 0, 1, true)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Edit Retention: %s", icons.Namespace, nd.namespace),
+		Width:    60,
+		Height:   12,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	nd.app.JigApp().Pages().Push(modal)
+	nd.app.JigApp().SetFocus(form)
+}
+
+// showRetentionConfirm confirms a retention change, warning when it is lowered.
+func (nd *NamespaceDetail) showRetentionConfirm(current, days int) {
+	var text string
+	height := 10
+	if current > 0 && days < current {
+		height = 14
+		text = fmt.Sprintf(`[%s]%s Lowering retention from %d to %d days[-]
+
+[%s]Closed workflows older than %d days become eligible for deletion
+and may disappear from visibility shortly after this change.
+This cannot be undone by raising retention again.[-]`,
+			theme.TagError(), icons.Warning, current, days,
+			theme.TagFg(), days)
+	} else {
+		text = fmt.Sprintf(`[%s]Set retention for[-] [%s]%s[-] [%s]to[-] [%s]%d days[-]`,
+			theme.TagFgDim(), theme.TagFg(), nd.namespace,
+			theme.TagFgDim(), theme.TagAccent(), days)
+	}
+
+	view := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	view.SetBackgroundColor(theme.Bg())
+	view.SetText(text)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Confirm Retention Change", icons.Warning),
+		Width:    70,
+		Height:   height,
+		Backdrop: true,
+	})
+	modal.SetContent(view)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Update"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		nd.closeModal()
+		nd.executeUpdate(temporal.NamespaceUpdateRequest{
+			Name:          nd.namespace,
+			RetentionDays: days,
+		})
+	})
+	modal.SetOnCancel(func() {
+		nd.closeModal()
+	})
+
+	nd.app.JigApp().Pages().Push(modal)
+}
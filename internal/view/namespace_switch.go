@@ -0,0 +1,189 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// handleNamespaceCommand switches to the named namespace, or opens the
+// namespace switcher when no name is given: :ns [namespace]
+func (a *App) handleNamespaceCommand(args []string) {
+	if len(args) == 0 {
+		a.ShowNamespaceSwitcher()
+		return
+	}
+	a.SwitchNamespace(args[0])
+}
+
+// ShowNamespaceSwitcher lists the server's namespaces in a modal and
+// switches to the one selected.
+func (a *App) ShowNamespaceSwitcher() {
+	provider := a.Provider()
+	if provider == nil {
+		a.ToastWarning("Switching namespaces needs a server connection")
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		namespaces, err := provider.ListNamespaces(ctx)
+		cancel()
+
+		a.app.QueueUpdateDraw(func() {
+			if err != nil {
+				a.ToastError(fmt.Sprintf("Failed to list namespaces: %v", err))
+				return
+			}
+			a.showNamespaceSwitcher(a.withSystemNamespace(namespaces))
+		})
+	}()
+}
+
+func (a *App) showNamespaceSwitcher(namespaces []temporal.Namespace) {
+	current := a.CurrentNamespace()
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    "Switch Namespace",
+		Width:    50,
+		Height:   min(len(namespaces)+6, 24),
+		Backdrop: true,
+	})
+
+	list := tview.NewList()
+	bg := theme.Bg()
+	list.SetBackgroundColor(bg)
+	list.SetMainTextColor(theme.Fg())
+	list.SetMainTextStyle(tcell.StyleDefault.Background(bg).Foreground(theme.Fg()))
+	list.SetSelectedBackgroundColor(theme.Accent())
+	list.SetSelectedTextColor(bg)
+	list.SetSelectedStyle(tcell.StyleDefault.Background(theme.Accent()).Foreground(bg))
+	list.SetHighlightFullLine(true)
+	list.ShowSecondaryText(false)
+
+	for i, ns := range namespaces {
+		name := ns.Name // capture for closure
+		prefix := "  "
+		if name == current {
+			prefix = "● "
+			list.SetCurrentItem(i)
+		}
+		label := prefix + name
+		if ns.State != "" && ns.State != "Active" {
+			label += fmt.Sprintf(" [%s](%s)[-]", theme.TagFgDim(), strings.ToLower(ns.State))
+		}
+		list.AddItem(label, "", 0, func() {
+			a.app.Pages().DismissModal()
+			a.SwitchNamespace(name)
+		})
+	}
+
+	modal.SetContent(list).
+		SetHints([]components.KeyHint{
+			{Key: "j/k", Description: "Navigate"},
+			{Key: "Enter", Description: "Switch"},
+			{Key: "Esc", Description: "Cancel"},
+		}).
+		SetOnCancel(func() {
+			a.app.Pages().DismissModal()
+			a.refocusCurrent()
+		})
+
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
+			a.app.Pages().DismissModal()
+			a.refocusCurrent()
+			return nil
+		}
+		switch event.Rune() {
+		case 'j':
+			if next := list.GetCurrentItem() + 1; next < list.GetItemCount() {
+				list.SetCurrentItem(next)
+			}
+			return nil
+		case 'k':
+			if prev := list.GetCurrentItem() - 1; prev >= 0 {
+				list.SetCurrentItem(prev)
+			}
+			return nil
+		}
+		return event
+	})
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(list)
+}
+
+// SwitchNamespace reconnects to the current server in another namespace and
+// reopens the workflow list there. If the server rejects the namespace, the
+// previous connection is restored.
+func (a *App) SwitchNamespace(namespace string) {
+	a.mu.RLock()
+	provider := a.provider
+	previousNS := a.currentNS
+	a.mu.RUnlock()
+
+	if provider == nil {
+		return
+	}
+	if namespace == previousNS && provider.Config().Namespace == namespace {
+		a.ToastSuccess(fmt.Sprintf("Already in namespace %s", namespace))
+		return
+	}
+
+	previous := provider.Config()
+	connConfig := previous
+	connConfig.Namespace = namespace
+
+	// Stop current views
+	if current := a.app.Pages().Current(); current != nil {
+		current.Stop()
+	}
+
+	a.setNamespace(namespace + " (switching...)")
+	a.setConnected(false)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := provider.ReconnectWithConfig(ctx, connConfig)
+		if err == nil {
+			// Dialing succeeds for any name, so check the namespace exists
+			_, err = provider.DescribeNamespace(ctx, namespace)
+		}
+		cancel()
+		if err != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			_ = provider.ReconnectWithConfig(ctx, previous)
+			cancel()
+		}
+
+		if err == nil {
+			a.mu.Lock()
+			a.currentNS = namespace
+			a.mu.Unlock()
+		}
+
+		a.app.QueueUpdateDraw(func() {
+			a.setConnected(provider.IsConnected())
+			if err != nil {
+				a.setNamespace(previousNS)
+				a.ToastError(fmt.Sprintf("Failed to switch to %s: %v", namespace, err))
+				if current := a.app.Pages().Current(); current != nil {
+					current.Start()
+				}
+				return
+			}
+
+			a.setNamespace(namespace)
+			a.ToastSuccess(fmt.Sprintf("Switched to namespace %s", namespace))
+			a.reinitializeViews()
+		})
+	}()
+}
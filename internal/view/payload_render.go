@@ -0,0 +1,41 @@
+package view
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// maxPayloadRenderBytes is the size above which payloads are truncated before
+// pretty-printing and highlighting, which get slow on multi-megabyte payloads.
+var maxPayloadRenderBytes = config.DefaultMaxPayloadRenderBytes
+
+// SetMaxPayloadRenderBytes sets the payload render limit. Non-positive values
+// restore the default.
+func SetMaxPayloadRenderBytes(n int) {
+	if n <= 0 {
+		n = config.DefaultMaxPayloadRenderBytes
+	}
+	maxPayloadRenderBytes = n
+}
+
+// truncatePayload returns the head of content up to the render limit and
+// whether it was cut. The cut never splits a UTF-8 sequence.
+func truncatePayload(content string) (string, bool) {
+	if len(content) <= maxPayloadRenderBytes {
+		return content, false
+	}
+	cut := maxPayloadRenderBytes
+	for cut > 0 && !utf8.RuneStart(content[cut]) {
+		cut--
+	}
+	return content[:cut], true
+}
+
+// payloadTruncatedNotice describes a truncated payload and how to see the rest.
+func payloadTruncatedNotice(total int, action string) string {
+	return fmt.Sprintf("\n\n[%s]… payload truncated at %d of %d bytes — %s[-]",
+		theme.TagWarning(), maxPayloadRenderBytes, total, action)
+}
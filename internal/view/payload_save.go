@@ -0,0 +1,141 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// payloadExportDir returns the default directory for saved payloads.
+func payloadExportDir() string {
+	return filepath.Join(config.ConfigDir(), "exports")
+}
+
+// payloadFileName builds a filesystem-safe file name from its parts,
+// e.g. ("order-123", "output") -> "order-123-output.json".
+func payloadFileName(parts ...string) string {
+	return safeFileName(parts...) + ".json"
+}
+
+// safeFileName joins parts with "-" and replaces characters that are not
+// allowed in file names.
+func safeFileName(parts ...string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, strings.Join(parts, "-"))
+}
+
+// resolvePayloadPath expands a leading ~ and makes relative paths absolute
+// against the current working directory.
+func resolvePayloadPath(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", fmt.Errorf("path is required")
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	return filepath.Abs(path)
+}
+
+// eventPayloadContent returns an event's payloads in full, as a JSON array
+// when it has several JSON payloads, or its details if it carries none.
+func eventPayloadContent(ev *temporal.EnhancedHistoryEvent) string {
+	if len(ev.Payloads) == 0 {
+		return ev.Details
+	}
+	if len(ev.Payloads) == 1 {
+		return string(ev.Payloads[0])
+	}
+	parts := make([]string, len(ev.Payloads))
+	allJSON := true
+	for i, p := range ev.Payloads {
+		parts[i] = string(p)
+		allJSON = allJSON && json.Valid(p)
+	}
+	if allJSON {
+		return "[" + strings.Join(parts, ",") + "]"
+	}
+	return strings.Join(parts, "\n")
+}
+
+// writePayloadFile writes a decoded payload to path, pretty-printing JSON.
+// Parent directories are created as needed. Returns the absolute path written.
+func writePayloadFile(path, content string) (string, error) {
+	resolved, err := resolvePayloadPath(path)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(resolved), 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+	data := formatJSONPretty(content) + "\n"
+	if err := os.WriteFile(resolved, []byte(data), 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	return resolved, nil
+}
+
+// showSavePayload prompts for a destination path and saves content there.
+// Focus returns to restore when the prompt closes.
+func (wd *WorkflowDetail) showSavePayload(fileName, content string, restore tview.Primitive) {
+	if content == "" {
+		wd.app.ToastError("Nothing to save")
+		return
+	}
+
+	closePrompt := func() {
+		wd.app.JigApp().Pages().DismissModal()
+		wd.app.JigApp().SetFocus(restore)
+	}
+
+	form := components.NewFormBuilder().
+		Text("path", "Path").
+		Value(filepath.Join(payloadExportDir(), fileName)).
+		Validate(validators.Required()).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			path := values["path"].(string)
+			closePrompt()
+			written, err := writePayloadFile(path, content)
+			if err != nil {
+				wd.app.ToastError(err.Error())
+				return
+			}
+			wd.app.ToastSuccess(fmt.Sprintf("Saved %s", written))
+		}).
+		OnCancel(closePrompt).
+		Build()
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Save to File", icons.Database),
+		Width:    80,
+		Height:   9,
+		Backdrop: true,
+	})
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(form)
+}
@@ -0,0 +1,396 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/input"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ReplayView replays a run's history against local workflow code and shows
+// where the code stops matching the history.
+type ReplayView struct {
+	*components.MasterDetailView
+	app          *App
+	workflowID   string
+	runID        string
+	workflowType string
+	table        *components.Table
+	output       *tview.TextView
+	historyJSON  []byte
+	events       []temporal.EnhancedHistoryEvent
+	source       string // What the history was replayed with
+	result       *temporal.ReplayResult
+	diverged     int64 // First event the code did not reproduce, 0 if unknown
+	running      bool
+}
+
+// NewReplayView creates a replay view for a workflow run.
+func NewReplayView(app *App, workflowID, runID, workflowType string) *ReplayView {
+	rv := &ReplayView{
+		app:          app,
+		workflowID:   workflowID,
+		runID:        runID,
+		workflowType: workflowType,
+		table:        components.NewTable(),
+		output:       tview.NewTextView(),
+	}
+	rv.setup()
+
+	// Register for automatic theme refresh
+	theme.RegisterRefreshable(rv)
+
+	return rv
+}
+
+// showReplay opens the replay view for the loaded run.
+func (wd *WorkflowDetail) showReplay() {
+	if wd.workflow == nil {
+		wd.app.ToastWarning("Workflow is still loading")
+		return
+	}
+	wd.app.NavigateToReplay(wd.workflowID, wd.runID, wd.workflow.Type)
+}
+
+func (rv *ReplayView) setup() {
+	rv.table.SetHeaders("ID", "EVENT")
+	rv.table.SetBorder(false)
+	rv.table.SetBackgroundColor(theme.Bg())
+
+	rv.output.SetDynamicColors(true)
+	rv.output.SetBackgroundColor(theme.Bg())
+	rv.output.SetTextColor(theme.Fg())
+	rv.output.SetWordWrap(true)
+
+	rv.MasterDetailView = components.NewMasterDetailView().
+		SetMasterTitle(fmt.Sprintf("%s Replay: %s", icons.Event, rv.workflowID)).
+		SetDetailTitle(fmt.Sprintf("%s Replay Output", icons.Info)).
+		SetMasterContent(rv.table).
+		SetDetailContent(rv.output).
+		SetRatio(0.4).
+		ConfigureEmpty(icons.Info, "No Events", "The history has no events")
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (rv *ReplayView) RefreshTheme() {
+	bg := theme.Bg()
+	rv.table.SetBackgroundColor(bg)
+	rv.output.SetBackgroundColor(bg)
+	rv.output.SetTextColor(theme.Fg())
+	rv.populateTable()
+	rv.renderOutput()
+}
+
+// loadData fetches the run's history, then replays it.
+func (rv *ReplayView) loadData() {
+	provider := rv.app.Provider()
+	if provider == nil {
+		rv.output.SetText(fmt.Sprintf("[%s]Replay needs a server connection to fetch the history.[-]", theme.TagWarning()))
+		return
+	}
+	if rv.running {
+		return
+	}
+	rv.running = true
+	rv.output.SetText(fmt.Sprintf("[%s]Fetching history...[-]", theme.TagFgDim()))
+	namespace := rv.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		data, err := provider.ExportWorkflowHistory(ctx, namespace, rv.workflowID, rv.runID, temporal.HistoryFormatJSON)
+		var events []temporal.EnhancedHistoryEvent
+		if err == nil {
+			events, err = temporal.ParseHistoryJSON(data)
+		}
+
+		rv.app.JigApp().QueueUpdateDraw(func() {
+			rv.running = false
+			if err != nil {
+				rv.showError(err)
+				return
+			}
+			rv.historyJSON, rv.events = data, events
+			rv.populateTable()
+			rv.replay()
+		})
+	}()
+}
+
+// replay runs the fetched history through the registered workflow
+// definition if there is one, otherwise through the configured binary.
+func (rv *ReplayView) replay() {
+	if rv.historyJSON == nil || rv.running {
+		return
+	}
+	provider := rv.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	binary := ""
+	if rv.app.config != nil {
+		binary = rv.app.config.ReplayBinary
+	}
+	registered := temporal.IsReplayWorkflowRegistered(rv.workflowType)
+	if !registered && binary == "" {
+		rv.showBinaryPrompt()
+		return
+	}
+
+	source := fmt.Sprintf("registered type %s", rv.workflowType)
+	if !registered {
+		source = binary
+	}
+	rv.running = true
+	rv.output.SetText(fmt.Sprintf("[%s]Replaying %d events with %s...[-]", theme.TagFgDim(), len(rv.events), tview.Escape(source)))
+	data, workflowType := rv.historyJSON, rv.workflowType
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		var result *temporal.ReplayResult
+		var err error
+		if registered {
+			result, err = provider.ReplayHistory(ctx, data, workflowType)
+		} else {
+			result, err = temporal.ReplayWithBinary(ctx, binary, data)
+		}
+
+		rv.app.JigApp().QueueUpdateDraw(func() {
+			rv.running = false
+			if err != nil {
+				rv.output.SetText(fmt.Sprintf("[%s]%s %s[-]", theme.TagError(), icons.Error, tview.Escape(err.Error())))
+				return
+			}
+			rv.source, rv.result = source, result
+			rv.diverged = 0
+			if !result.OK {
+				rv.diverged = temporal.DivergedEventID(result.Output, rv.events)
+			}
+			rv.populateTable()
+			rv.renderOutput()
+			rv.jumpToDivergence()
+		})
+	}()
+}
+
+// showBinaryPrompt asks for the replayer binary and saves it to the config.
+func (rv *ReplayView) showBinaryPrompt() {
+	value := ""
+	if rv.app.config != nil {
+		value = rv.app.config.ReplayBinary
+	}
+
+	form := components.NewFormBuilder().
+		Text("binary", "Replayer binary").
+		Value(value).
+		Placeholder("~/bin/order-replayer").
+		Validate(validators.Required()).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			rv.app.JigApp().Pages().DismissModal()
+			binary, err := resolvePayloadPath(values["binary"].(string))
+			if err != nil {
+				rv.app.ToastError(err.Error())
+				return
+			}
+			if rv.app.config != nil {
+				rv.app.config.ReplayBinary = binary
+				if err := rv.app.config.Save(); err != nil {
+					rv.app.ToastError(fmt.Sprintf("Failed to save replay binary: %v", err))
+				}
+			}
+			rv.replay()
+		}).
+		OnCancel(func() {
+			rv.app.JigApp().Pages().DismissModal()
+		}).
+		Build()
+
+	help := tview.NewTextView().SetDynamicColors(true).SetWordWrap(true)
+	help.SetBackgroundColor(theme.Bg())
+	help.SetText(fmt.Sprintf("[%s]%s is not registered in this build. Choose a program that replays the history file given as its argument, e.g. one calling worker.ReplayWorkflowHistoryFromJSONFile, and exits non-zero on failure.[-]",
+		theme.TagFgDim(), tview.Escape(rv.workflowType)))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(help, 3, 0, false)
+	content.AddItem(form, 0, 1, true)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Replay With", icons.Event),
+		Width:    80,
+		Height:   12,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Replay"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	rv.app.JigApp().Pages().Push(modal)
+	rv.app.JigApp().SetFocus(form)
+}
+
+func (rv *ReplayView) populateTable() {
+	currentRow := rv.table.SelectedRow()
+
+	rv.table.ClearRows()
+	rv.table.SetHeaders("ID", "EVENT")
+	for _, ev := range rv.events {
+		if ev.ID == rv.diverged {
+			rv.table.AddRowWithColor(theme.Error(), fmt.Sprintf("%d", ev.ID), icons.Error+" "+shapeLabel(&ev))
+			continue
+		}
+		rv.table.AddRow(fmt.Sprintf("%d", ev.ID), shapeLabel(&ev))
+	}
+
+	if len(rv.events) > 0 {
+		if currentRow < 0 || currentRow >= len(rv.events) {
+			currentRow = 0
+		}
+		rv.table.SelectRow(currentRow)
+	}
+}
+
+// renderOutput shows the replay outcome, the first divergent event, and the
+// replayer's output with non-determinism errors highlighted.
+func (rv *ReplayView) renderOutput() {
+	if rv.result == nil {
+		return
+	}
+
+	var sb strings.Builder
+	if rv.result.OK {
+		sb.WriteString(fmt.Sprintf("[%s::b]%s Replayed %d events cleanly[-:-:-]\n", theme.TagSuccess(), icons.Check, len(rv.events)))
+	} else {
+		sb.WriteString(fmt.Sprintf("[%s::b]%s Replay failed[-:-:-]\n", theme.TagError(), icons.Error))
+	}
+	sb.WriteString(fmt.Sprintf("[%s]with %s[-]\n\n", theme.TagFgDim(), tview.Escape(rv.source)))
+
+	if ev := rv.divergedEvent(); ev != nil {
+		sb.WriteString(fmt.Sprintf("[%s]First divergent event:[-] [%s]#%d %s[-]\n\n",
+			theme.TagFgDim(), eventColorTag(ev.Type), ev.ID, tview.Escape(shapeLabel(ev))))
+	} else if !rv.result.OK {
+		sb.WriteString(fmt.Sprintf("[%s]The output does not name the divergent event.[-]\n\n", theme.TagFgDim()))
+	}
+
+	for _, line := range rv.result.Output {
+		color := theme.TagFg()
+		if strings.Contains(line, "TMPRL1100") || strings.Contains(strings.ToLower(line), "nondetermin") {
+			color = theme.TagError()
+		}
+		sb.WriteString(fmt.Sprintf("[%s]%s[-]\n", color, tview.Escape(line)))
+	}
+	rv.output.SetText(sb.String())
+	rv.output.ScrollToBeginning()
+}
+
+func (rv *ReplayView) divergedEvent() *temporal.EnhancedHistoryEvent {
+	for i := range rv.events {
+		if rv.events[i].ID == rv.diverged {
+			return &rv.events[i]
+		}
+	}
+	return nil
+}
+
+// jumpToDivergence selects the first divergent event in the table.
+func (rv *ReplayView) jumpToDivergence() {
+	for i, ev := range rv.events {
+		if ev.ID == rv.diverged {
+			rv.table.SelectRow(i)
+			return
+		}
+	}
+}
+
+func (rv *ReplayView) showError(err error) {
+	rv.events = nil
+	rv.table.ClearRows()
+	rv.table.SetHeaders("ID", "EVENT")
+	rv.output.SetText(fmt.Sprintf("[%s]%s Error loading history: %s[-]", theme.TagError(), icons.Error, tview.Escape(err.Error())))
+}
+
+// Name returns the view name.
+func (rv *ReplayView) Name() string {
+	return "replay"
+}
+
+// Reload refetches the history and replays it again.
+func (rv *ReplayView) Reload() {
+	rv.loadData()
+}
+
+// Start is called when the view becomes active.
+func (rv *ReplayView) Start() {
+	bindings := input.NewKeyBindings().
+		OnRune('r', func(e *tcell.EventKey) bool {
+			rv.loadData()
+			return true
+		}).
+		OnRune('b', func(e *tcell.EventKey) bool {
+			rv.showBinaryPrompt()
+			return true
+		}).
+		OnRune('n', func(e *tcell.EventKey) bool {
+			rv.jumpToDivergence()
+			return true
+		}).
+		OnRune('p', func(e *tcell.EventKey) bool {
+			rv.ToggleDetail()
+			return true
+		})
+	addEdgeNav(bindings, tableEdgeNav{rv.table}, true)
+
+	rv.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if bindings.Handle(event) {
+			return nil
+		}
+		return event
+	})
+	rv.loadData()
+}
+
+// Stop is called when the view is deactivated.
+func (rv *ReplayView) Stop() {
+	rv.table.SetInputCapture(nil)
+}
+
+// Hints returns keybinding hints for this view.
+func (rv *ReplayView) Hints() []KeyHint {
+	return []KeyHint{
+		{Key: "r", Description: "Replay Again"},
+		{Key: "b", Description: "Replayer Binary"},
+		{Key: "n", Description: "Divergent Event"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "p", Description: "Output"},
+		{Key: "esc", Description: "Back"},
+	}
+}
+
+// Focus sets focus to the table.
+func (rv *ReplayView) Focus(delegate func(p tview.Primitive)) {
+	delegate(rv.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (rv *ReplayView) Draw(screen tcell.Screen) {
+	bg := theme.Bg()
+	rv.output.SetBackgroundColor(bg)
+	rv.output.SetTextColor(theme.Fg())
+	rv.MasterDetailView.Draw(screen)
+}
@@ -0,0 +1,87 @@
+package view
+
+import (
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// scheduleNextRunCol is the NEXT RUN column of the schedules table.
+const scheduleNextRunCol = 4
+
+// formatNextRun describes when a schedule fires next: "paused", "in 14m",
+// "due now" once the time has passed, or "-" when nothing is scheduled.
+func formatNextRun(s temporal.Schedule, now time.Time) string {
+	if s.Paused {
+		return "paused"
+	}
+	if s.NextRunTime == nil {
+		return "-"
+	}
+	if !s.NextRunTime.After(now) {
+		return "due now"
+	}
+	return formatRelativeTime(now, *s.NextRunTime)
+}
+
+// startCountdown updates the NEXT RUN column every second while the view is
+// active. When a schedule's next run time passes, the list is reloaded to
+// pick up the following one.
+func (sl *ScheduleList) startCountdown() {
+	if sl.countdownStop != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	sl.countdownStop = stop
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		last := time.Now()
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				since := last
+				last = now
+				sl.app.JigApp().QueueUpdateDraw(func() {
+					if sl.countdownStop != stop {
+						return
+					}
+					sl.refreshCountdown(since, now)
+				})
+			}
+		}
+	}()
+}
+
+// stopCountdown stops the countdown ticker if it is running.
+func (sl *ScheduleList) stopCountdown() {
+	if sl.countdownStop != nil {
+		close(sl.countdownStop)
+		sl.countdownStop = nil
+	}
+}
+
+// refreshCountdown rewrites the NEXT RUN cells and the preview as of now,
+// reloading if any schedule became due since the previous tick.
+func (sl *ScheduleList) refreshCountdown(since, now time.Time) {
+	fired := false
+	for i, s := range sl.schedules {
+		if cell := sl.table.GetCell(i+1, scheduleNextRunCol); cell != nil {
+			cell.SetText(formatNextRun(s, now))
+		}
+		if !s.Paused && s.NextRunTime != nil && s.NextRunTime.After(since) && !s.NextRunTime.After(now) {
+			fired = true
+		}
+	}
+
+	if row := sl.table.SelectedRow(); row >= 0 && row < len(sl.schedules) {
+		sl.updatePreview(sl.schedules[row])
+	}
+
+	if fired && !sl.loading {
+		sl.loadData()
+	}
+}
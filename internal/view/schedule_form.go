@@ -0,0 +1,289 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/async"
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// parseScheduleSpec reads a schedule spec as an interval, such as "1h" or
+// "every 30m", or else as a cron expression such as "0 * * * *".
+func parseScheduleSpec(s string) (cron string, interval time.Duration, err error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", 0, fmt.Errorf("enter a cron expression or an interval like 1h")
+	}
+	if d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(s, "every "))); err == nil {
+		if d <= 0 {
+			return "", 0, fmt.Errorf("interval must be positive")
+		}
+		return "", d, nil
+	}
+	return s, 0, nil
+}
+
+func validateScheduleSpec(v any) error {
+	_, _, err := parseScheduleSpec(v.(string))
+	return err
+}
+
+// scheduleSpecValue returns the spec field value for a schedule's current
+// cron expression or interval.
+func scheduleSpecValue(s temporal.Schedule) string {
+	if s.Cron != "" {
+		return s.Cron
+	}
+	if s.Interval > 0 {
+		return s.Interval.String()
+	}
+	return ""
+}
+
+// showCreateScheduleForm displays a modal for creating a schedule.
+func (sl *ScheduleList) showCreateScheduleForm() {
+	if sl.app.blockReadOnly() {
+		return
+	}
+	errView := newFormError()
+
+	form := components.NewFormBuilder().
+		Text("scheduleId", "Schedule ID").
+		Placeholder("Enter schedule ID").
+		Validate(validators.Required()).
+		Done().
+		Text("spec", "Spec").
+		Placeholder("cron like 0 * * * *, or interval like 1h").
+		Validate(validators.Custom(validateScheduleSpec)).
+		Done().
+		Text("workflowType", "Workflow Type").
+		Placeholder("Enter workflow type").
+		Validate(validators.Required()).
+		Done().
+		Text("taskQueue", "Task Queue").
+		Placeholder("Enter task queue").
+		Validate(validators.Required()).
+		Done().
+		Text("workflowId", "Workflow ID (optional)").
+		Placeholder("defaults to the schedule ID").
+		Done().
+		Text("input", "Input (JSON, optional)").
+		Placeholder("{}").
+		Done().
+		Select("overlap", "Overlap Policy", temporal.ScheduleOverlapPolicies).
+		Default(temporal.ScheduleOverlapPolicies[0]).
+		Done().
+		Select("paused", "Start Paused", []string{"No", "Yes"}).
+		Default("No").
+		Done().
+		Text("note", "Note (optional)").
+		Done().
+		OnSubmit(func(values map[string]any) {
+			cron, interval, err := parseScheduleSpec(values["spec"].(string))
+			if err != nil {
+				return // Validated above
+			}
+			req := temporal.ScheduleCreateRequest{
+				ScheduleID:    strings.TrimSpace(values["scheduleId"].(string)),
+				Cron:          cron,
+				Interval:      interval,
+				WorkflowType:  strings.TrimSpace(values["workflowType"].(string)),
+				WorkflowID:    strings.TrimSpace(values["workflowId"].(string)),
+				TaskQueue:     strings.TrimSpace(values["taskQueue"].(string)),
+				OverlapPolicy: values["overlap"].(string),
+				Paused:        values["paused"].(string) == "Yes",
+				Note:          values["note"].(string),
+			}
+			if input := values["input"].(string); input != "" {
+				req.Input = []byte(input)
+			}
+			sl.executeCreateSchedule(req, errView)
+		}).
+		OnCancel(func() {
+			sl.closeModal()
+		}).
+		Build()
+
+	suggestions := attachTypeCompleter(form, "workflowType", sl.app.KnownWorkflowTypes(sl.namespace), wholeValueType)
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(formWithError(form, errView), 0, 1, true).
+		AddItem(suggestions, 1, 0, false)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Create Schedule", icons.Info),
+		Width:    70,
+		Height:   32,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+N/P", Description: "Complete type"},
+		{Key: "Ctrl+S", Description: "Create"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	sl.app.JigApp().Pages().Push(modal)
+	sl.app.JigApp().SetFocus(form)
+}
+
+// executeCreateSchedule creates the schedule, closing the form when it
+// succeeds and showing the error in it when it does not.
+func (sl *ScheduleList) executeCreateSchedule(req temporal.ScheduleCreateRequest, errView *tview.TextView) {
+	provider := sl.app.Provider()
+	if provider == nil {
+		showFormError(errView, fmt.Errorf("no provider connected"))
+		return
+	}
+	if sl.submitting {
+		return // Ignore repeated submits while the first is in flight
+	}
+	sl.submitting = true
+	errView.SetText(fmt.Sprintf("[%s]Creating schedule...[-]", theme.TagFgDim()))
+
+	namespace := sl.namespace
+	async.NewLoader[struct{}]().
+		WithTimeout(10 * time.Second).
+		OnSuccess(func(_ struct{}) {
+			sl.closeModal()
+			sl.app.ToastSuccess(fmt.Sprintf("Schedule %s created", req.ScheduleID))
+			sl.loadData()
+		}).
+		OnError(func(err error) {
+			showFormError(errView, err)
+		}).
+		OnFinally(func() {
+			sl.submitting = false
+		}).
+		Run(func(ctx context.Context) (struct{}, error) {
+			return struct{}{}, provider.CreateSchedule(ctx, namespace, req)
+		})
+}
+
+// showEditScheduleForm displays a modal for changing the selected schedule's
+// spec and paused state.
+func (sl *ScheduleList) showEditScheduleForm() {
+	schedule := sl.getSelectedSchedule()
+	if schedule == nil {
+		return
+	}
+	if sl.app.blockReadOnly() {
+		return
+	}
+
+	// Capture values to avoid pointer issues with slice reallocation
+	scheduleID := schedule.ID
+	currentSpec := scheduleSpecValue(*schedule)
+	paused := "No"
+	if schedule.Paused {
+		paused = "Yes"
+	}
+	errView := newFormError()
+
+	form := components.NewFormBuilder().
+		Text("spec", "Spec").
+		Value(currentSpec).
+		Placeholder("cron like 0 * * * *, or interval like 1h").
+		Validate(validators.Custom(func(v any) error {
+			if strings.TrimSpace(v.(string)) == currentSpec {
+				return nil // Unchanged, including an empty complex spec
+			}
+			return validateScheduleSpec(v)
+		})).
+		Done().
+		Select("paused", "Paused", []string{"No", "Yes"}).
+		Default(paused).
+		Done().
+		Text("note", "Note (optional)").
+		Placeholder(schedule.Notes).
+		Done().
+		OnSubmit(func(values map[string]any) {
+			req := temporal.ScheduleUpdateRequest{
+				Paused: values["paused"].(string) == "Yes",
+				Note:   values["note"].(string),
+			}
+			// Only replace the spec when it was changed, so a spec with
+			// several parts is not cut down to its first one
+			if spec := strings.TrimSpace(values["spec"].(string)); spec != currentSpec {
+				var err error
+				req.Cron, req.Interval, err = parseScheduleSpec(spec)
+				if err != nil {
+					return // Validated above
+				}
+			}
+			sl.executeUpdateSchedule(scheduleID, req, errView)
+		}).
+		OnCancel(func() {
+			sl.closeModal()
+		}).
+		Build()
+
+	info := tview.NewTextView().SetDynamicColors(true)
+	info.SetBackgroundColor(theme.Bg())
+	info.SetText(fmt.Sprintf("[%s]Workflow:[-] [%s]%s[-]\n[%s]Current spec:[-] [%s]%s[-]",
+		theme.TagFgDim(), theme.TagFg(), schedule.WorkflowType,
+		theme.TagFgDim(), theme.TagFg(), schedule.Spec))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(info, 3, 0, false).
+		AddItem(formWithError(form, errView), 0, 1, true)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Edit Schedule: %s", icons.Info, scheduleID),
+		Width:    70,
+		Height:   18,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+S", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	sl.app.JigApp().Pages().Push(modal)
+	sl.app.JigApp().SetFocus(form)
+}
+
+// executeUpdateSchedule updates the schedule, closing the form when it
+// succeeds and showing the error in it when it does not.
+func (sl *ScheduleList) executeUpdateSchedule(scheduleID string, req temporal.ScheduleUpdateRequest, errView *tview.TextView) {
+	provider := sl.app.Provider()
+	if provider == nil {
+		showFormError(errView, fmt.Errorf("no provider connected"))
+		return
+	}
+	if sl.submitting {
+		return // Ignore repeated submits while the first is in flight
+	}
+	sl.submitting = true
+	errView.SetText(fmt.Sprintf("[%s]Saving schedule...[-]", theme.TagFgDim()))
+
+	namespace := sl.namespace
+	async.NewLoader[struct{}]().
+		WithTimeout(10 * time.Second).
+		OnSuccess(func(_ struct{}) {
+			sl.closeModal()
+			sl.app.ToastSuccess(fmt.Sprintf("Schedule %s updated", scheduleID))
+			sl.loadData()
+		}).
+		OnError(func(err error) {
+			showFormError(errView, err)
+		}).
+		OnFinally(func() {
+			sl.submitting = false
+		}).
+		Run(func(ctx context.Context) (struct{}, error) {
+			return struct{}{}, provider.UpdateSchedule(ctx, namespace, scheduleID, req)
+		})
+}
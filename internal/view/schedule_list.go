@@ -11,6 +11,7 @@ import (
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -19,13 +20,16 @@ import (
 // ScheduleList displays a list of schedules with actions.
 type ScheduleList struct {
 	*components.MasterDetailView
-	app          *App
-	namespace    string
-	table        *components.Table
-	preview      *tview.TextView
-	allSchedules []temporal.Schedule // Full unfiltered list
-	schedules    []temporal.Schedule // Filtered list for display
-	loading      bool
+	freshness
+	app           *App
+	namespace     string
+	table         *components.Table
+	preview       *tview.TextView
+	allSchedules  []temporal.Schedule // Full unfiltered list
+	schedules     []temporal.Schedule // Filtered list for display
+	loading       bool
+	countdownStop chan struct{} // Stops the next run countdown
+	submitting    bool          // A create or edit form's request is in flight
 }
 
 // NewScheduleList creates a new schedule list view.
@@ -58,12 +62,12 @@ func (sl *ScheduleList) setup() {
 
 	// Create MasterDetailView
 	sl.MasterDetailView = components.NewMasterDetailView().
-		SetMasterTitle(fmt.Sprintf("%s Schedules", theme.IconSchedule)).
-		SetDetailTitle(fmt.Sprintf("%s Preview", theme.IconInfo)).
+		SetMasterTitle(fmt.Sprintf("%s Schedules", icons.Schedule)).
+		SetDetailTitle(fmt.Sprintf("%s Preview", icons.Info)).
 		SetMasterContent(sl.table).
 		SetDetailContent(sl.preview).
 		SetRatio(0.6).
-		ConfigureEmpty(theme.IconInfo, "No Selection", "Select a schedule to view details").
+		ConfigureEmpty(icons.Info, "No Selection", "Select a schedule to view details").
 		EnableSearch(func(current string, cb components.SearchCallbacks) {
 			sl.app.ShowFilterMode(current, FilterModeCallbacks{
 				OnChange: cb.OnChange,
@@ -117,9 +121,9 @@ func (sl *ScheduleList) updatePreview(s temporal.Schedule) {
 		pauseColor = temporal.StatusCanceled.ColorTag()
 	}
 
-	nextRun := "-"
-	if s.NextRunTime != nil {
-		nextRun = formatRelativeTime(now, *s.NextRunTime)
+	nextRun := formatNextRun(s, now)
+	if !s.Paused && s.NextRunTime != nil {
+		nextRun += " (" + s.NextRunTime.Local().Format("2006-01-02 15:04:05") + ")"
 	}
 
 	lastRun := "-"
@@ -238,11 +242,13 @@ func (sl *ScheduleList) loadData() {
 
 	sl.loading = true
 	namespace := sl.namespace
+	pageSize := sl.app.PageSize()
 
 	async.NewLoader[[]temporal.Schedule]().
 		WithTimeout(10 * time.Second).
 		OnSuccess(func(schedules []temporal.Schedule) {
 			sl.allSchedules = schedules
+			sl.markLoaded()
 			sl.applyFilter(sl.MasterDetailView.GetSearchText())
 		}).
 		OnError(func(err error) {
@@ -252,7 +258,7 @@ func (sl *ScheduleList) loadData() {
 			sl.loading = false
 		}).
 		Run(func(ctx context.Context) ([]temporal.Schedule, error) {
-			schedules, _, err := provider.ListSchedules(ctx, namespace, temporal.ListOptions{PageSize: 100})
+			schedules, _, err := provider.ListSchedules(ctx, namespace, temporal.ListOptions{PageSize: pageSize})
 			return schedules, err
 		})
 }
@@ -314,6 +320,7 @@ func (sl *ScheduleList) populateTable() {
 	sl.table.ClearRows()
 	sl.table.SetHeaders("SCHEDULE ID", "WORKFLOW TYPE", "SPEC", "STATUS", "NEXT RUN")
 
+	now := time.Now()
 	for _, s := range sl.schedules {
 		status := "Active"
 		statusColor := temporal.StatusCompleted.Color()
@@ -322,17 +329,12 @@ func (sl *ScheduleList) populateTable() {
 			statusColor = temporal.StatusCanceled.Color()
 		}
 
-		nextRun := "-"
-		if s.NextRunTime != nil {
-			nextRun = formatRelativeTime(time.Now(), *s.NextRunTime)
-		}
-
 		sl.table.AddRowWithColor(statusColor,
 			truncate(s.ID, 20),
 			truncate(s.WorkflowType, 20),
 			truncate(s.Spec, 15),
 			status,
-			nextRun,
+			formatNextRun(s, now),
 		)
 	}
 
@@ -354,7 +356,7 @@ func (sl *ScheduleList) showError(err error) {
 	sl.table.ClearRows()
 	sl.table.SetHeaders("SCHEDULE ID", "WORKFLOW TYPE", "SPEC", "STATUS", "NEXT RUN")
 	sl.table.AddRowWithColor(theme.Error(),
-		theme.IconError+" Error loading schedules",
+		icons.Error+" Error loading schedules",
 		err.Error(),
 		"",
 		"",
@@ -474,6 +476,9 @@ func (sl *ScheduleList) showPauseConfirm() {
 	if schedule == nil {
 		return
 	}
+	if sl.app.blockReadOnly() {
+		return
+	}
 
 	// If already paused, show unpause confirmation instead
 	if schedule.Paused {
@@ -482,7 +487,7 @@ func (sl *ScheduleList) showPauseConfirm() {
 	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Pause Schedule", theme.IconWarning),
+		Title:    fmt.Sprintf("%s Pause Schedule", icons.Warning),
 		Width:    60,
 		Height:   12,
 		Backdrop: true,
@@ -529,7 +534,7 @@ func (sl *ScheduleList) showPauseConfirm() {
 
 func (sl *ScheduleList) showUnpauseConfirm(s *temporal.Schedule) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Unpause Schedule", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Unpause Schedule", icons.Info),
 		Width:    60,
 		Height:   12,
 		Backdrop: true,
@@ -619,9 +624,12 @@ func (sl *ScheduleList) showTriggerConfirm() {
 	if schedule == nil {
 		return
 	}
+	if sl.app.blockReadOnly() {
+		return
+	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Trigger Schedule", theme.IconSignal),
+		Title:    fmt.Sprintf("%s Trigger Schedule", icons.Signal),
 		Width:    60,
 		Height:   12,
 		Backdrop: true,
@@ -685,9 +693,12 @@ func (sl *ScheduleList) showDeleteConfirm() {
 	if schedule == nil {
 		return
 	}
+	if sl.app.blockReadOnly() {
+		return
+	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Delete Schedule", theme.IconError),
+		Title:    fmt.Sprintf("%s Delete Schedule", icons.Error),
 		Width:    65,
 		Height:   14,
 		Backdrop: true,
@@ -769,6 +780,11 @@ func (sl *ScheduleList) Name() string {
 	return "schedules"
 }
 
+// Reload reloads the schedule list.
+func (sl *ScheduleList) Reload() {
+	sl.loadData()
+}
+
 // Start is called when the view becomes active.
 func (sl *ScheduleList) Start() {
 	bindings := input.NewKeyBindings().
@@ -796,9 +812,21 @@ func (sl *ScheduleList) Start() {
 			sl.viewRecentRuns()
 			return true
 		}).
+		OnRune('n', func(e *tcell.EventKey) bool {
+			sl.showCreateScheduleForm()
+			return true
+		}).
+		OnRune('e', func(e *tcell.EventKey) bool {
+			sl.showEditScheduleForm()
+			return true
+		}).
 		OnRune('D', func(e *tcell.EventKey) bool {
 			sl.showDeleteConfirm()
 			return true
+		}).
+		OnRune('Y', func(e *tcell.EventKey) bool {
+			sl.app.tableToClipboard(sl.table)
+			return true
 		})
 
 	sl.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
@@ -808,11 +836,13 @@ func (sl *ScheduleList) Start() {
 		return event
 	})
 	sl.loadData()
+	sl.startCountdown()
 }
 
 // Stop is called when the view is deactivated.
 func (sl *ScheduleList) Stop() {
 	sl.table.SetInputCapture(nil)
+	sl.stopCountdown()
 }
 
 // Hints returns keybinding hints for this view.
@@ -825,8 +855,11 @@ func (sl *ScheduleList) Hints() []KeyHint {
 		{Key: "p", Description: "Preview"},
 		{Key: "P", Description: "Pause/Unpause"},
 		{Key: "t", Description: "Trigger"},
+		{Key: "n", Description: "New"},
+		{Key: "e", Description: "Edit"},
 		{Key: "v", Description: "View runs"},
 		{Key: "D", Description: "Delete"},
+		{Key: "Y", Description: "Copy Table"},
 		{Key: "T", Description: "Theme"},
 		{Key: "esc", Description: "Back"},
 	}
@@ -0,0 +1,141 @@
+package view
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// sentOpKind identifies a mutation issued from tempo.
+type sentOpKind int
+
+const (
+	sentSignal sentOpKind = iota
+	sentUpdate
+	sentCancel
+)
+
+// eventType returns the history event type that confirms the operation landed.
+func (k sentOpKind) eventType() string {
+	switch k {
+	case sentSignal:
+		return "WorkflowExecutionSignaled"
+	case sentUpdate:
+		return "WorkflowExecutionUpdateAccepted"
+	case sentCancel:
+		return "WorkflowExecutionCancelRequested"
+	}
+	return ""
+}
+
+const (
+	// sentOpTTL is how long a sent operation is highlighted after it was issued.
+	sentOpTTL = 5 * time.Minute
+	// sentOpSkew tolerates clock drift between this machine and the server.
+	sentOpSkew = 5 * time.Second
+)
+
+// sentOpLabel is shown next to history events matched to a sent operation.
+const sentOpLabel = "← sent by you just now"
+
+// sentOp is a signal, update, or cancel request issued from this session.
+type sentOp struct {
+	Kind       sentOpKind
+	WorkflowID string
+	RunID      string // Empty targets the latest run
+	Name       string // Signal or update name; empty for cancel
+	At         time.Time
+}
+
+// sentOpLog tracks recently sent operations so refreshed histories can
+// highlight the events they produced.
+type sentOpLog struct {
+	mu  sync.Mutex
+	ops []sentOp
+}
+
+// record adds an operation and prunes expired entries.
+func (l *sentOpLog) record(op sentOp) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pruneLocked(op.At)
+	l.ops = append(l.ops, op)
+}
+
+func (l *sentOpLog) pruneLocked(now time.Time) {
+	kept := l.ops[:0]
+	for _, op := range l.ops {
+		if now.Sub(op.At) < sentOpTTL {
+			kept = append(kept, op)
+		}
+	}
+	l.ops = kept
+}
+
+// match returns the IDs of events produced by operations sent to the given
+// execution. Each operation matches at most one event: the first event of the
+// expected type (and name, for signals) at or after the time it was sent.
+func (l *sentOpLog) match(workflowID, runID string, events []temporal.EnhancedHistoryEvent, now time.Time) map[int64]bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pruneLocked(now)
+
+	matched := make(map[int64]bool)
+	for _, op := range l.ops {
+		if op.WorkflowID != workflowID || (op.RunID != "" && runID != "" && op.RunID != runID) {
+			continue
+		}
+		for _, ev := range events {
+			if matched[ev.ID] || ev.Type != op.Kind.eventType() {
+				continue
+			}
+			if ev.Time.Before(op.At.Add(-sentOpSkew)) {
+				continue
+			}
+			if op.Kind == sentSignal && op.Name != "" && ev.SignalName != "" && ev.SignalName != op.Name {
+				continue
+			}
+			if op.Kind == sentUpdate && op.Name != "" && ev.UpdateName != "" && ev.UpdateName != op.Name {
+				continue
+			}
+			matched[ev.ID] = true
+			break
+		}
+	}
+	return matched
+}
+
+// RecordSentOp remembers an operation issued from tempo so the resulting
+// history event can be highlighted on refresh.
+func (a *App) RecordSentOp(kind sentOpKind, workflowID, runID, name string) {
+	a.sentOps.record(sentOp{
+		Kind:       kind,
+		WorkflowID: workflowID,
+		RunID:      runID,
+		Name:       name,
+		At:         time.Now(),
+	})
+}
+
+// SentOpEvents returns the IDs of history events produced by operations sent from tempo.
+func (a *App) SentOpEvents(workflowID, runID string, events []temporal.EnhancedHistoryEvent) map[int64]bool {
+	return a.sentOps.match(workflowID, runID, events, time.Now())
+}
+
+// markSentNodes appends the sent label to tree nodes containing a sent event.
+func markSentNodes(nodes []*temporal.EventTreeNode, sent map[int64]bool) {
+	if len(sent) == 0 {
+		return
+	}
+	for _, node := range nodes {
+		for _, ev := range node.Events {
+			if sent[ev.ID] && !strings.HasSuffix(node.Name, sentOpLabel) {
+				node.Name = node.Name + " " + sentOpLabel
+				break
+			}
+		}
+		markSentNodes(node.Children, sent)
+	}
+}
@@ -0,0 +1,79 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// showSystemWorkflows reports whether the temporal-system namespace is listed.
+func (a *App) showSystemWorkflows() bool {
+	return a.config != nil && a.config.ShowSystemWorkflows
+}
+
+// readOnlyNamespace reports whether the current namespace only allows
+// viewing, which is the case for the server's own system namespace.
+func (a *App) readOnlyNamespace() bool {
+	return a.CurrentNamespace() == temporal.SystemNamespace
+}
+
+// blockReadOnly warns and returns true when a mutation is attempted in a
+// read-only namespace.
+func (a *App) blockReadOnly() bool {
+	return a.blockReadOnlyNamespace(a.CurrentNamespace())
+}
+
+// blockReadOnlyNamespace warns and returns true if namespace is read-only.
+func (a *App) blockReadOnlyNamespace(namespace string) bool {
+	if namespace != temporal.SystemNamespace {
+		return false
+	}
+	a.ToastWarning(fmt.Sprintf("%s is read-only in tempo", temporal.SystemNamespace))
+	return true
+}
+
+// toggleSystemWorkflows shows or hides the system namespace and saves the
+// choice to the config.
+func (a *App) toggleSystemWorkflows() {
+	if a.config == nil {
+		a.ToastWarning("The system workflows setting needs a config file")
+		return
+	}
+	a.config.ShowSystemWorkflows = !a.config.ShowSystemWorkflows
+	if err := a.config.Save(); err != nil {
+		a.ToastError(fmt.Sprintf("Failed to save system workflows setting: %v", err))
+		return
+	}
+	if a.config.ShowSystemWorkflows {
+		a.ToastSuccess(fmt.Sprintf("Showing %s (read-only)", temporal.SystemNamespace))
+	} else {
+		a.ToastSuccess(fmt.Sprintf("Hiding %s", temporal.SystemNamespace))
+	}
+}
+
+// withSystemNamespace hides or adds the system namespace to a namespace
+// list according to the show_system_workflows setting.
+func (a *App) withSystemNamespace(namespaces []temporal.Namespace) []temporal.Namespace {
+	show := a.showSystemWorkflows()
+	result := make([]temporal.Namespace, 0, len(namespaces)+1)
+	found := false
+	for _, ns := range namespaces {
+		if ns.Name == temporal.SystemNamespace {
+			found = true
+			if !show {
+				continue
+			}
+		}
+		result = append(result, ns)
+	}
+	if show && !found {
+		// Some servers leave the system namespace out of ListNamespaces
+		result = append(result, temporal.Namespace{
+			Name:            temporal.SystemNamespace,
+			State:           "Active",
+			RetentionPeriod: "N/A",
+			Description:     "Temporal server system workflows",
+		})
+	}
+	return result
+}
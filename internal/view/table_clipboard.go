@@ -0,0 +1,125 @@
+package view
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/atterpac/jig/components"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// styleTagPattern matches tview style tags such as "[red]", "[-:-:b]", or
+// "[#ff0000::u]". Candidates are checked with isStyleTag, since text like
+// "[Completed]" is printed literally.
+var styleTagPattern = regexp.MustCompile(`\[[a-zA-Z0-9#:\-]+\]`)
+
+// isStyleTag reports whether tag, including its brackets, is a style tag
+// tview would apply rather than print.
+func isStyleTag(tag string) bool {
+	parts := strings.Split(tag[1:len(tag)-1], ":")
+	if len(parts) > 3 {
+		return false
+	}
+	for i, part := range parts {
+		if part == "" || part == "-" {
+			continue
+		}
+		if i == 2 {
+			if strings.Trim(part, "lbidrusLBIDRUS") != "" {
+				return false
+			}
+			continue
+		}
+		if tcell.GetColor(part) == tcell.ColorDefault {
+			return false
+		}
+	}
+	return true
+}
+
+// plainCellText removes style tags from a cell's text and unescapes it.
+func plainCellText(text string) string {
+	text = styleTagPattern.ReplaceAllStringFunc(text, func(tag string) string {
+		if isStyleTag(tag) {
+			return ""
+		}
+		return tag
+	})
+	return strings.TrimSpace(tview.Unescape(text))
+}
+
+// tableText returns a table's header and rows as displayed, so the current
+// filter, sort, and columns carry over.
+func tableText(table *components.Table) [][]string {
+	rows := make([][]string, 0, table.Table.GetRowCount())
+	cols := table.Table.GetColumnCount()
+	for r := 0; r < table.Table.GetRowCount(); r++ {
+		row := make([]string, cols)
+		for c := 0; c < cols; c++ {
+			if cell := table.Table.GetCell(r, c); cell != nil {
+				row[c] = plainCellText(cell.Text)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// formatTSV joins rows as tab-separated values, for pasting into a
+// spreadsheet.
+func formatTSV(rows [][]string) string {
+	var sb strings.Builder
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(cell)
+		}
+		sb.WriteString(strings.Join(cells, "\t"))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// formatMarkdownTable renders rows as a Markdown table, the first row being
+// the header.
+func formatMarkdownTable(rows [][]string) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	line := func(cells []string) string {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = escape.Replace(cell)
+		}
+		return "| " + strings.Join(escaped, " | ") + " |\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(line(rows[0]))
+	sep := make([]string, len(rows[0]))
+	for i := range sep {
+		sep[i] = "---"
+	}
+	sb.WriteString(line(sep))
+	for _, row := range rows[1:] {
+		sb.WriteString(line(row))
+	}
+	return sb.String()
+}
+
+// tableToClipboard offers the table as tab-separated values or a Markdown
+// table in the copy menu.
+func (a *App) tableToClipboard(table *components.Table) {
+	rows := tableText(table)
+	if len(rows) < 2 {
+		a.ToastWarning("The table has no rows to copy")
+		return
+	}
+	a.showYankMenu([]yankTarget{
+		{Key: 't', Label: fmt.Sprintf("Table, %d rows (TSV)", len(rows)-1), Value: formatTSV(rows)},
+		{Key: 'm', Label: fmt.Sprintf("Table, %d rows (Markdown)", len(rows)-1), Value: formatMarkdownTable(rows)},
+	})
+}
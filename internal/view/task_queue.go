@@ -3,12 +3,14 @@ package view
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -16,15 +18,37 @@ import (
 
 // taskQueueEntry represents a task queue in the list.
 type taskQueueEntry struct {
-	Name        string
-	Type        string
-	PollerCount int
-	Backlog     int
+	Name            string
+	Type            string
+	PollerCount     int
+	WorkflowPollers int
+	ActivityPollers int
+	Backlog         int
+	Workflow        *temporal.TaskQueueStats // Nil until described, or if the server has no stats
+	Activity        *temporal.TaskQueueStats
+}
+
+// pollerStaleAfter is how long since a poller's last poll before it is shown
+// as stale. Workers long-poll for up to a minute, so a live worker polls
+// again well within this.
+const pollerStaleAfter = 2 * time.Minute
+
+// starvedType returns the task type that has a backlog but no pollers, the
+// usual reason nothing is running, or "" if there is none.
+func (q taskQueueEntry) starvedType() string {
+	switch {
+	case q.Activity != nil && q.Activity.BacklogCount > 0 && q.ActivityPollers == 0:
+		return temporal.TaskQueueTypeActivity
+	case q.Workflow != nil && q.Workflow.BacklogCount > 0 && q.WorkflowPollers == 0:
+		return temporal.TaskQueueTypeWorkflow
+	}
+	return ""
 }
 
 // TaskQueueView displays task queue information.
 type TaskQueueView struct {
 	*tview.Flex
+	freshness
 	app            *App
 	queueTable     *components.Table
 	pollerTable    *components.Table
@@ -35,9 +59,10 @@ type TaskQueueView struct {
 	pollers        []temporal.Poller
 	selectedQueue  string
 	loading        bool
-	suppressSelect bool   // Prevent recursive selection handling
-	searchText     string // Current search filter text
-	baseTitle      string // Base title without search suffix
+	suppressSelect bool                                    // Prevent recursive selection handling
+	searchText     string                                  // Current search filter text
+	baseTitle      string                                  // Base title without search suffix
+	rateLimits     map[string]*temporal.TaskQueueRateLimit // Activity rate limit by queue name
 }
 
 // NewTaskQueueView creates a new task queue view.
@@ -49,6 +74,7 @@ func NewTaskQueueView(app *App) *TaskQueueView {
 		pollerTable: components.NewTable(),
 		queues:      []taskQueueEntry{},
 		pollers:     []temporal.Poller{},
+		rateLimits:  make(map[string]*temporal.TaskQueueRateLimit),
 	}
 	tq.setup()
 
@@ -62,21 +88,21 @@ func (tq *TaskQueueView) setup() {
 	tq.SetBackgroundColor(theme.Bg())
 
 	// Task queues table
-	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG")
+	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG", "ADD/S", "DISPATCH/S")
 	tq.queueTable.SetBorder(false)
 	tq.queueTable.SetBackgroundColor(theme.Bg())
 
 	// Pollers table
-	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS")
+	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE/S")
 	tq.pollerTable.SetBorder(false)
 	tq.pollerTable.SetBackgroundColor(theme.Bg())
 
 	// Create panels with icons (blubber pattern)
-	tq.baseTitle = fmt.Sprintf("%s Task Queues", theme.IconTaskQueue)
+	tq.baseTitle = fmt.Sprintf("%s Task Queues", icons.TaskQueue)
 	tq.queuePanel = components.NewPanel().SetTitle(tq.baseTitle)
 	tq.queuePanel.SetContent(tq.queueTable)
 
-	tq.pollerPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Pollers", theme.IconActivity))
+	tq.pollerPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Pollers", icons.Activity))
 	tq.pollerPanel.SetContent(tq.pollerTable)
 
 	// Update pollers when queue selection changes
@@ -169,7 +195,7 @@ func (tq *TaskQueueView) loadData() {
 		defer cancel()
 
 		// List workflows to discover task queues
-		workflows, _, err := provider.ListWorkflows(ctx, tq.app.CurrentNamespace(), temporal.ListOptions{PageSize: 100})
+		workflows, _, err := provider.ListWorkflows(ctx, tq.app.CurrentNamespace(), temporal.ListOptions{PageSize: tq.app.PageSize()})
 
 		tq.app.JigApp().QueueUpdateDraw(func() {
 			tq.setLoading(false)
@@ -177,6 +203,7 @@ func (tq *TaskQueueView) loadData() {
 				tq.showQueueError(err)
 				return
 			}
+			tq.markLoaded()
 
 			// Extract unique task queue names
 			queueSet := make(map[string]bool)
@@ -218,21 +245,23 @@ func (tq *TaskQueueView) loadData() {
 
 func (tq *TaskQueueView) showQueueError(err error) {
 	tq.queueTable.ClearRows()
-	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG")
+	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG", "ADD/S", "DISPATCH/S")
 	tq.queueTable.AddRowWithColor(theme.Error(),
 		"Error loading task queues",
 		err.Error(),
 		"",
 		"",
+		"",
+		"",
 	)
 }
 
 func (tq *TaskQueueView) loadMockQueues() {
 	tq.allQueues = []taskQueueEntry{
-		{Name: "order-tasks", Type: "Combined", PollerCount: 5, Backlog: 12},
-		{Name: "payment-tasks", Type: "Combined", PollerCount: 3, Backlog: 0},
-		{Name: "shipment-tasks", Type: "Combined", PollerCount: 2, Backlog: 5},
-		{Name: "notification-tasks", Type: "Combined", PollerCount: 2, Backlog: 0},
+		{Name: "order-tasks", Type: "Combined", PollerCount: 5, WorkflowPollers: 2, ActivityPollers: 3, Backlog: 12},
+		{Name: "payment-tasks", Type: "Combined", PollerCount: 3, WorkflowPollers: 1, ActivityPollers: 2, Backlog: 0},
+		{Name: "shipment-tasks", Type: "Combined", PollerCount: 2, WorkflowPollers: 1, ActivityPollers: 1, Backlog: 5},
+		{Name: "notification-tasks", Type: "Combined", PollerCount: 2, WorkflowPollers: 1, ActivityPollers: 1, Backlog: 0},
 	}
 	tq.applyFilter(tq.searchText)
 }
@@ -242,35 +271,43 @@ func (tq *TaskQueueView) populateQueueTable() {
 	currentRow := tq.queueTable.SelectedRow()
 
 	tq.queueTable.ClearRows()
-	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG")
+	tq.queueTable.SetHeaders("NAME", "TYPE", "POLLERS", "BACKLOG", "ADD/S", "DISPATCH/S")
 
 	for _, q := range tq.queues {
-		backlogIcon := theme.IconCompleted
+		backlogIcon := icons.Completed
 		backlogColor := temporal.StatusCompleted.Color()
 		if q.Backlog > 50 {
-			backlogIcon = theme.IconError
+			backlogIcon = icons.Error
 			backlogColor = temporal.StatusFailed.Color()
 		} else if q.Backlog > 10 {
-			backlogIcon = theme.IconRunning
+			backlogIcon = icons.Running
 			backlogColor = temporal.StatusRunning.Color()
 		}
 
-		typeIcon := theme.IconWorkflow
+		typeIcon := icons.Workflow
 		if q.Type == "Activity" {
-			typeIcon = theme.IconActivity
+			typeIcon = icons.Activity
 		}
 
 		// Track row position before adding
 		tableRow := tq.queueTable.Table.GetRowCount()
 		tq.queueTable.AddRow(
-			theme.IconTaskQueue+" "+q.Name,
+			icons.TaskQueue+" "+q.Name,
 			typeIcon+" "+q.Type,
-			fmt.Sprintf("%d", q.PollerCount),
+			fmt.Sprintf("%d wf / %d act", q.WorkflowPollers, q.ActivityPollers),
 			fmt.Sprintf("%s %d", backlogIcon, q.Backlog),
+			formatTaskRate(q.Workflow, q.Activity, func(s *temporal.TaskQueueStats) float32 { return s.AddRate }),
+			formatTaskRate(q.Workflow, q.Activity, func(s *temporal.TaskQueueStats) float32 { return s.DispatchRate }),
 		)
 		// Color the backlog cell
 		cell := tq.queueTable.GetCell(tableRow, 3)
 		cell.SetTextColor(backlogColor)
+		// Flag a backlog nobody is polling for
+		if starved := q.starvedType(); starved != "" {
+			pollerCell := tq.queueTable.GetCell(tableRow, 2)
+			pollerCell.SetText(icons.Warning + " " + pollerCell.Text)
+			pollerCell.SetTextColor(theme.Error())
+		}
 	}
 
 	if tq.queueTable.RowCount() > 0 {
@@ -291,6 +328,43 @@ func (tq *TaskQueueView) populateQueueTable() {
 	}
 }
 
+// formatTaskRate sums a per-second task rate across the workflow and
+// activity stats, or returns "-" if the server reported no rates.
+func formatTaskRate(workflow, activity *temporal.TaskQueueStats, rate func(*temporal.TaskQueueStats) float32) string {
+	var total float32
+	reported := false
+	for _, s := range []*temporal.TaskQueueStats{workflow, activity} {
+		if s != nil && s.HasRates {
+			total += rate(s)
+			reported = true
+		}
+	}
+	if !reported {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", total)
+}
+
+// formatPollerRate formats the dispatch rate limit a worker polls with. The
+// SDKs report a very large rate when the worker sets no limit.
+func formatPollerRate(rps float64) string {
+	if rps <= 0 || rps >= 100000 {
+		return "-"
+	}
+	return strconv.FormatFloat(rps, 'g', -1, 64)
+}
+
+// formatBacklog describes one task queue type's backlog, e.g. "12 (oldest 3m)".
+func formatBacklog(s *temporal.TaskQueueStats) string {
+	if s == nil {
+		return "?"
+	}
+	if s.BacklogCount == 0 || s.BacklogAge <= 0 {
+		return fmt.Sprintf("%d", s.BacklogCount)
+	}
+	return fmt.Sprintf("%d (oldest %s)", s.BacklogCount, s.BacklogAge.Round(time.Second))
+}
+
 func (tq *TaskQueueView) loadPollers(queueIndex int) {
 	if queueIndex < 0 || queueIndex >= len(tq.queues) {
 		return
@@ -298,6 +372,7 @@ func (tq *TaskQueueView) loadPollers(queueIndex int) {
 
 	queue := tq.queues[queueIndex]
 	tq.selectedQueue = queue.Name
+	tq.updatePollerTitle()
 
 	provider := tq.app.Provider()
 	if provider == nil {
@@ -307,7 +382,7 @@ func (tq *TaskQueueView) loadPollers(queueIndex int) {
 
 	// Load pollers from provider
 	tq.pollerTable.ClearRows()
-	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS")
+	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE/S")
 
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -327,6 +402,7 @@ func (tq *TaskQueueView) loadPollers(queueIndex int) {
 			}
 
 			tq.pollers = pollers
+			tq.markLoaded()
 			tq.populatePollerTable("")
 		})
 	}()
@@ -337,8 +413,17 @@ func (tq *TaskQueueView) updateQueueInfo(queueIndex int, info *temporal.TaskQueu
 		return
 	}
 	// Update the queue entry with real data
+	tq.queues[queueIndex].Type = info.Type
 	tq.queues[queueIndex].PollerCount = info.PollerCount
+	tq.queues[queueIndex].WorkflowPollers = info.WorkflowPollers
+	tq.queues[queueIndex].ActivityPollers = info.ActivityPollers
 	tq.queues[queueIndex].Backlog = info.Backlog
+	tq.queues[queueIndex].Workflow = info.Workflow
+	tq.queues[queueIndex].Activity = info.Activity
+	if info.RateLimit != nil {
+		tq.rateLimits[info.Name] = info.RateLimit
+	}
+	tq.updatePollerTitle()
 	// Suppress selection events during table refresh to avoid recursive loop
 	tq.suppressSelect = true
 	// Refresh the queue table display
@@ -351,18 +436,18 @@ func (tq *TaskQueueView) updateQueueInfo(queueIndex int, info *temporal.TaskQueu
 func (tq *TaskQueueView) loadMockPollers(queue taskQueueEntry) {
 	now := time.Now()
 	tq.pollers = []temporal.Poller{
-		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-5 * time.Second), TaskQueueType: "Workflow"},
-		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-3 * time.Second), TaskQueueType: "Activity"},
-		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-10 * time.Second), TaskQueueType: "Workflow"},
-		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-2 * time.Second), TaskQueueType: "Activity"},
-		{Identity: "worker-3@host-003", LastAccessTime: now.Add(-1 * time.Second), TaskQueueType: "Activity"},
+		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-5 * time.Second), TaskQueueType: "Workflow", RatePerSecond: 100000},
+		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-3 * time.Second), TaskQueueType: "Activity", RatePerSecond: 100000},
+		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-10 * time.Second), TaskQueueType: "Workflow", RatePerSecond: 100000},
+		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-2 * time.Second), TaskQueueType: "Activity", RatePerSecond: 50},
+		{Identity: "worker-3@host-003", LastAccessTime: now.Add(-5 * time.Minute), TaskQueueType: "Activity", RatePerSecond: 50},
 	}
 	tq.populatePollerTable("")
 }
 
 func (tq *TaskQueueView) populatePollerTable(queueType string) {
 	tq.pollerTable.ClearRows()
-	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS")
+	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE/S")
 
 	now := time.Now()
 	for _, p := range tq.pollers {
@@ -371,27 +456,34 @@ func (tq *TaskQueueView) populatePollerTable(queueType string) {
 			continue
 		}
 
-		typeIcon := theme.IconWorkflow
+		typeIcon := icons.Workflow
 		if p.TaskQueueType == "Activity" {
-			typeIcon = theme.IconActivity
+			typeIcon = icons.Activity
 		}
 
 		lastAccess := formatRelativeTime(now, p.LastAccessTime)
+		tableRow := tq.pollerTable.Table.GetRowCount()
 		tq.pollerTable.AddRow(
-			theme.IconConnected+" "+p.Identity,
+			icons.Connected+" "+p.Identity,
 			typeIcon+" "+p.TaskQueueType,
 			lastAccess,
+			formatPollerRate(p.RatePerSecond),
 		)
+		if now.Sub(p.LastAccessTime) > pollerStaleAfter {
+			tq.pollerTable.GetCell(tableRow, 0).SetText(icons.Disconnected + " " + p.Identity)
+			tq.pollerTable.GetCell(tableRow, 2).SetTextColor(theme.Error())
+		}
 	}
 }
 
 func (tq *TaskQueueView) showPollerError(err error) {
 	tq.pollerTable.ClearRows()
-	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS")
+	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS", "RATE/S")
 	tq.pollerTable.AddRowWithColor(theme.Error(),
-		theme.IconError+" Error loading pollers",
+		icons.Error+" Error loading pollers",
 		err.Error(),
 		"",
+		"",
 	)
 }
 
@@ -407,6 +499,11 @@ func (tq *TaskQueueView) Name() string {
 	return "task-queues"
 }
 
+// Reload reloads the task queue list and the first queue's pollers.
+func (tq *TaskQueueView) Reload() {
+	tq.loadData()
+}
+
 // Start is called when the view becomes active.
 func (tq *TaskQueueView) Start() {
 	queueBindings := input.NewKeyBindings().
@@ -421,6 +518,22 @@ func (tq *TaskQueueView) Start() {
 		OnRune('r', func(e *tcell.EventKey) bool {
 			tq.refreshCurrentQueue()
 			return true
+		}).
+		OnRune('L', func(e *tcell.EventKey) bool {
+			tq.showRateLimitForm()
+			return true
+		}).
+		OnRune('p', func(e *tcell.EventKey) bool {
+			tq.togglePause()
+			return true
+		}).
+		OnRune('v', func(e *tcell.EventKey) bool {
+			tq.showVersioning()
+			return true
+		}).
+		OnRune('Y', func(e *tcell.EventKey) bool {
+			tq.app.tableToClipboard(tq.queueTable)
+			return true
 		})
 
 	pollerBindings := input.NewKeyBindings().
@@ -431,6 +544,10 @@ func (tq *TaskQueueView) Start() {
 		OnRune('r', func(e *tcell.EventKey) bool {
 			tq.refreshCurrentQueue()
 			return true
+		}).
+		OnRune('Y', func(e *tcell.EventKey) bool {
+			tq.app.tableToClipboard(tq.pollerTable)
+			return true
 		})
 
 	tq.queueTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
@@ -462,6 +579,10 @@ func (tq *TaskQueueView) Hints() []KeyHint {
 	return []KeyHint{
 		{Key: "/", Description: "Search"},
 		{Key: "r", Description: "Refresh"},
+		{Key: "L", Description: "Rate Limit"},
+		{Key: "p", Description: "Pause/Unpause"},
+		{Key: "v", Description: "Versioning"},
+		{Key: "Y", Description: "Copy Table"},
 		{Key: "tab", Description: "Switch Panel"},
 		{Key: "j/k", Description: "Navigate"},
 		{Key: "T", Description: "Theme"},
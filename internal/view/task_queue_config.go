@@ -0,0 +1,286 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// formatRateLimit describes an activity rate limit, e.g. "5/s" or "paused".
+func formatRateLimit(rl *temporal.TaskQueueRateLimit) string {
+	switch {
+	case rl == nil:
+		return "unknown"
+	case !rl.Set:
+		return "worker default"
+	case rl.Paused():
+		return "paused"
+	}
+	return strconv.FormatFloat(float64(rl.RequestsPerSecond), 'g', -1, 32) + "/s"
+}
+
+// selectedQueueEntry returns the queue under the cursor, skipping the
+// "no task queues" placeholder.
+func (tq *TaskQueueView) selectedQueueEntry() (taskQueueEntry, bool) {
+	row := tq.queueTable.SelectedRow()
+	if row < 0 || row >= len(tq.queues) || tq.queues[row].Type == "-" {
+		return taskQueueEntry{}, false
+	}
+	return tq.queues[row], true
+}
+
+// updatePollerTitle shows the selected queue's backlog by task type, a
+// warning if a backlog has no pollers, and its activity rate limit in the
+// pollers panel title.
+func (tq *TaskQueueView) updatePollerTitle() {
+	title := fmt.Sprintf("%s Pollers", icons.Activity)
+	for _, q := range tq.queues {
+		if q.Name != tq.selectedQueue {
+			continue
+		}
+		if starved := q.starvedType(); starved != "" {
+			title += fmt.Sprintf(" · [%s]%s No %s pollers for a backlog of tasks[-]", theme.TagError(), icons.Warning, strings.ToLower(starved))
+		}
+		if q.Workflow != nil || q.Activity != nil {
+			title += fmt.Sprintf(" · Backlog: wf %s, act %s", formatBacklog(q.Workflow), formatBacklog(q.Activity))
+		}
+	}
+	if rl, ok := tq.rateLimits[tq.selectedQueue]; ok {
+		title += " · Activity limit: " + formatRateLimit(rl)
+	}
+	tq.pollerPanel.SetTitle(title)
+}
+
+// withTaskQueueConfig runs fn for the selected queue in a writable namespace,
+// once the server is known to support task queue configuration.
+func (tq *TaskQueueView) withTaskQueueConfig(fn func(queue taskQueueEntry)) {
+	queue, ok := tq.selectedQueueEntry()
+	if !ok {
+		return
+	}
+	if tq.app.blockReadOnly() {
+		return
+	}
+	provider := tq.app.Provider()
+	if provider == nil {
+		tq.app.ToastWarning("Task queue configuration needs a server connection")
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		supported, err := provider.SupportsTaskQueueConfig(ctx)
+
+		tq.app.JigApp().QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				tq.app.ToastError(fmt.Sprintf("Failed to check server version: %v", err))
+			case !supported:
+				tq.app.ToastWarning("This server does not support task queue rate limits")
+			default:
+				fn(queue)
+			}
+		})
+	}()
+}
+
+// showRateLimitForm asks for a new activity rate limit for the selected queue.
+func (tq *TaskQueueView) showRateLimitForm() {
+	tq.withTaskQueueConfig(func(queue taskQueueEntry) {
+		current := tq.rateLimits[queue.Name]
+
+		value := ""
+		if current != nil && current.Set {
+			value = strconv.FormatFloat(float64(current.RequestsPerSecond), 'g', -1, 32)
+		}
+
+		form := components.NewFormBuilder().
+			Text("rps", "Activities per second (empty removes the limit)").
+			Value(value).
+			Placeholder("e.g. 10").
+			Validate(validators.Custom(func(v any) error {
+				_, err := parseRateLimit(v.(string))
+				return err
+			})).
+			Done().
+			Text("reason", "Reason").
+			Value("Rate limited via tempo").
+			Done().
+			OnSubmit(func(values map[string]any) {
+				rps, err := parseRateLimit(values["rps"].(string))
+				if err != nil {
+					return
+				}
+				tq.closeModal()
+				// A limit of 0 pauses dispatch, so confirm it like a pause
+				if rps != nil && *rps == 0 {
+					tq.showPauseConfirm(queue, current)
+					return
+				}
+				tq.executeSetRateLimit(queue.Name, rps, values["reason"].(string))
+			}).
+			OnCancel(func() {
+				tq.closeModal()
+			}).
+			Build()
+
+		tq.showConfigModal(fmt.Sprintf("%s Activity Rate Limit", icons.TaskQueue), queue.Name, current, "", form, 15, "Apply")
+	})
+}
+
+// togglePause pauses activity dispatch on the selected queue, or removes the
+// pause if it is already paused.
+func (tq *TaskQueueView) togglePause() {
+	tq.withTaskQueueConfig(func(queue taskQueueEntry) {
+		current := tq.rateLimits[queue.Name]
+
+		if current.Paused() {
+			form := components.NewFormBuilder().
+				Text("reason", "Reason").
+				Value("Unpaused via tempo").
+				Done().
+				OnSubmit(func(values map[string]any) {
+					tq.closeModal()
+					tq.executeSetRateLimit(queue.Name, nil, values["reason"].(string))
+				}).
+				OnCancel(func() {
+					tq.closeModal()
+				}).
+				Build()
+			tq.showConfigModal(fmt.Sprintf("%s Unpause Task Queue", icons.Info), queue.Name, current,
+				"Removes the rate limit override; workers' own limits apply again.", form, 13, "Unpause")
+			return
+		}
+
+		tq.showPauseConfirm(queue, current)
+	})
+}
+
+// showPauseConfirm asks for a reason, and the danger phrase in danger
+// namespaces, before pausing activity dispatch on queue.
+func (tq *TaskQueueView) showPauseConfirm(queue taskQueueEntry, current *temporal.TaskQueueRateLimit) {
+	namespace := tq.app.CurrentNamespace()
+	confirm := tq.app.confirmationFor("pause", namespace, "")
+	builder := components.NewFormBuilder().
+		Text("reason", "Reason").
+		Value("Paused via tempo").
+		Validate(validators.Required()).
+		Done()
+	height := 13
+	if confirm.required() {
+		builder = confirm.addField(builder, "")
+		height += 4
+	}
+	form := builder.
+		OnSubmit(func(values map[string]any) {
+			if confirm.required() && !confirm.matches(values["confirm"].(string)) {
+				return
+			}
+			tq.closeModal()
+			paused := float32(0)
+			tq.executeSetRateLimit(queue.Name, &paused, values["reason"].(string))
+		}).
+		OnCancel(func() {
+			tq.closeModal()
+		}).
+		Build()
+	tq.showConfigModal(fmt.Sprintf("%s Pause Task Queue", icons.Warning), queue.Name, current,
+		fmt.Sprintf("[%s]No activities are dispatched until the queue is unpaused.[-]", theme.TagWarning())+confirm.warning(namespace),
+		form, height, "Pause")
+}
+
+// showConfigModal shows a task queue configuration form below the queue's
+// current limit and an optional note.
+func (tq *TaskQueueView) showConfigModal(title, queue string, current *temporal.TaskQueueRateLimit, note string, form tview.Primitive, height int, action string) {
+	lines := []string{
+		fmt.Sprintf("[%s]Task Queue:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), tview.Escape(queue)),
+		fmt.Sprintf("[%s]Current limit:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), formatRateLimit(current)),
+	}
+	if note != "" {
+		lines = append(lines, note)
+	}
+	text := strings.Join(lines, "\n")
+
+	infoText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft).
+		SetText(text)
+	infoText.SetBackgroundColor(theme.Bg())
+
+	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
+	contentFlex.SetBackgroundColor(theme.Bg())
+	contentFlex.AddItem(infoText, strings.Count(text, "\n")+2, 0, false)
+	contentFlex.AddItem(form, 0, 1, true)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    title,
+		Width:    70,
+		Height:   height,
+		Backdrop: true,
+	})
+	modal.SetContent(contentFlex)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: action},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	tq.app.JigApp().Pages().Push(modal)
+	tq.app.JigApp().SetFocus(form)
+}
+
+// parseRateLimit parses a requests-per-second value; empty means no limit.
+func parseRateLimit(s string) (*float32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(s, 32)
+	if err != nil || f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("must be a number of at least 0")
+	}
+	rps := float32(f)
+	return &rps, nil
+}
+
+// executeSetRateLimit applies an activity rate limit and reports the limit
+// the server now enforces.
+func (tq *TaskQueueView) executeSetRateLimit(queue string, rps *float32, reason string) {
+	provider := tq.app.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := tq.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		limit, err := provider.SetTaskQueueRateLimit(ctx, namespace, queue, rps, reason)
+
+		tq.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				tq.app.ToastError(fmt.Sprintf("Failed to update %s: %v", queue, err))
+				return
+			}
+			tq.rateLimits[queue] = limit
+			tq.updatePollerTitle()
+			tq.app.ToastSuccess(fmt.Sprintf("%s activity limit: %s", queue, formatRateLimit(limit)))
+		})
+	}()
+}
+
+func (tq *TaskQueueView) closeModal() {
+	tq.app.JigApp().Pages().DismissModal()
+}
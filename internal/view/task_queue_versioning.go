@@ -0,0 +1,120 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// showVersioning loads the selected queue's worker build IDs and shows them
+// in a modal.
+func (tq *TaskQueueView) showVersioning() {
+	queue, ok := tq.selectedQueueEntry()
+	if !ok {
+		return
+	}
+	provider := tq.app.Provider()
+	if provider == nil {
+		tq.app.ToastWarning("Worker versioning needs a server connection")
+		return
+	}
+	namespace := tq.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		versioning, err := provider.GetTaskQueueVersioning(ctx, namespace, queue.Name)
+
+		tq.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				tq.app.ToastError(fmt.Sprintf("Failed to load worker versioning: %v", err))
+				return
+			}
+			if !versioning.Versioned() {
+				tq.app.ToastWarning(fmt.Sprintf("No build IDs are registered on %s", queue.Name))
+				return
+			}
+			tq.showVersioningModal(queue.Name, versioning)
+		})
+	}()
+}
+
+func (tq *TaskQueueView) showVersioningModal(queue string, versioning *temporal.TaskQueueVersioning) {
+	text := formatVersioning(queue, versioning)
+
+	textView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetText(text)
+	textView.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Worker Versioning", icons.TaskQueue),
+		Width:    80,
+		Height:   min(strings.Count(text, "\n")+5, 30),
+		Backdrop: true,
+	})
+	modal.SetContent(textView)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Scroll"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		tq.closeModal()
+	})
+
+	tq.app.JigApp().Pages().Push(modal)
+	tq.app.JigApp().SetFocus(textView)
+}
+
+// formatVersioning lists a queue's version sets, newest first, with what each
+// build ID can still be sent. Build IDs that only have closed workflows left
+// are marked drained.
+func formatVersioning(queue string, versioning *temporal.TaskQueueVersioning) string {
+	lines := []string{
+		fmt.Sprintf("[%s]Task Queue:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), tview.Escape(queue)),
+		fmt.Sprintf("[%s]Default build ID:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagAccent(), tview.Escape(versioning.DefaultBuildID)),
+		"",
+	}
+
+	for i := len(versioning.VersionSets) - 1; i >= 0; i-- {
+		set := versioning.VersionSets[i]
+		label := fmt.Sprintf("Version set %d", i+1)
+		if i == len(versioning.VersionSets)-1 {
+			label += " (default)"
+		}
+		lines = append(lines, fmt.Sprintf("[%s::b]%s[-::-]", theme.TagFg(), label))
+
+		for j := len(set.BuildIDs) - 1; j >= 0; j-- {
+			id := set.BuildIDs[j]
+			marker := " "
+			if id == set.Default {
+				marker = "*"
+			}
+			lines = append(lines, fmt.Sprintf("  %s %s  %s", marker, tview.Escape(id), formatReachability(versioning, id)))
+		}
+	}
+
+	lines = append(lines, "", fmt.Sprintf("[%s]* marks the default build ID of each set[-]", theme.TagFgDim()))
+	return strings.Join(lines, "\n")
+}
+
+// formatReachability describes what a build ID can still be sent.
+func formatReachability(versioning *temporal.TaskQueueVersioning, buildID string) string {
+	reach, ok := versioning.Reachability[buildID]
+	switch {
+	case !ok:
+		return fmt.Sprintf("[%s]reachability unknown[-]", theme.TagFgDim())
+	case versioning.Drained(buildID):
+		return fmt.Sprintf("[%s]%s drained[-]", theme.TagSuccess(), icons.Check)
+	}
+	return fmt.Sprintf("[%s]reachable: %s[-]", theme.TagWarning(), strings.Join(reach, ", "))
+}
@@ -13,8 +13,23 @@ import (
 const (
 	timelineLabelWidth = 25 // Width for lane labels on the left
 	timelineMinWidth   = 40 // Minimum timeline bar area width
+	timelineHeaderRows = 3  // Wall-clock ticks, elapsed offsets, and the axis line
+	timelineTickSpan   = 12 // Minimum columns between axis ticks
 )
 
+// timelineTickIntervals are the candidate spacings between axis ticks,
+// chosen so ticks land on round wall-clock times.
+var timelineTickIntervals = []time.Duration{
+	10 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond,
+	250 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second,
+	15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute,
+	15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour,
+}
+
 // TimelineLane represents a horizontal lane in the timeline.
 type TimelineLane struct {
 	Name      string
@@ -35,6 +50,7 @@ type TimelineView struct {
 	scrollY           int
 	zoomLevel         float64
 	selectedLane      int
+	running           bool // The workflow is open, so endTime is when the nodes were set
 	onSelect          func(lane *TimelineLane)
 	onSelectionChange func(lane *TimelineLane)
 }
@@ -59,13 +75,36 @@ func (tv *TimelineView) Destroy() {}
 
 // SetNodes populates the timeline from event tree nodes.
 func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
+	// Keep the selected lane across a reload if it is still there
+	var selectedKey int64
+	if lane := tv.SelectedLane(); lane != nil {
+		selectedKey = treeNodeKey(lane.Node)
+	}
+
 	tv.lanes = nil
 	tv.selectedLane = 0
+	tv.running = false
 
 	if len(nodes) == 0 {
 		return
 	}
 
+	// The workflow is open if it started and has no closing event yet
+	var workflowStart time.Time
+	started, closed := false, false
+	for _, node := range nodes {
+		if node.Type != temporal.GroupWorkflow {
+			continue
+		}
+		switch node.Status {
+		case "Running":
+			started = true
+			workflowStart = node.StartTime
+		case "Completed", "Failed", "TimedOut", "Canceled", "Terminated", "ContinuedAsNew":
+			closed = true
+		}
+	}
+
 	// First pass: collect valid lanes and find time range
 	var validLanes []TimelineLane
 	var minStart, maxEnd time.Time
@@ -106,11 +145,26 @@ func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
 		return
 	}
 
+	// Measure elapsed time from the workflow start rather than the first lane
+	if !workflowStart.IsZero() && workflowStart.Before(minStart) {
+		minStart = workflowStart
+	}
+
 	tv.lanes = validLanes
 	tv.startTime = minStart
+	for i, lane := range tv.lanes {
+		if selectedKey != 0 && treeNodeKey(lane.Node) == selectedKey {
+			tv.selectedLane = i
+			break
+		}
+	}
+	if tv.scrollY > tv.selectedLane {
+		tv.scrollY = tv.selectedLane
+	}
 
-	// Set end time: use max end time, or now for running items
-	if maxEnd.IsZero() || maxEnd.Before(minStart) {
+	// Set end time: use max end time, or now for running items and workflows
+	tv.running = started && !closed
+	if tv.running || maxEnd.IsZero() || maxEnd.Before(minStart) {
 		tv.endTime = time.Now()
 	} else {
 		tv.endTime = maxEnd
@@ -139,7 +193,7 @@ func (tv *TimelineView) Draw(screen tcell.Screen) {
 	// Draw header with time scale
 	tv.drawHeader(screen, x, y, width)
 
-	// Draw lanes starting from y+2 (after header)
+	// Draw lanes below the header
 	barAreaWidth := width - timelineLabelWidth - 1
 	if barAreaWidth < timelineMinWidth {
 		barAreaWidth = timelineMinWidth
@@ -150,7 +204,7 @@ func (tv *TimelineView) Draw(screen tcell.Screen) {
 		timeRange = time.Minute
 	}
 
-	visibleLanes := height - 3 // Subtract header rows
+	visibleLanes := height - timelineHeaderRows - 1 // Subtract header rows and legend
 	startLane := tv.scrollY
 	endLane := startLane + visibleLanes
 	if endLane > len(tv.lanes) {
@@ -161,7 +215,7 @@ func (tv *TimelineView) Draw(screen tcell.Screen) {
 
 	for i := startLane; i < endLane; i++ {
 		lane := tv.lanes[i]
-		laneY := y + 2 + (i - startLane)
+		laneY := y + timelineHeaderRows + (i - startLane)
 
 		// Draw lane label
 		tv.drawLaneLabel(screen, x, laneY, lane, i == tv.selectedLane)
@@ -175,13 +229,19 @@ func (tv *TimelineView) Draw(screen tcell.Screen) {
 		tv.drawCursor(screen, barStartX, y, barAreaWidth, height, timeRange)
 	}
 
+	// Mark the present for an open workflow
+	if tv.running {
+		tv.drawNowLine(screen, barStartX, y, barAreaWidth, height, endLane-startLane)
+	}
+
 	// Draw legend at bottom if space
-	if height > len(tv.lanes)+4 {
+	if height > len(tv.lanes)+timelineHeaderRows+2 {
 		tv.drawLegend(screen, x, y+height-1, width)
 	}
 }
 
-// drawHeader draws the time scale header.
+// drawHeader draws the time axis: wall-clock times at round intervals, each
+// with its elapsed offset from the first event, above a tick-marked line.
 func (tv *TimelineView) drawHeader(screen tcell.Screen, x, y, width int) {
 	barAreaWidth := width - timelineLabelWidth - 1
 	if barAreaWidth <= 0 {
@@ -193,58 +253,112 @@ func (tv *TimelineView) drawHeader(screen tcell.Screen, x, y, width int) {
 		return
 	}
 
-	// Draw label column header
-	labelStyle := tcell.StyleDefault.Foreground(theme.PanelTitle()).Background(theme.Bg())
 	tview.Print(screen, "Event", x, y, timelineLabelWidth, tview.AlignLeft, theme.PanelTitle())
-
-	// Draw time markers (scaled to width, with rounding)
-	markerCount := 5
-	if barAreaWidth < 60 {
-		markerCount = 3
-	}
+	tview.Print(screen, "Elapsed", x, y+1, timelineLabelWidth, tview.AlignLeft, theme.FgDim())
 
 	barStartX := x + timelineLabelWidth + 1
-	zoomLevel := tv.zoomLevel
-	if zoomLevel < 0.1 {
-		zoomLevel = 0.1
+	axisY := y + timelineHeaderRows - 1
+
+	lineStyle := tcell.StyleDefault.Foreground(theme.Border()).Background(theme.Bg())
+	for i := barStartX; i < x+width; i++ {
+		screen.SetContent(i, axisY, '─', nil, lineStyle)
 	}
 
-	for i := 0; i <= markerCount; i++ {
-		// Calculate position with zoom and scroll
-		rawPos := barAreaWidth * i / markerCount
-		pos := barStartX + int(float64(rawPos)*zoomLevel) - tv.scrollX
+	// The times shown at the left and right edges of the bar area
+	first := tv.timeAtColumn(0, barAreaWidth)
+	last := tv.timeAtColumn(barAreaWidth, barAreaWidth)
+	interval := timelineTickInterval(last.Sub(first), barAreaWidth)
 
-		if pos < barStartX || pos >= x+width {
+	tickStyle := tcell.StyleDefault.Foreground(theme.FgDim()).Background(theme.Bg())
+	labelEnd := barStartX // First column clear of the previous label
+	for t := first.Truncate(interval); !t.After(last); t = t.Add(interval) {
+		if t.Before(first) {
 			continue
 		}
-
-		// Calculate time at this position (accounting for scroll/zoom)
-		effectivePos := int(float64(pos-barStartX+tv.scrollX) / zoomLevel)
-		if effectivePos < 0 {
-			effectivePos = 0
+		col := tv.columnAt(t, barAreaWidth)
+		if col < 0 || col >= barAreaWidth {
+			continue
 		}
-		if effectivePos > barAreaWidth {
-			effectivePos = barAreaWidth
+		pos := barStartX + col
+		screen.SetContent(pos, axisY, '┬', nil, tickStyle)
+
+		clock := formatTickTime(t, interval)
+		elapsed := "+" + formatRelativeDuration(t.Sub(tv.startTime))
+		labelWidth := max(len(clock), len(elapsed))
+		if pos < labelEnd || pos+labelWidth > x+width {
+			continue
 		}
-		offset := time.Duration(float64(timeRange) * float64(effectivePos) / float64(barAreaWidth))
+		tview.Print(screen, clock, pos, y, labelWidth, tview.AlignLeft, theme.Fg())
+		tview.Print(screen, elapsed, pos, y+1, labelWidth, tview.AlignLeft, theme.FgDim())
+		labelEnd = pos + labelWidth + 1
+	}
+}
 
-		// Round to nice value
-		offset = roundDuration(offset)
+// timeAtColumn returns the time shown at a column of the bar area, taking
+// zoom and scroll into account.
+func (tv *TimelineView) timeAtColumn(col, barAreaWidth int) time.Time {
+	zoom := max(tv.zoomLevel, 0.1)
+	offset := float64(col+tv.scrollX) / zoom / float64(barAreaWidth)
+	return tv.startTime.Add(time.Duration(offset * float64(tv.endTime.Sub(tv.startTime))))
+}
 
-		// Format as relative duration from start
-		marker := formatRelativeDuration(offset)
+// columnAt returns the bar area column a time is drawn at, which may fall
+// outside the visible area.
+func (tv *TimelineView) columnAt(t time.Time, barAreaWidth int) int {
+	timeRange := tv.endTime.Sub(tv.startTime)
+	if timeRange <= 0 {
+		return 0
+	}
+	col := float64(barAreaWidth) * float64(t.Sub(tv.startTime)) / float64(timeRange)
+	return int(col*tv.zoomLevel) - tv.scrollX
+}
 
-		// Draw marker
-		tview.Print(screen, marker, pos, y, 10, tview.AlignLeft, theme.FgDim())
+// timelineTickInterval picks the smallest round interval that keeps ticks
+// at least timelineTickSpan columns apart.
+func timelineTickInterval(visible time.Duration, barAreaWidth int) time.Duration {
+	if barAreaWidth > 0 {
+		minInterval := visible * timelineTickSpan / time.Duration(barAreaWidth)
+		for _, interval := range timelineTickIntervals {
+			if interval >= minInterval {
+				return interval
+			}
+		}
+	}
+	return timelineTickIntervals[len(timelineTickIntervals)-1]
+}
 
-		// Draw tick mark
-		screen.SetContent(pos, y+1, '│', nil, labelStyle)
+// formatTickTime formats a tick's local wall-clock time with enough
+// precision to tell neighbouring ticks apart.
+func formatTickTime(t time.Time, interval time.Duration) string {
+	t = t.Local()
+	switch {
+	case interval < time.Second:
+		return t.Format("15:04:05.000")
+	case interval < time.Minute:
+		return t.Format("15:04:05")
+	case interval < 24*time.Hour:
+		return t.Format("15:04")
+	default:
+		return t.Format("Jan 2")
 	}
+}
 
-	// Draw horizontal line under header
-	lineStyle := tcell.StyleDefault.Foreground(theme.Border()).Background(theme.Bg())
-	for i := x + timelineLabelWidth + 1; i < x+width; i++ {
-		screen.SetContent(i, y+1, '─', nil, lineStyle)
+// drawNowLine draws a vertical line at the present through the visible
+// lanes of an open workflow.
+func (tv *TimelineView) drawNowLine(screen tcell.Screen, x, y, width, height, lanes int) {
+	col := min(tv.columnAt(tv.endTime, width), width-1)
+	if col < 0 {
+		return
+	}
+	style := tcell.StyleDefault.Foreground(theme.Warning()).Background(theme.Bg())
+	screen.SetContent(x+col, y+timelineHeaderRows-1, '▼', nil, style)
+	for row := y + timelineHeaderRows; row < y+timelineHeaderRows+lanes && row < y+height-1; row++ {
+		mainc, _, laneStyle, _ := screen.GetContent(x+col, row)
+		if mainc == '·' || mainc == ' ' {
+			screen.SetContent(x+col, row, '┊', nil, style)
+		} else {
+			screen.SetContent(x+col, row, mainc, nil, laneStyle.Foreground(theme.Warning()))
+		}
 	}
 }
 
@@ -380,8 +494,8 @@ func (tv *TimelineView) drawCursor(screen tcell.Screen, x, y, width, height int,
 	prevEndPos = int(float64(prevEndPos)*tv.zoomLevel) - tv.scrollX
 
 	// Calculate the row for the selected lane
-	selectedRow := y + 2 + (tv.selectedLane - tv.scrollY)
-	lanesEnd := y + 2 + (len(tv.lanes) - tv.scrollY)
+	selectedRow := y + timelineHeaderRows + (tv.selectedLane - tv.scrollY)
+	lanesEnd := y + timelineHeaderRows + (len(tv.lanes) - tv.scrollY)
 	if lanesEnd > y+height-1 {
 		lanesEnd = y + height - 1
 	}
@@ -399,12 +513,12 @@ func (tv *TimelineView) drawCursor(screen tcell.Screen, x, y, width, height int,
 	// Draw vertical cursor line at start position
 	if startPos >= 0 && startPos < width {
 		cursorStyle := tcell.StyleDefault.Foreground(theme.Accent()).Background(theme.Bg())
-		for row := y + 2; row < lanesEnd; row++ {
+		for row := y + timelineHeaderRows; row < lanesEnd; row++ {
 			screen.SetContent(x+startPos, row, '│', nil, cursorStyle)
 		}
 
 		// Draw start time label in header
-		startLabel := formatRelativeDuration(startOffset)
+		startLabel := "+" + formatRelativeDuration(startOffset)
 		labelStyle := tcell.StyleDefault.Foreground(theme.Bg()).Background(theme.Accent())
 		labelX := x + startPos
 		if labelX+len(startLabel) > x+width {
@@ -415,7 +529,7 @@ func (tv *TimelineView) drawCursor(screen tcell.Screen, x, y, width, height int,
 		}
 		for i, r := range startLabel {
 			if labelX+i >= x && labelX+i < x+width {
-				screen.SetContent(labelX+i, y, r, nil, labelStyle)
+				screen.SetContent(labelX+i, y+1, r, nil, labelStyle)
 			}
 		}
 	}
@@ -429,14 +543,14 @@ func (tv *TimelineView) drawCursor(screen tcell.Screen, x, y, width, height int,
 		// Draw vertical line at end position (if visible and different from start)
 		if endPos > startPos && endPos >= 0 && endPos < width {
 			endStyle := tcell.StyleDefault.Foreground(theme.Success()).Background(theme.Bg())
-			for row := y + 2; row < lanesEnd; row++ {
+			for row := y + timelineHeaderRows; row < lanesEnd; row++ {
 				screen.SetContent(x+endPos, row, '│', nil, endStyle)
 			}
 		}
 
 		// Calculate available space inside the candlestick
 		candleWidth := endPos - startPos
-		startLabelLen := len(formatRelativeDuration(startOffset))
+		startLabelLen := len(formatRelativeDuration(startOffset)) + 1
 
 		var durationX int
 		if candleWidth > len(durationLabel)+2 {
@@ -464,7 +578,7 @@ func (tv *TimelineView) drawCursor(screen tcell.Screen, x, y, width, height int,
 		if durationX >= x && durationX < x+width {
 			for i, r := range durationLabel {
 				if durationX+i >= x && durationX+i < x+width {
-					screen.SetContent(durationX+i, y+1, r, nil, durationStyle)
+					screen.SetContent(durationX+i, y+timelineHeaderRows-1, r, nil, durationStyle)
 				}
 			}
 		}
@@ -581,13 +695,13 @@ func (tv *TimelineView) barStyle(status string) (rune, tcell.Color) {
 	switch status {
 	case "Running":
 		return '▓', theme.Warning()
-	case "Completed", "Fired":
+	case "Completed", "Fired", "ContinuedAsNew":
 		return '█', theme.Success()
-	case "Failed", "TimedOut":
+	case "Failed", "TimedOut", "Rejected":
 		return '░', theme.Error()
 	case "Canceled", "Terminated":
 		return '▒', theme.Warning()
-	case "Scheduled", "Initiated", "Pending":
+	case "Scheduled", "Initiated", "Pending", "Admitted":
 		return '▒', theme.FgDim()
 	default:
 		return '▒', theme.Fg()
@@ -596,6 +710,9 @@ func (tv *TimelineView) barStyle(status string) (rune, tcell.Color) {
 
 // statusColor returns the color for a status.
 func (tv *TimelineView) statusColor(status string) tcell.Color {
+	if status == "Rejected" {
+		return temporal.StatusFailed.Color()
+	}
 	return temporal.GetWorkflowStatus(status).Color()
 }
 
@@ -654,7 +771,7 @@ func (tv *TimelineView) moveSelection(delta int) {
 
 	// Adjust scroll to keep selection visible
 	_, _, _, height := tv.GetInnerRect()
-	visibleLanes := height - 3
+	visibleLanes := height - timelineHeaderRows - 1
 
 	if tv.selectedLane < tv.scrollY {
 		tv.scrollY = tv.selectedLane
@@ -669,6 +786,20 @@ func (tv *TimelineView) moveSelection(delta int) {
 	}
 }
 
+// JumpToFailure selects the next (dir > 0) or previous (dir < 0) failed
+// lane, wrapping around at either end. It returns false if no lane failed.
+func (tv *TimelineView) JumpToFailure(dir int) bool {
+	n := len(tv.lanes)
+	for i := 1; i <= n; i++ {
+		lane := ((tv.selectedLane+dir*i)%n + n) % n
+		if isFailureStatus(tv.lanes[lane].Status) {
+			tv.moveSelection(lane - tv.selectedLane)
+			return true
+		}
+	}
+	return false
+}
+
 // selectFirst jumps to the first lane.
 func (tv *TimelineView) selectFirst() {
 	if len(tv.lanes) == 0 {
@@ -692,7 +823,7 @@ func (tv *TimelineView) selectLast() {
 
 	// Adjust scroll to keep selection visible
 	_, _, _, height := tv.GetInnerRect()
-	visibleLanes := height - 3
+	visibleLanes := height - timelineHeaderRows - 1
 	if tv.selectedLane >= tv.scrollY+visibleLanes {
 		tv.scrollY = tv.selectedLane - visibleLanes + 1
 	}
@@ -702,6 +833,16 @@ func (tv *TimelineView) selectLast() {
 	}
 }
 
+// selectPage moves the lane selection by one screen of lanes.
+func (tv *TimelineView) selectPage(dir int) {
+	_, _, _, height := tv.GetInnerRect()
+	visibleLanes := height - timelineHeaderRows - 1
+	if visibleLanes < 1 {
+		visibleLanes = 1
+	}
+	tv.moveSelection(dir * visibleLanes)
+}
+
 // scroll horizontally scrolls the timeline.
 func (tv *TimelineView) scroll(delta int) {
 	tv.scrollX += delta
@@ -761,39 +902,6 @@ func (tv *TimelineView) HasFocus() bool {
 	return tv.Box.HasFocus()
 }
 
-// roundDuration rounds a duration up to a nice value.
-func roundDuration(d time.Duration) time.Duration {
-	if d <= 0 {
-		return 0
-	}
-
-	// Define rounding thresholds and their round-to values
-	type roundRule struct {
-		threshold time.Duration
-		roundTo   time.Duration
-	}
-
-	rules := []roundRule{
-		{100 * time.Millisecond, 10 * time.Millisecond},   // < 100ms: round to 10ms
-		{time.Second, 50 * time.Millisecond},              // < 1s: round to 50ms
-		{10 * time.Second, 500 * time.Millisecond},        // < 10s: round to 500ms
-		{time.Minute, time.Second},                        // < 1m: round to 1s
-		{10 * time.Minute, 10 * time.Second},              // < 10m: round to 10s
-		{time.Hour, time.Minute},                          // < 1h: round to 1m
-		{24 * time.Hour, 10 * time.Minute},                // < 24h: round to 10m
-	}
-
-	for _, rule := range rules {
-		if d < rule.threshold {
-			// Round up to nearest roundTo
-			return ((d + rule.roundTo - 1) / rule.roundTo) * rule.roundTo
-		}
-	}
-
-	// For very long durations, round to nearest hour
-	return ((d + time.Hour - 1) / time.Hour) * time.Hour
-}
-
 // formatRelativeDuration formats a duration as a relative time string.
 func formatRelativeDuration(d time.Duration) string {
 	if d == 0 {
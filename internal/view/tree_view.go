@@ -2,8 +2,10 @@ package view
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -17,6 +19,8 @@ type EventTreeView struct {
 	onSelect     func(node *temporal.EventTreeNode)
 	onSelChange  func(node *temporal.EventTreeNode)
 	selectedNode *temporal.EventTreeNode
+	slowDuration time.Duration // Fixed slow end of the duration scale, 0 to derive it
+	heat         durationHeat
 }
 
 // NewEventTreeView creates a new tree view for displaying workflow events.
@@ -80,10 +84,29 @@ func (etv *EventTreeView) Draw(screen tcell.Screen) {
 	etv.TreeView.Draw(screen)
 }
 
+// SetSlowDuration fixes the duration colored as slowest. Zero derives it
+// from each workflow's own durations.
+func (etv *EventTreeView) SetSlowDuration(d time.Duration) {
+	etv.slowDuration = d
+}
+
 // SetNodes populates the tree with event nodes.
 func (etv *EventTreeView) SetNodes(nodes []*temporal.EventTreeNode) {
+	// Carry the selection and collapsed state over to nodes that survive a
+	// reload, so refreshing a running workflow does not reset the tree
+	selectedKey := treeNodeKey(etv.selectedNode)
+	collapsed := make(map[int64]bool)
+	etv.walkNodes(etv.root, func(tn *tview.TreeNode) {
+		if node, ok := tn.GetReference().(*temporal.EventTreeNode); ok && treeNodeKey(node) != 0 {
+			collapsed[treeNodeKey(node)] = node.Collapsed
+		}
+	})
+	restoreCollapsed(nodes, collapsed)
+
 	etv.nodes = nodes
+	etv.heat = newDurationHeat(nodes, etv.slowDuration)
 	etv.root.ClearChildren()
+	etv.selectedNode = nil
 
 	for _, node := range nodes {
 		treeNode := etv.createTreeNode(node, 0)
@@ -93,11 +116,44 @@ func (etv *EventTreeView) SetNodes(nodes []*temporal.EventTreeNode) {
 	// Expand root by default
 	etv.root.SetExpanded(true)
 
-	// Select first node if available
-	if len(nodes) > 0 {
+	// Reselect the previously selected node, otherwise the first node
+	var selected *tview.TreeNode
+	if selectedKey != 0 {
+		etv.walkNodes(etv.root, func(tn *tview.TreeNode) {
+			if node, ok := tn.GetReference().(*temporal.EventTreeNode); ok && selected == nil && treeNodeKey(node) == selectedKey {
+				selected = tn
+			}
+		})
+	}
+	if selected == nil {
 		if children := etv.root.GetChildren(); len(children) > 0 {
-			etv.SetCurrentNode(children[0])
+			selected = children[0]
+		}
+	}
+	if selected != nil {
+		etv.expandParentsOf(selected)
+		etv.SetCurrentNode(selected)
+		etv.selectedNode, _ = selected.GetReference().(*temporal.EventTreeNode)
+	}
+}
+
+// treeNodeKey identifies a node across rebuilds of the tree by its first
+// event, or returns 0 for a node without events.
+func treeNodeKey(node *temporal.EventTreeNode) int64 {
+	if node == nil || len(node.Events) == 0 {
+		return 0
+	}
+	return node.Events[0].ID
+}
+
+// restoreCollapsed applies previously recorded collapsed states, keyed by
+// treeNodeKey, to nodes and their children.
+func restoreCollapsed(nodes []*temporal.EventTreeNode, collapsed map[int64]bool) {
+	for _, node := range nodes {
+		if c, ok := collapsed[treeNodeKey(node)]; ok && treeNodeKey(node) != 0 {
+			node.Collapsed = c
 		}
+		restoreCollapsed(node.Children, collapsed)
 	}
 }
 
@@ -128,15 +184,24 @@ func (etv *EventTreeView) formatNodeText(node *temporal.EventTreeNode) string {
 	icon := etv.statusIcon(node.Status)
 	name := node.Name
 
-	// Add duration if completed
+	// Add duration if completed, colored by how slow it is for this workflow
 	var suffix string
 	if node.EndTime != nil && node.Duration > 0 {
-		suffix = fmt.Sprintf(" %s", temporal.FormatDuration(node.Duration))
+		if etv.heat.enabled() && heatedNode(node) {
+			suffix = " " + etv.heat.colorTag(node.Duration)
+		} else {
+			suffix = fmt.Sprintf(" %s", temporal.FormatDuration(node.Duration))
+		}
 	}
 
-	// Add attempt count if multiple attempts
+	// Badge retried activities with their attempt count
 	if node.Attempts > 1 {
-		suffix = fmt.Sprintf(" %d attempts", node.Attempts)
+		suffix += fmt.Sprintf(" ×%d attempts", node.Attempts)
+	}
+
+	// Preview a completed child's result so data flow is visible without opening it
+	if ev := childResultEvent(node); ev != nil {
+		suffix += fmt.Sprintf(" → %s", tview.Escape(truncate(ev.Result, childResultPreviewLen)))
 	}
 
 	// Add status tag
@@ -145,32 +210,54 @@ func (etv *EventTreeView) formatNodeText(node *temporal.EventTreeNode) string {
 	return fmt.Sprintf("%s %s %s%s", icon, name, statusTag, suffix)
 }
 
+// childResultPreviewLen caps the inline child result shown in the tree.
+const childResultPreviewLen = 40
+
+// childResultEvent returns the completion event of a child workflow node if the
+// child returned a result.
+func childResultEvent(node *temporal.EventTreeNode) *temporal.EnhancedHistoryEvent {
+	if node.Type != temporal.GroupChildWorkflow {
+		return nil
+	}
+	for _, ev := range node.Events {
+		if ev.Type == "ChildWorkflowExecutionCompleted" && ev.Result != "" {
+			return ev
+		}
+	}
+	return nil
+}
+
 // statusIcon returns the icon for a node status.
 func (etv *EventTreeView) statusIcon(status string) string {
 	switch status {
 	case "Running":
-		return theme.IconRunning
+		return icons.Running
 	case "Completed":
-		return theme.IconCompleted
-	case "Failed":
-		return theme.IconFailed
+		return icons.Completed
+	case "Failed", "Rejected":
+		return icons.Failed
 	case "Canceled":
-		return theme.IconCanceled
+		return icons.Canceled
 	case "Terminated":
-		return theme.IconTerminated
+		return icons.Terminated
 	case "TimedOut":
-		return theme.IconTimedOut
+		return icons.TimedOut
+	case "ContinuedAsNew":
+		return icons.ArrowRight
 	case "Fired":
-		return theme.IconCompleted
-	case "Scheduled", "Initiated", "Pending":
-		return theme.IconPending
+		return icons.Completed
+	case "Scheduled", "Initiated", "Pending", "Admitted":
+		return icons.Pending
 	default:
-		return theme.IconEvent
+		return icons.Event
 	}
 }
 
 // statusColor returns the color for a node status.
 func (etv *EventTreeView) statusColor(status string) tcell.Color {
+	if status == "Rejected" {
+		return temporal.StatusFailed.Color()
+	}
 	return temporal.GetWorkflowStatus(status).Color()
 }
 
@@ -231,28 +318,37 @@ func (etv *EventTreeView) CollapseAll() {
 	}
 }
 
-// JumpToFailed finds and selects the first failed node.
-func (etv *EventTreeView) JumpToFailed() bool {
-	var failedNode *tview.TreeNode
+// JumpToFailure selects the next (dir > 0) or previous (dir < 0) failed
+// node after the current one, wrapping around at either end and expanding
+// collapsed parents to show it. It returns false if no node failed.
+func (etv *EventTreeView) JumpToFailure(dir int) bool {
+	var nodes []*tview.TreeNode
+	current := -1 // Without a selection, start from either end
+	if dir < 0 {
+		current = 0
+	}
 	etv.walkNodes(etv.root, func(node *tview.TreeNode) {
-		if failedNode != nil {
-			return
+		if node == etv.GetCurrentNode() {
+			current = len(nodes)
 		}
-		ref := node.GetReference()
-		if eventNode, ok := ref.(*temporal.EventTreeNode); ok {
-			if eventNode.Status == "Failed" || eventNode.Status == "TimedOut" {
-				failedNode = node
+		if eventNode, ok := node.GetReference().(*temporal.EventTreeNode); ok && isFailureStatus(eventNode.Status) {
+			nodes = append(nodes, node)
+		} else if node == etv.GetCurrentNode() {
+			// Searching starts between the failures around the current node
+			current = len(nodes) - 1
+			if dir < 0 {
+				current = len(nodes)
 			}
 		}
 	})
-
-	if failedNode != nil {
-		// Expand parent nodes to make it visible
-		etv.expandParentsOf(failedNode)
-		etv.SetCurrentNode(failedNode)
-		return true
+	if len(nodes) == 0 {
+		return false
 	}
-	return false
+
+	next := ((current+dir)%len(nodes) + len(nodes)) % len(nodes)
+	etv.expandParentsOf(nodes[next])
+	etv.selectTreeNode(nodes[next])
+	return true
 }
 
 // expandParentsOf expands all parent nodes of the given node.
@@ -293,7 +389,7 @@ func (etv *EventTreeView) NodeCount() int {
 func (etv *EventTreeView) JumpToFirst() {
 	children := etv.root.GetChildren()
 	if len(children) > 0 {
-		etv.SetCurrentNode(children[0])
+		etv.selectTreeNode(children[0])
 	}
 }
 
@@ -301,7 +397,34 @@ func (etv *EventTreeView) JumpToFirst() {
 func (etv *EventTreeView) JumpToLast() {
 	node := etv.findLastVisible(etv.root)
 	if node != nil && node != etv.root {
-		etv.SetCurrentNode(node)
+		etv.selectTreeNode(node)
+	}
+}
+
+// selectTreeNode makes node current and notifies the selection-change handler
+// immediately, rather than waiting for the next draw.
+func (etv *EventTreeView) selectTreeNode(node *tview.TreeNode) {
+	etv.SetCurrentNode(node)
+	if eventNode, ok := node.GetReference().(*temporal.EventTreeNode); ok {
+		etv.selectedNode = eventNode
+		if etv.onSelChange != nil {
+			etv.onSelChange(eventNode)
+		}
+	}
+}
+
+// edgeNavigator implementation. The synthetic root node is never selected.
+func (etv *EventTreeView) selectFirst() { etv.JumpToFirst() }
+func (etv *EventTreeView) selectLast()  { etv.JumpToLast() }
+
+func (etv *EventTreeView) selectPage(dir int) {
+	_, _, _, height := etv.GetInnerRect()
+	if height < 1 {
+		height = 1
+	}
+	etv.Move(dir * height)
+	if etv.GetCurrentNode() == etv.root {
+		etv.JumpToFirst()
 	}
 }
 
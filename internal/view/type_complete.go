@@ -0,0 +1,270 @@
+package view
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// typeSuggestionLimit caps how many completions are listed under a field.
+const typeSuggestionLimit = 5
+
+// workflowTypeCache remembers the workflow types seen in each namespace so
+// forms can offer them as completions.
+type workflowTypeCache struct {
+	mu    sync.Mutex
+	types map[string]map[string]struct{}
+}
+
+// record adds the types of the given workflows to a namespace's set.
+func (c *workflowTypeCache) record(namespace string, workflows []temporal.Workflow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.types == nil {
+		c.types = make(map[string]map[string]struct{})
+	}
+	seen, ok := c.types[namespace]
+	if !ok {
+		seen = make(map[string]struct{})
+		c.types[namespace] = seen
+	}
+	for _, wf := range workflows {
+		if wf.Type != "" {
+			seen[wf.Type] = struct{}{}
+		}
+	}
+}
+
+// list returns the known types for a namespace, sorted.
+func (c *workflowTypeCache) list(namespace string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	types := make([]string, 0, len(c.types[namespace]))
+	for t := range c.types[namespace] {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// RecordWorkflowTypes remembers the workflow types in a loaded list for completion.
+func (a *App) RecordWorkflowTypes(namespace string, workflows []temporal.Workflow) {
+	a.workflowTypes.record(namespace, workflows)
+}
+
+// KnownWorkflowTypes returns the workflow types seen so far in a namespace.
+func (a *App) KnownWorkflowTypes(namespace string) []string {
+	return a.workflowTypes.list(namespace)
+}
+
+// matchWorkflowTypes returns the types matching partial, case-insensitively.
+// Prefix matches come before substring matches.
+func matchWorkflowTypes(types []string, partial string) []string {
+	needle := strings.ToLower(partial)
+	var prefix, contains []string
+	for _, t := range types {
+		lower := strings.ToLower(t)
+		switch {
+		case strings.HasPrefix(lower, needle):
+			prefix = append(prefix, t)
+		case strings.Contains(lower, needle):
+			contains = append(contains, t)
+		}
+	}
+	return append(prefix, contains...)
+}
+
+// typeSplitter splits a field value into the text before the workflow type
+// being typed, the partial type itself, and the text to append after a
+// completed type. ok is false when the value is not at a workflow type.
+type typeSplitter func(value string) (head, partial, tail string, ok bool)
+
+// wholeValueType treats the entire field value as the workflow type.
+func wholeValueType(value string) (string, string, string, bool) {
+	return "", value, "", true
+}
+
+// queryTypePattern matches a WorkflowType comparison whose quoted value is still open.
+var queryTypePattern = regexp.MustCompile(`(?i)\bWorkflowType\s*(=|!=)\s*'([^']*)$`)
+
+// queryValueType completes the value of a WorkflowType comparison at the end of a query.
+func queryValueType(value string) (string, string, string, bool) {
+	m := queryTypePattern.FindStringSubmatch(value)
+	if m == nil {
+		return "", "", "", false
+	}
+	partial := m[2]
+	return value[:len(value)-len(partial)], partial, "'", true
+}
+
+// queryFieldPattern matches the identifier being typed at the end of a query.
+var queryFieldPattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*$`)
+
+// queryClauseStart matches query text that ends where a new clause begins:
+// the start of the query, an open parenthesis, or AND / OR / NOT.
+var queryClauseStart = regexp.MustCompile(`(?i)(^|\(|\b(AND|OR|NOT))\s*$`)
+
+// queryFieldName completes the search attribute on the left-hand side of the
+// clause being typed at the end of a query.
+func queryFieldName(value string) (string, string, string, bool) {
+	partial := queryFieldPattern.FindString(value)
+	head := value[:len(value)-len(partial)]
+	if !queryClauseStart.MatchString(head) {
+		return "", "", "", false
+	}
+	return head, partial, " ", true
+}
+
+// completionSource is one kind of completion a field offers, such as
+// workflow types or search attribute names.
+type completionSource struct {
+	items []string
+	split typeSplitter
+	noun  string // What the items are, for the empty messages
+}
+
+// typeCompleter lists completions under a form text field and fills the
+// field with the next or previous match on Ctrl+N / Ctrl+P. The first source
+// whose splitter accepts the field value supplies the matches.
+type typeCompleter struct {
+	field   *components.TextField
+	sources []completionSource
+	active  int // Index of the source in use, -1 if none
+	list    *tview.TextView
+	matches []string
+	index   int
+	head    string
+	tail    string
+}
+
+// attachTypeCompleter wires workflow type completion into a form field and
+// returns the one-row suggestion list to place under the form.
+func attachTypeCompleter(form *components.Form, fieldName string, types []string, split typeSplitter) *tview.TextView {
+	return attachCompleter(form, fieldName, completionSource{items: types, split: split, noun: "workflow type"}).list
+}
+
+// attachCompleter wires completion from the given sources into a form field.
+// The returned completer's list is the one-row suggestion list to place under
+// the form.
+func attachCompleter(form *components.Form, fieldName string, sources ...completionSource) *typeCompleter {
+	list := tview.NewTextView().SetDynamicColors(true).SetWrap(false)
+	list.SetBackgroundColor(theme.Bg())
+
+	tc := &typeCompleter{sources: sources, active: -1, list: list}
+	field, ok := form.GetTextField(fieldName)
+	if !ok {
+		return tc
+	}
+
+	tc.field = field
+	field.SetOnChange(func(e *components.ChangeEvent[string]) {
+		tc.update(e.NewValue)
+	})
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !field.HasFocus() {
+			return event
+		}
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			tc.cycle(1)
+			return nil
+		case tcell.KeyCtrlP:
+			tc.cycle(-1)
+			return nil
+		}
+		return event
+	})
+	tc.update(field.GetValue())
+	return tc
+}
+
+// setItems replaces a source's items, for completions loaded after the form
+// opens, and refreshes the matches.
+func (tc *typeCompleter) setItems(source int, items []string) {
+	if source < 0 || source >= len(tc.sources) {
+		return
+	}
+	tc.sources[source].items = items
+	if tc.field != nil {
+		tc.update(tc.field.GetValue())
+	}
+}
+
+// update recomputes the matches for the field's current value.
+func (tc *typeCompleter) update(value string) {
+	tc.matches = nil
+	tc.index = -1
+	tc.active = -1
+	for i, src := range tc.sources {
+		head, partial, tail, ok := src.split(value)
+		if !ok {
+			continue
+		}
+		tc.active = i
+		tc.head, tc.tail = head, tail
+		tc.matches = matchWorkflowTypes(src.items, partial)
+		break
+	}
+	tc.render()
+}
+
+// cycle fills the field with the next (dir > 0) or previous match.
+func (tc *typeCompleter) cycle(dir int) {
+	n := len(tc.matches)
+	if n == 0 {
+		return
+	}
+	if tc.index < 0 && dir < 0 {
+		tc.index = n - 1
+	} else {
+		tc.index = ((tc.index+dir)%n + n) % n
+	}
+	tc.field.SetValue(tc.head + tc.matches[tc.index] + tc.tail)
+	tc.render()
+}
+
+// render draws the matches, highlighting the one currently filled in.
+func (tc *typeCompleter) render() {
+	if tc.active < 0 {
+		tc.list.SetText("")
+		return
+	}
+	src := tc.sources[tc.active]
+	switch {
+	case len(src.items) == 0:
+		tc.list.SetText(fmt.Sprintf(" [%s]No %ss seen yet in this namespace[-]", theme.TagFgDim(), src.noun))
+		return
+	case len(tc.matches) == 0:
+		tc.list.SetText(fmt.Sprintf(" [%s]No known %s matches[-]", theme.TagFgDim(), src.noun))
+		return
+	}
+
+	// Keep the filled-in match visible when cycling past the limit.
+	start := 0
+	if tc.index >= typeSuggestionLimit {
+		start = tc.index - typeSuggestionLimit + 1
+	}
+	end := min(start+typeSuggestionLimit, len(tc.matches))
+
+	var sb strings.Builder
+	for i := start; i < end; i++ {
+		name := tview.Escape(tc.matches[i])
+		if i == tc.index {
+			sb.WriteString(fmt.Sprintf(" [%s::r] %s [-::-]", theme.TagAccent(), name))
+		} else {
+			sb.WriteString(fmt.Sprintf(" [%s] %s [-]", theme.TagFg(), name))
+		}
+	}
+	if rest := len(tc.matches) - end; rest > 0 {
+		sb.WriteString(fmt.Sprintf(" [%s]+%d more[-]", theme.TagFgDim(), rest))
+	}
+	tc.list.SetText(sb.String())
+}
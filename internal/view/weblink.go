@@ -0,0 +1,35 @@
+package view
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WorkflowWebURL returns the Web UI address of a workflow run, or "" when the
+// active profile has no web_ui configured.
+func (a *App) WorkflowWebURL(namespace, workflowID, runID string) string {
+	if a.config == nil {
+		return ""
+	}
+	profile, ok := a.config.GetProfile(a.activeProfile)
+	if !ok || profile.WebUI == "" {
+		return ""
+	}
+	base := strings.TrimRight(profile.WebUI, "/")
+	link := fmt.Sprintf("%s/namespaces/%s/workflows/%s", base, url.PathEscape(namespace), url.PathEscape(workflowID))
+	if runID != "" {
+		link += "/" + url.PathEscape(runID)
+	}
+	return link + "/history"
+}
+
+// hyperlink wraps already-escaped text in an OSC 8 hyperlink tag. Terminals
+// without hyperlink support show the text alone; an empty link or one the tag
+// syntax cannot carry leaves the text untouched.
+func hyperlink(text, link string) string {
+	if link == "" || strings.Contains(link, "]") {
+		return text
+	}
+	return fmt.Sprintf("[:::%s]%s[:::-]", link, text)
+}
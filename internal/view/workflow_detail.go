@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -19,28 +21,49 @@ import (
 // WorkflowDetail displays detailed information about a workflow with events.
 type WorkflowDetail struct {
 	*tview.Flex
+	freshness
 	app              *App
 	workflowID       string
 	runID            string
 	workflow         *temporal.Workflow
 	allEvents        []temporal.EnhancedHistoryEvent // Full unfiltered list
 	events           []temporal.EnhancedHistoryEvent // Filtered list for display
+	body             *tview.Flex
 	leftFlex         *tview.Flex
+	outcomeView      *tview.TextView // Result or failure banner for closed runs
 	workflowPanel    *components.Panel
 	eventDetailPanel *components.Panel
 	eventsPanel      *components.Panel
+	pendingPanel     *components.Panel // Hidden while the run is waiting on nothing
 	workflowView     *tview.TextView
+	pendingView      *tview.TextView
 	eventDetailView  *tview.TextView
 	eventTable       *components.Table
 	loading          bool
-	searchText       string // Current search filter text
-	baseEventsTitle  string // Base title without search suffix
+	searchText       string        // Current search filter text
+	baseEventsTitle  string        // Base title without search suffix
+	countdownStop    chan struct{} // Stops the pending activity retry countdown
+	runSummary       string        // One-line narrative derived from the event tree
+	taskLatency      temporal.WorkflowTaskLatency
+	historyLimit     int  // Load only this many recent events (0 = all)
+	historyChosen    bool // The large history prompt was answered
+	inputMissing     bool // Recent history left out the start event and it could not be fetched
+
+	historyAttrs  []temporal.UpsertedSearchAttribute // Latest search attributes replayed from history
+	idleTaskQueue string                             // Task queue with no recent pollers, shown as a warning
+
+	runChain         []temporal.Workflow // Runs of this workflow ID, oldest first
+	runIndex         int                 // This run's index in runChain, -1 if not listed
+	runChainComplete bool                // runChain was not cut off at runChainLimit
+	checkedRun       string              // Run ID and status the run chain and worker check were loaded for
+
+	refresher autoRefresher // Re-polls a running workflow while on
 }
 
 // NewWorkflowDetail creates a new workflow detail view.
 func NewWorkflowDetail(app *App, workflowID, runID string) *WorkflowDetail {
 	wd := &WorkflowDetail{
-		Flex:       tview.NewFlex().SetDirection(tview.FlexColumn),
+		Flex:       tview.NewFlex().SetDirection(tview.FlexRow),
 		app:        app,
 		workflowID: workflowID,
 		runID:      runID,
@@ -69,31 +92,53 @@ func (wd *WorkflowDetail) setup() {
 		SetTextAlign(tview.AlignLeft)
 	wd.eventDetailView.SetBackgroundColor(theme.Bg())
 
+	// Pending work view
+	wd.pendingView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft).
+		SetWordWrap(true)
+	wd.pendingView.SetBackgroundColor(theme.Bg())
+
 	// Event table
 	wd.eventTable.SetHeaders("ID", "TIME", "TYPE", "NAME")
 	wd.eventTable.SetBorder(false)
 	wd.eventTable.SetBackgroundColor(theme.Bg())
 
 	// Create panels with icons (blubber pattern)
-	wd.workflowPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow", theme.IconWorkflow))
+	wd.workflowPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow", icons.Workflow))
 	wd.workflowPanel.SetContent(wd.workflowView)
 
-	wd.eventDetailPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Event Detail", theme.IconInfo))
+	wd.eventDetailPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Event Detail", icons.Info))
 	wd.eventDetailPanel.SetContent(wd.eventDetailView)
 
-	wd.baseEventsTitle = fmt.Sprintf("%s Events", theme.IconEvent)
+	wd.pendingPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Pending", icons.Pending))
+	wd.pendingPanel.SetContent(wd.pendingView)
+
+	wd.baseEventsTitle = fmt.Sprintf("%s Events", icons.Event)
 	wd.eventsPanel = components.NewPanel().SetTitle(wd.baseEventsTitle)
 	wd.eventsPanel.SetContent(wd.eventTable)
 
-	// Left side: workflow info + event detail stacked
+	// Left side: workflow info, pending work, and event detail stacked
 	wd.leftFlex = tview.NewFlex().SetDirection(tview.FlexRow)
 	wd.leftFlex.SetBackgroundColor(theme.Bg())
 	wd.leftFlex.AddItem(wd.workflowPanel, 0, 1, false)
+	wd.leftFlex.AddItem(wd.pendingPanel, 0, 0, false)
 	wd.leftFlex.AddItem(wd.eventDetailPanel, 0, 1, false)
 
-	// Main layout: left stack + right events
-	wd.AddItem(wd.leftFlex, 0, 2, false)
-	wd.AddItem(wd.eventsPanel, 0, 3, true)
+	// Body: left stack + right events
+	wd.body = tview.NewFlex().SetDirection(tview.FlexColumn)
+	wd.body.SetBackgroundColor(theme.Bg())
+	wd.body.AddItem(wd.leftFlex, 0, 2, false)
+	wd.body.AddItem(wd.eventsPanel, 0, 3, true)
+
+	// Outcome banner above the body, hidden until the run is known to be closed
+	wd.outcomeView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	wd.outcomeView.SetBackgroundColor(theme.Bg())
+
+	wd.AddItem(wd.outcomeView, 0, 0, false)
+	wd.AddItem(wd.body, 0, 1, true)
 
 	// Update event detail when selection changes
 	wd.eventTable.SetSelectionChangedFunc(func(row, col int) {
@@ -136,11 +181,14 @@ func (wd *WorkflowDetail) applyFilter(query string) {
 }
 
 func (wd *WorkflowDetail) updateEventsTitle() {
-	if wd.searchText == "" {
-		wd.eventsPanel.SetTitle(wd.baseEventsTitle)
-	} else {
-		wd.eventsPanel.SetTitle(wd.baseEventsTitle + " (/" + wd.searchText + ")")
+	title := wd.baseEventsTitle
+	if wd.historyLimit > 0 && wd.workflow != nil {
+		title += fmt.Sprintf(" (last %d of %d)", len(wd.allEvents), wd.workflow.HistoryLength)
 	}
+	if wd.searchText != "" {
+		title += " (/" + wd.searchText + ")"
+	}
+	wd.eventsPanel.SetTitle(title)
 }
 
 func (wd *WorkflowDetail) showSearch() {
@@ -168,16 +216,21 @@ func (wd *WorkflowDetail) RefreshTheme() {
 	wd.workflowView.SetTextColor(fg)
 	wd.eventDetailView.SetBackgroundColor(bg)
 	wd.eventDetailView.SetTextColor(fg)
+	wd.pendingView.SetBackgroundColor(bg)
+	wd.pendingView.SetTextColor(fg)
 
 	// Update table
 	wd.eventTable.SetBackgroundColor(bg)
 
 	// Update flex containers
+	wd.body.SetBackgroundColor(bg)
 	wd.leftFlex.SetBackgroundColor(bg)
+	wd.outcomeView.SetBackgroundColor(bg)
 
 	// Re-render content with new theme colors
 	wd.render()
 	wd.populateEventTable()
+	wd.updateOutcome()
 }
 
 func (wd *WorkflowDetail) loadData() {
@@ -217,41 +270,112 @@ func (wd *WorkflowDetail) loadData() {
 		}
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
+			// Pin an unspecified run to the latest one so history and
+			// actions all target the run being shown
+			if wd.runID == "" {
+				wd.runID = workflow.RunID
+			}
 			wd.workflow = workflow
+			if wd.refresher.active() && workflow.Status != "Running" {
+				wd.refresher.stop()
+				wd.app.ToastSuccess(fmt.Sprintf("Workflow %s, auto-refresh stopped", workflow.Status))
+			}
 			wd.render()
-			wd.app.JigApp().Menu().SetHints(wd.Hints())
+			wd.startRetryCountdown()
+			// The run chain and idle worker check only change with the run or
+			// how it closed, so auto-refresh ticks do not repeat them
+			if checked := workflow.RunID + "/" + workflow.Status; checked != wd.checkedRun {
+				wd.checkedRun = checked
+				wd.checkWorkers(provider, namespace)
+				wd.loadRunChain(provider, namespace)
+			}
+			wd.app.setFooterHints(wd.Hints())
+
+			// Step 2: Load events, asking first if the history is large
+			if !wd.historyChosen && wd.app.isLargeHistory(workflow) {
+				wd.app.showLargeHistoryPrompt(workflow, func(limit int) {
+					wd.historyChosen = true
+					wd.historyLimit = limit
+					go wd.loadEvents(provider, namespace, limit)
+				}, func() {
+					wd.setLoading(false)
+					wd.eventsPanel.SetTitle(fmt.Sprintf("%s (not loaded: %s, press r to choose)", wd.baseEventsTitle, formatHistorySize(workflow)))
+				})
+				return
+			}
+			go wd.loadEvents(provider, namespace, wd.historyLimit)
 		})
+	}()
+}
 
-		// Step 2: Load events after workflow succeeds (with retry)
-		var events []temporal.EnhancedHistoryEvent
-		for attempt := 0; attempt < 3; attempt++ {
-			if attempt > 0 {
-				time.Sleep(time.Duration(250<<attempt) * time.Millisecond)
-			}
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+// loadEvents fetches the history, or only its most recent events when a
+// limit was chosen for a large history. Call it from a goroutine.
+func (wd *WorkflowDetail) loadEvents(provider temporal.Provider, namespace string, limit int) {
+	// Load events with retry
+	var events []temporal.EnhancedHistoryEvent
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(250<<attempt) * time.Millisecond)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if limit > 0 {
+			events, err = provider.GetRecentWorkflowHistory(ctx, namespace, wd.workflowID, wd.runID, limit)
+		} else {
 			events, err = provider.GetEnhancedWorkflowHistory(ctx, namespace, wd.workflowID, wd.runID)
-			cancel()
-			if err == nil {
-				break
-			}
 		}
+		cancel()
+		if err == nil {
+			break
+		}
+	}
 
-		wd.app.JigApp().QueueUpdateDraw(func() {
-			wd.setLoading(false)
-			if err != nil {
-				// Show workflow info even if events fail
-				return
-			}
-			wd.allEvents = events
-			wd.events = events
-			wd.populateEventTable()
+	// Recent events leave out the start event, which holds the input that
+	// re-running the workflow resends
+	var start *temporal.EnhancedHistoryEvent
+	hasStart := len(events) > 0 && events[0].ID == 1
+	if err == nil && !hasStart {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		start, _ = provider.GetWorkflowStartEvent(ctx, namespace, wd.workflowID, wd.runID)
+		cancel()
+	}
 
-			// Extract input/output from events
-			if wd.workflow != nil {
-				wd.extractWorkflowIO()
+	// Upserts in the skipped events still count toward the latest search
+	// attributes, so read those from the whole history
+	var attrs []temporal.UpsertedSearchAttribute
+	if err == nil {
+		if hasStart {
+			attrs = temporal.LatestSearchAttributes(events)
+		} else {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			attrs, _ = provider.GetSearchAttributes(ctx, namespace, wd.workflowID, wd.runID)
+			cancel()
+		}
+	}
+
+	wd.app.JigApp().QueueUpdateDraw(func() {
+		wd.setLoading(false)
+		if err != nil {
+			// Show workflow info even if events fail
+			return
+		}
+		wd.allEvents = events
+		wd.inputMissing = !hasStart && start == nil
+		wd.historyAttrs = attrs
+		wd.markLoaded()
+		wd.applyFilter(wd.searchText) // Keep an active search across refreshes
+		wd.updateRunSummary()
+
+		// Extract input/output from events
+		if wd.workflow != nil {
+			wd.extractWorkflowIO()
+			if start != nil && start.Input != "" {
+				wd.workflow.Input = start.Input
 			}
-		})
-	}()
+		}
+		// Hints depend on history (e.g. Remediate)
+		wd.app.setFooterHints(wd.Hints())
+	})
 }
 
 // extractWorkflowIO extracts input/output from the loaded events and updates the workflow.
@@ -306,7 +430,8 @@ func (wd *WorkflowDetail) loadMockData() {
 		{ID: 7, Type: "ActivityTaskCompleted", Time: now.Add(-3 * time.Minute), Details: "ScheduledEventId: 5, Result: {success: true}", ActivityType: "MockActivity", ScheduledEventID: 5},
 	}
 	wd.events = wd.allEvents
-	wd.render()
+	wd.historyAttrs = temporal.LatestSearchAttributes(wd.allEvents)
+	wd.updateRunSummary()
 	wd.populateEventTable()
 }
 
@@ -334,6 +459,14 @@ func (wd *WorkflowDetail) render() {
 		durationStr = time.Since(w.StartTime).Round(time.Second).String()
 	}
 
+	link := wd.app.WorkflowWebURL(wd.app.CurrentNamespace(), w.ID, w.RunID)
+
+	// Show who terminated or cancelled the workflow next to its status
+	closedBy := ""
+	if by := temporal.ClosedBy(wd.allEvents, w.Status); by != "" {
+		closedBy = " by " + tview.Escape(by)
+	}
+
 	// Combined workflow info
 	workflowText := fmt.Sprintf(`
 [%s::b]ID[-:-:-]           [%s]%s[-]
@@ -343,15 +476,100 @@ func (wd *WorkflowDetail) render() {
 [%s::b]Duration[-:-:-]     [%s]%s[-]
 [%s::b]Task Queue[-:-:-]   [%s]%s[-]
 [%s::b]Run ID[-:-:-]       [%s]%s[-]`,
-		theme.TagFgDim(), theme.TagFg(), w.ID,
+		theme.TagFgDim(), theme.TagFg(), hyperlink(w.ID, link),
 		theme.TagFgDim(), theme.TagFg(), w.Type,
-		theme.TagFgDim(), statusColor, statusIcon, w.Status,
+		theme.TagFgDim(), statusColor, statusIcon, w.Status+closedBy,
 		theme.TagFgDim(), theme.TagFg(), formatRelativeTime(now, w.StartTime),
 		theme.TagFgDim(), theme.TagFg(), durationStr,
 		theme.TagFgDim(), theme.TagFg(), w.TaskQueue,
-		theme.TagFgDim(), theme.TagFgDim(), truncateStr(w.RunID, 25),
+		theme.TagFgDim(), theme.TagFgDim(), hyperlink(truncateStr(w.RunID, 25), link),
 	)
+	if newRunID := wd.continuedAsNewRunID(); newRunID != "" {
+		newLink := wd.app.WorkflowWebURL(wd.app.CurrentNamespace(), w.ID, newRunID)
+		workflowText += fmt.Sprintf("\n[%s::b]Next Run[-:-:-]     [%s]%s %s[-] [%s](C to open)[-]",
+			theme.TagFgDim(), statusColor, icons.ArrowRight, hyperlink(truncateStr(newRunID, 25), newLink), theme.TagFgDim())
+	}
+	workflowText += wd.formatRunChain()
+	workflowText += formatTaskLatency(wd.taskLatency)
+	if wd.runSummary != "" {
+		workflowText = fmt.Sprintf("\n[%s]%s[-]%s", theme.TagAccent(), tview.Escape(wd.runSummary), workflowText)
+	}
+	workflowText += formatSearchAttributes(w.SearchAttributes, wd.historyAttrs)
 	wd.workflowView.SetText(workflowText)
+	wd.renderPending(now)
+}
+
+// slowDispatchThreshold is the workflow task dispatch latency above which
+// workers are likely saturated.
+const slowDispatchThreshold = time.Second
+
+// formatTaskLatency renders workflow task dispatch and execution latency rows.
+func formatTaskLatency(lat temporal.WorkflowTaskLatency) string {
+	var sb strings.Builder
+	if lat.Dispatched > 0 {
+		color := theme.TagFg()
+		hint := ""
+		if lat.DispatchMax >= slowDispatchThreshold {
+			color = theme.TagWarning()
+			hint = " (workers saturated?)"
+		}
+		sb.WriteString(fmt.Sprintf("\n[%s::b]WFT Dispatch[-:-:-] [%s]avg %s / max %s%s[-]",
+			theme.TagFgDim(), color,
+			temporal.FormatDuration(lat.DispatchAvg), temporal.FormatDuration(lat.DispatchMax), hint))
+	}
+	if lat.Executed > 0 {
+		sb.WriteString(fmt.Sprintf("\n[%s::b]WFT Execute[-:-:-]  [%s]avg %s / max %s[-]",
+			theme.TagFgDim(), theme.TagFg(),
+			temporal.FormatDuration(lat.ExecutionAvg), temporal.FormatDuration(lat.ExecutionMax)))
+	}
+	return sb.String()
+}
+
+// formatSearchAttributes renders the search attributes section of the workflow panel.
+// Values the workflow upserted are taken from history, which is current even
+// when visibility has not caught up, and are marked with the upsert event.
+func formatSearchAttributes(attrs map[string]string, history []temporal.UpsertedSearchAttribute) string {
+	values := make(map[string]string, len(attrs))
+	for name, value := range attrs {
+		values[name] = value
+	}
+	upserts := make(map[string]int64)
+	for _, attr := range history {
+		if attr.Upserted {
+			values[attr.Name] = attr.Value
+			upserts[attr.Name] = attr.EventID
+		} else if _, ok := values[attr.Name]; !ok {
+			values[attr.Name] = attr.Value
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\n\n[%s::b]Search Attributes[-:-:-]", theme.TagFgDim()))
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("\n[%s]%s[-] [%s]%s[-]",
+			theme.TagFgDim(), tview.Escape(name), theme.TagFg(), tview.Escape(values[name])))
+		if id, ok := upserts[name]; ok {
+			sb.WriteString(fmt.Sprintf(" [%s](upserted #%d)[-]", theme.TagFgDim(), id))
+		}
+	}
+	return sb.String()
+}
+
+// updateRunSummary recomputes the run narrative from the full event history and re-renders.
+func (wd *WorkflowDetail) updateRunSummary() {
+	wd.runSummary = temporal.SummarizeEventTree(temporal.BuildEventTree(wd.allEvents), time.Now())
+	wd.taskLatency = temporal.ComputeWorkflowTaskLatency(wd.allEvents)
+	wd.render()
+	wd.updateOutcome()
 }
 
 func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
@@ -359,7 +577,7 @@ func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
 	colorTag := eventColorTag(ev.Type)
 
 	// Parse and format the details string
-	formattedDetails := formatEventDetails(ev.Details)
+	formattedDetails := formatEventDetails(ev.Details, false, "press d to open the event")
 
 	// Build name line if applicable
 	var nameLine string
@@ -367,15 +585,18 @@ func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
 	if name != "" {
 		nameLine = fmt.Sprintf("\n[%s::b]Name[-:-:-]         [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), name)
 	}
+	if by := temporal.ActionBy(&ev); by != "" {
+		nameLine += fmt.Sprintf("\n[%s::b]By[-:-:-]           [%s]%s[-]", theme.TagFgDim(), theme.TagWarning(), tview.Escape(by))
+	}
 
 	detailText := fmt.Sprintf(`
 [%s::b]Event ID[-:-:-]     [%s]%d[-]
-[%s::b]Type[-:-:-]         [%s]%s %s[-]%s
+[%s::b]Type[-:-:-]         [%s]%s %s[-]%s%s
 [%s::b]Time[-:-:-]         [%s]%s[-]
 
 %s%s`,
 		theme.TagFgDim(), theme.TagFg(), ev.ID,
-		theme.TagFgDim(), colorTag, icon, ev.Type, nameLine,
+		theme.TagFgDim(), colorTag, icon, ev.Type, eventExplanationLine(ev.Type, "              "), nameLine,
 		theme.TagFgDim(), theme.TagFg(), ev.Time.Format("2006-01-02 15:04:05.000"),
 		formattedDetails,
 		formatFailureSidePanel(&ev),
@@ -383,11 +604,25 @@ func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
 	wd.eventDetailView.SetText(detailText)
 }
 
+// toggleEventExplanations shows or hides the plain-language event type
+// explanation in the event panel.
+func (wd *WorkflowDetail) toggleEventExplanations() {
+	toggleExplainEvents()
+	if row := wd.eventTable.SelectedRow(); row >= 0 && row < len(wd.events) {
+		wd.updateEventDetail(wd.events[row])
+	}
+}
+
 // formatEventDetails parses event details and formats them with pretty JSON.
-func formatEventDetails(details string) string {
+// Details over the render limit are truncated unless full is set; action
+// tells the user how to see the rest.
+func formatEventDetails(details string, full bool, action string) string {
 	if details == "" {
 		return fmt.Sprintf("[%s]No details[-]", theme.TagFgDim())
 	}
+	if head, truncated := truncatePayload(details); truncated && !full {
+		return formatEventDetails(head, true, "") + payloadTruncatedNotice(len(details), action)
+	}
 
 	// First check if the whole thing is JSON
 	trimmed := strings.TrimSpace(details)
@@ -585,16 +820,25 @@ func highlightValuesWorkflow(s string) string {
 }
 
 func (wd *WorkflowDetail) populateEventTable() {
-	// Preserve current selection
+	// Preserve current selection, by event ID so it survives new events
 	currentRow := wd.eventTable.SelectedRow()
+	var selectedID string
+	if data := wd.eventTable.GetRowData(currentRow); len(data) > 0 {
+		selectedID = data[0]
+	}
 
 	wd.eventTable.ClearRows()
 	wd.eventTable.SetHeaders("ID", "TIME", "TYPE", "NAME")
 
+	sent := wd.app.SentOpEvents(wd.workflowID, wd.runID, wd.allEvents)
 	for _, ev := range wd.events {
 		icon := eventIcon(ev.Type)
 		color := eventColor(ev.Type)
 		name := getEventNameDetail(&ev)
+		if sent[ev.ID] {
+			color = theme.Accent()
+			name = strings.TrimSpace(name + " " + sentOpLabel)
+		}
 		wd.eventTable.AddRowWithColor(color,
 			fmt.Sprintf("%d", ev.ID),
 			ev.Time.Format("15:04:05"),
@@ -604,6 +848,12 @@ func (wd *WorkflowDetail) populateEventTable() {
 	}
 
 	if wd.eventTable.RowCount() > 0 {
+		for i, ev := range wd.events {
+			if fmt.Sprintf("%d", ev.ID) == selectedID {
+				currentRow = i
+				break
+			}
+		}
 		// Restore previous selection if valid, otherwise select first row
 		if currentRow >= 0 && currentRow < len(wd.events) {
 			wd.eventTable.SelectRow(currentRow)
@@ -617,6 +867,38 @@ func (wd *WorkflowDetail) populateEventTable() {
 	}
 }
 
+// isFailureEvent returns true for events that record a failure, timeout, or termination.
+func isFailureEvent(eventType string) bool {
+	return strings.Contains(eventType, "Failed") ||
+		strings.Contains(eventType, "TimedOut") ||
+		strings.Contains(eventType, "Terminated")
+}
+
+// jumpToFailure moves the event table cursor to the next (dir > 0) or previous
+// (dir < 0) failure event, wrapping around at either end.
+func (wd *WorkflowDetail) jumpToFailure(dir int) {
+	n := len(wd.events)
+	if n == 0 {
+		return
+	}
+	start := wd.eventTable.SelectedRow()
+	if start < 0 {
+		start = 0
+		if dir < 0 {
+			start = n - 1
+		}
+	}
+	for i := 1; i <= n; i++ {
+		row := ((start+dir*i)%n + n) % n
+		if isFailureEvent(wd.events[row].Type) {
+			wd.eventTable.SelectRow(row)
+			wd.updateEventDetail(wd.events[row])
+			return
+		}
+	}
+	wd.app.ToastWarning("No failure events")
+}
+
 // getEventNameDetail returns the activity type, timer ID, or child workflow type for an event.
 func getEventNameDetail(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ActivityType != "" {
@@ -628,6 +910,9 @@ func getEventNameDetail(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ChildWorkflowType != "" {
 		return ev.ChildWorkflowType
 	}
+	if ev.SignalName != "" {
+		return "Signal: " + ev.SignalName
+	}
 	return ""
 }
 
@@ -645,6 +930,11 @@ func (wd *WorkflowDetail) Name() string {
 	return "workflow-detail"
 }
 
+// Reload reloads the workflow and its history.
+func (wd *WorkflowDetail) Reload() {
+	wd.loadData()
+}
+
 // Start is called when the view becomes active.
 func (wd *WorkflowDetail) Start() {
 	bindings := input.NewKeyBindings().
@@ -656,12 +946,16 @@ func (wd *WorkflowDetail) Start() {
 			wd.loadData()
 			return true
 		}).
+		OnRune('a', func(e *tcell.EventKey) bool {
+			wd.toggleAutoRefresh()
+			return true
+		}).
 		OnRune('e', func(e *tcell.EventKey) bool {
-			wd.app.NavigateToEvents(wd.workflowID, wd.runID)
+			wd.app.NavigateToEvents(wd.workflowID, wd.runID, wd.historyLimit)
 			return true
 		}).
 		OnRune('y', func(e *tcell.EventKey) bool {
-			wd.yankEventData()
+			wd.showYankMenu()
 			return true
 		}).
 		OnRune('d', func(e *tcell.EventKey) bool {
@@ -673,13 +967,17 @@ func (wd *WorkflowDetail) Start() {
 			return true
 		}).
 		OnRune('X', func(e *tcell.EventKey) bool {
-			wd.showTerminateConfirm()
+			wd.showTerminatePreview()
 			return true
 		}).
 		OnRune('s', func(e *tcell.EventKey) bool {
 			wd.showSignalInput()
 			return true
 		}).
+		OnRune('u', func(e *tcell.EventKey) bool {
+			wd.showUpdateInput()
+			return true
+		}).
 		OnRune('D', func(e *tcell.EventKey) bool {
 			wd.showDeleteConfirm()
 			return true
@@ -688,10 +986,22 @@ func (wd *WorkflowDetail) Start() {
 			wd.showResetSelector()
 			return true
 		}).
+		OnRune('p', func(e *tcell.EventKey) bool {
+			wd.diffPreviousRun()
+			return true
+		}).
 		OnRune('Q', func(e *tcell.EventKey) bool {
 			wd.showQueryInput()
 			return true
 		}).
+		OnRune('K', func(e *tcell.EventKey) bool {
+			wd.showLiveStackTrace()
+			return true
+		}).
+		OnRune('O', func(e *tcell.EventKey) bool {
+			wd.showWaitForResult()
+			return true
+		}).
 		OnRune('i', func(e *tcell.EventKey) bool {
 			wd.showIOModal()
 			return true
@@ -704,11 +1014,71 @@ func (wd *WorkflowDetail) Start() {
 			wd.showStartWorkflow()
 			return true
 		}).
+		OnRune('f', func(e *tcell.EventKey) bool {
+			wd.jumpToFailure(1)
+			return true
+		}).
 		OnRune('o', func(e *tcell.EventKey) bool {
 			wd.showWorkflowGraph()
 			return true
+		}).
+		OnRune('A', func(e *tcell.EventKey) bool {
+			wd.showPendingActivityPicker()
+			return true
+		}).
+		OnRune('F', func(e *tcell.EventKey) bool {
+			wd.jumpToFailure(-1)
+			return true
+		}).
+		OnRune('M', func(e *tcell.EventKey) bool {
+			wd.showRemediation()
+			return true
+		}).
+		OnRune('E', func(e *tcell.EventKey) bool {
+			wd.showRecoveryConfirm()
+			return true
+		}).
+		OnRune('w', func(e *tcell.EventKey) bool {
+			wd.toggleEventExplanations()
+			return true
+		}).
+		OnRune('H', func(e *tcell.EventKey) bool {
+			wd.openHistoryExternally()
+			return true
+		}).
+		OnRune('W', func(e *tcell.EventKey) bool {
+			wd.app.showHistoryExport(wd.workflowID, wd.runID)
+			return true
+		}).
+		OnRune('V', func(e *tcell.EventKey) bool {
+			wd.showReplay()
+			return true
+		}).
+		OnRune('C', func(e *tcell.EventKey) bool {
+			wd.openContinuedAsNewRun()
+			return true
+		}).
+		OnRune('L', func(e *tcell.EventKey) bool {
+			wd.openLatestRun()
+			return true
+		}).
+		OnRune('[', func(e *tcell.EventKey) bool {
+			wd.openAdjacentRun(-1)
+			return true
+		}).
+		OnRune(']', func(e *tcell.EventKey) bool {
+			wd.openAdjacentRun(1)
+			return true
 		})
 
+	// g is taken by "go to child", so Home jumps to the first event
+	addEdgeNav(bindings, tableEdgeNav{wd.eventTable}, false)
+
+	// Enter on a child workflow or external signal event opens its workflow too
+	bindings.On(tcell.KeyEnter, func(e *tcell.EventKey) bool {
+		return wd.openLinkedWorkflow()
+	})
+
 	wd.eventTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if bindings.Handle(event) {
 			return nil
@@ -721,6 +1091,36 @@ func (wd *WorkflowDetail) Start() {
 // Stop is called when the view is deactivated.
 func (wd *WorkflowDetail) Stop() {
 	wd.eventTable.SetInputCapture(nil)
+	wd.stopRetryCountdown()
+	wd.refresher.stop()
+}
+
+// toggleAutoRefresh turns re-polling of a running workflow on or off. It
+// turns itself off once the workflow closes.
+func (wd *WorkflowDetail) toggleAutoRefresh() {
+	if wd.refresher.active() {
+		wd.refresher.stop()
+		wd.app.ToastSuccess("Auto-refresh off")
+		return
+	}
+	if wd.workflow != nil && wd.workflow.Status != "Running" {
+		wd.app.ToastWarning(fmt.Sprintf("Workflow is %s; auto-refresh only follows running workflows", wd.workflow.Status))
+		return
+	}
+	wd.refresher.start(wd.app, wd.autoRefresh)
+	wd.app.ToastSuccess(fmt.Sprintf("Auto-refresh on, every %s", autoRefreshInterval))
+}
+
+// autoRefresh reloads the workflow unless a load is still in flight or the
+// large history prompt is waiting for an answer.
+func (wd *WorkflowDetail) autoRefresh() {
+	if wd.loading {
+		return
+	}
+	if !wd.historyChosen && wd.workflow != nil && wd.app.isLargeHistory(wd.workflow) {
+		return
+	}
+	wd.loadData()
 }
 
 // Hints returns keybinding hints for this view.
@@ -730,31 +1130,72 @@ func (wd *WorkflowDetail) Hints() []KeyHint {
 		{Key: "i", Description: "Input/Output"},
 		{Key: "e", Description: "Event Graph"},
 		{Key: "o", Description: "Relationships"},
+		{Key: "p", Description: "Diff Prev Run"},
 		{Key: "d", Description: "Detail"},
 		{Key: "g", Description: "Go to Child"},
+		{Key: "f/F", Description: "Next/Prev Failure"},
 		{Key: "y", Description: "Yank"},
+		{Key: "w", Description: "Explain Events"},
+		{Key: "H", Description: "Raw History"},
+		{Key: "W", Description: "Export History"},
+		{Key: "V", Description: "Replay"},
 		{Key: "r", Description: "Refresh"},
+		{Key: "a", Description: "Auto-refresh"},
 		{Key: "j/k", Description: "Navigate"},
 	}
 
+	// The system namespace is read-only, so it gets no mutation hints
+	mutable := !wd.app.readOnlyNamespace()
+
 	// Only show mutation hints if workflow is running
 	if wd.workflow != nil && wd.workflow.Status == "Running" {
+		if mutable {
+			hints = append(hints,
+				KeyHint{Key: "c", Description: "Cancel"},
+				KeyHint{Key: "X", Description: "Terminate"},
+				KeyHint{Key: "s", Description: "Signal"},
+				KeyHint{Key: "u", Description: "Update"},
+			)
+		}
 		hints = append(hints,
-			KeyHint{Key: "c", Description: "Cancel"},
-			KeyHint{Key: "X", Description: "Terminate"},
-			KeyHint{Key: "s", Description: "Signal"},
 			KeyHint{Key: "Q", Description: "Query"},
+			KeyHint{Key: "K", Description: "Stack Trace"},
+			KeyHint{Key: "O", Description: "Wait for Result"},
 		)
+		if mutable && len(wd.workflow.PendingActivities) > 0 {
+			hints = append(hints, KeyHint{Key: "A", Description: "Cancel Activity"})
+		}
 	}
 
 	// Reset is available for completed/failed workflows
-	if wd.workflow != nil && (wd.workflow.Status == "Completed" || wd.workflow.Status == "Failed" || wd.workflow.Status == "Terminated" || wd.workflow.Status == "Canceled") {
+	if mutable && wd.workflow != nil && (wd.workflow.Status == "Completed" || wd.workflow.Status == "Failed" || wd.workflow.Status == "Terminated" || wd.workflow.Status == "Canceled" || wd.workflow.Status == "ContinuedAsNew") {
 		hints = append(hints, KeyHint{Key: "R", Description: "Reset"})
 	}
 
+	if wd.continuedAsNewRunID() != "" {
+		hints = append(hints, KeyHint{Key: "C", Description: "Open New Run"})
+	}
+	if wd.previousRunID() != "" || wd.nextRunID() != "" {
+		hints = append(hints, KeyHint{Key: "[/]", Description: "Prev/Next Run"})
+	}
+	if wd.nextRunID() != "" {
+		hints = append(hints, KeyHint{Key: "L", Description: "Latest Run"})
+	}
+
+	if mutable {
+		if wd.hasNonDeterminismFailure() {
+			hints = append(hints, KeyHint{Key: "M", Description: "Remediate"})
+		}
+		if _, ok := wd.recoveryAction(); ok {
+			hints = append(hints, KeyHint{Key: "E", Description: "Recover"})
+		}
+		hints = append(hints,
+			KeyHint{Key: "N", Description: "Start"},
+			KeyHint{Key: "D", Description: "Delete"},
+		)
+	}
+
 	hints = append(hints,
-		KeyHint{Key: "N", Description: "Start"},
-		KeyHint{Key: "D", Description: "Delete"},
 		KeyHint{Key: "T", Description: "Theme"},
 		KeyHint{Key: "esc", Description: "Back"},
 	)
@@ -771,9 +1212,12 @@ func (wd *WorkflowDetail) Focus(delegate func(p tview.Primitive)) {
 func (wd *WorkflowDetail) Draw(screen tcell.Screen) {
 	bg := theme.Bg()
 	wd.SetBackgroundColor(bg)
+	wd.body.SetBackgroundColor(bg)
 	wd.leftFlex.SetBackgroundColor(bg)
+	wd.outcomeView.SetBackgroundColor(bg)
 	wd.workflowView.SetBackgroundColor(bg)
 	wd.eventDetailView.SetBackgroundColor(bg)
+	wd.pendingView.SetBackgroundColor(bg)
 	wd.Flex.Draw(screen)
 }
 
@@ -787,6 +1231,10 @@ func truncateStr(s string, maxLen int) string {
 // Mutation methods
 
 func (wd *WorkflowDetail) showCancelConfirm() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
 	form := components.NewFormBuilder().
 		Text("reason", "Reason (optional)").
 		Value("Cancelled via tempo").
@@ -802,7 +1250,7 @@ func (wd *WorkflowDetail) showCancelConfirm() {
 		Build()
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Cancel Workflow", theme.IconWarning),
+		Title:    fmt.Sprintf("%s Cancel Workflow", icons.Warning),
 		Width:    60,
 		Height:   12,
 		Backdrop: true,
@@ -840,18 +1288,34 @@ func (wd *WorkflowDetail) executeCancelWorkflow(reason string) {
 				wd.showError(err)
 				return
 			}
+			wd.app.RecordSentOp(sentCancel, wd.workflowID, wd.runID, "")
 			wd.loadData() // Refresh to show updated status
 		})
 	}()
 }
 
-func (wd *WorkflowDetail) showTerminateConfirm() {
-	form := components.NewFormBuilder().
+// showTerminateConfirm asks for a reason before terminating. br counts the
+// descendants found by showTerminatePreview.
+func (wd *WorkflowDetail) showTerminateConfirm(br blastRadius) {
+	namespace := wd.app.CurrentNamespace()
+	confirm := wd.app.confirmationFor("terminate", namespace, "")
+
+	builder := components.NewFormBuilder().
 		Text("reason", "Reason (required)").
 		Value("Terminated via tempo").
 		Validate(validators.Required()).
-		Done().
+		Done()
+	modalHeight := 14
+	if confirm.required() {
+		builder = confirm.addField(builder, "")
+		modalHeight += 4
+	}
+
+	form := builder.
 		OnSubmit(func(values map[string]any) {
+			if confirm.required() && !confirm.matches(values["confirm"].(string)) {
+				return
+			}
 			reason := values["reason"].(string)
 			wd.closeModal()
 			wd.executeTerminateWorkflow(reason)
@@ -869,15 +1333,25 @@ func (wd *WorkflowDetail) showTerminateConfirm() {
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignLeft)
 	warningText.SetBackgroundColor(theme.Bg())
-	warningText.SetText(fmt.Sprintf("[%s]Warning: Termination is immediate and irreversible.\nNo cleanup code will run in the workflow.[-]", theme.TagError()))
+	warning := fmt.Sprintf("[%s]Warning: Termination is immediate and irreversible.\nNo cleanup code will run in the workflow.[-]", theme.TagError())
+	warningHeight := 3
+	if br.total > 0 {
+		warning += fmt.Sprintf("\n[%s]%d descendant workflows, %d still running.[-]", theme.TagWarning(), br.total, br.open)
+		warningHeight++
+		modalHeight++
+	}
+	warningText.SetText(warning + confirm.warning(namespace))
 
-	contentFlex.AddItem(warningText, 3, 0, false)
+	if confirm.danger {
+		warningHeight++
+	}
+	contentFlex.AddItem(warningText, warningHeight, 0, false)
 	contentFlex.AddItem(form, 0, 1, true)
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Terminate Workflow", theme.IconError),
+		Title:    fmt.Sprintf("%s Terminate Workflow", icons.Error),
 		Width:    65,
-		Height:   14,
+		Height:   modalHeight,
 		Backdrop: true,
 	})
 	modal.SetContent(contentFlex)
@@ -919,20 +1393,17 @@ func (wd *WorkflowDetail) executeTerminateWorkflow(reason string) {
 }
 
 func (wd *WorkflowDetail) showDeleteConfirm() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
 	workflowID := wd.workflowID
-	form := components.NewFormBuilder().
-		Text("confirm", "Type workflow ID to confirm").
-		Placeholder(workflowID).
-		Validate(validators.Custom(func(value any) error {
-			if s, ok := value.(string); ok && s != workflowID {
-				return fmt.Errorf("must match workflow ID")
-			}
-			return nil
-		})).
-		Done().
+	namespace := wd.app.CurrentNamespace()
+	confirm := wd.app.confirmationFor("delete", namespace, workflowID)
+
+	form := confirm.addField(components.NewFormBuilder(), "workflow ID").
 		OnSubmit(func(values map[string]any) {
-			confirm := values["confirm"].(string)
-			if confirm != workflowID {
+			if !confirm.matches(values["confirm"].(string)) {
 				return
 			}
 			wd.closeModal()
@@ -954,17 +1425,22 @@ func (wd *WorkflowDetail) showDeleteConfirm() {
 	warningText.SetText(fmt.Sprintf(`[%s]Warning: This will permanently delete the workflow and its history.
 This action cannot be undone.[-]
 
-[%s]Workflow ID:[-] [%s]%s[-]`,
+[%s]Workflow ID:[-] [%s]%s[-]%s`,
 		theme.TagError(),
-		theme.TagFgDim(), theme.TagFg(), workflowID))
+		theme.TagFgDim(), theme.TagFg(), workflowID,
+		confirm.warning(namespace)))
 
-	contentFlex.AddItem(warningText, 5, 0, false)
+	warningHeight := 5
+	if confirm.danger {
+		warningHeight++
+	}
+	contentFlex.AddItem(warningText, warningHeight, 0, false)
 	contentFlex.AddItem(form, 0, 1, true)
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Delete Workflow", theme.IconError),
+		Title:    fmt.Sprintf("%s Delete Workflow", icons.Error),
 		Width:    70,
-		Height:   16,
+		Height:   11 + warningHeight,
 		Backdrop: true,
 	})
 	modal.SetContent(contentFlex)
@@ -1006,6 +1482,10 @@ func (wd *WorkflowDetail) executeDeleteWorkflow() {
 }
 
 func (wd *WorkflowDetail) showSignalInput() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
 	form := components.NewFormBuilder().
 		Text("signalName", "Signal Name").
 		Placeholder("Enter signal name").
@@ -1026,7 +1506,7 @@ func (wd *WorkflowDetail) showSignalInput() {
 		Build()
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Signal Workflow", theme.IconSignal),
+		Title:    fmt.Sprintf("%s Signal Workflow", icons.Signal),
 		Width:    70,
 		Height:   16,
 		Backdrop: true,
@@ -1071,13 +1551,51 @@ func (wd *WorkflowDetail) executeSignalWorkflow(signalName, input string) {
 				wd.showError(err)
 				return
 			}
+			wd.app.RecordSentOp(sentSignal, wd.workflowID, wd.runID, signalName)
 			wd.loadData() // Refresh to show signal event
 		})
 	}()
 }
 
+// diffPreviousRun opens the diff view with the run that preceded this one on
+// the left and the current run on the right.
+func (wd *WorkflowDetail) diffPreviousRun() {
+	provider := wd.app.Provider()
+	if provider == nil || wd.workflow == nil {
+		return
+	}
+	current := *wd.workflow
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		runs, _, err := provider.ListWorkflows(ctx, wd.app.CurrentNamespace(), temporal.ListOptions{
+			PageSize: wd.app.PageSize(),
+			Query:    fmt.Sprintf("WorkflowId = '%s'", current.ID),
+		})
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showError(err)
+				return
+			}
+			prev, ok := temporal.PreviousRun(runs, current)
+			if !ok {
+				wd.app.ToastWarning("No earlier run of this workflow ID")
+				return
+			}
+			wd.app.NavigateToWorkflowDiff(&prev, &current)
+		})
+	}()
+}
+
 // showStartWorkflow displays the start workflow modal pre-filled from the current workflow.
 func (wd *WorkflowDetail) showStartWorkflow() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
 	var prefill startWorkflowPrefill
 	if wd.workflow != nil {
 		prefill = startWorkflowPrefill{
@@ -1092,6 +1610,10 @@ func (wd *WorkflowDetail) showStartWorkflow() {
 }
 
 func (wd *WorkflowDetail) showResetSelector() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
 	provider := wd.app.Provider()
 	if provider == nil {
 		return
@@ -1099,7 +1621,7 @@ func (wd *WorkflowDetail) showResetSelector() {
 
 	// Show loading modal
 	loadingModal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Loading Reset Points...", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Loading Reset Points...", icons.Info),
 		Width:    40,
 		Height:   5,
 		Backdrop: true,
@@ -1117,6 +1639,8 @@ func (wd *WorkflowDetail) showResetSelector() {
 		defer cancel()
 
 		resetPoints, err := provider.GetResetPoints(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
+		// Older servers ignore reapply exclusions, so only offer them when honored.
+		canExclude, _ := provider.SupportsResetReapplyExclude(ctx)
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
 			wd.closeModal()
@@ -1132,7 +1656,7 @@ func (wd *WorkflowDetail) showResetSelector() {
 			}
 
 			// Show the reset picker with all points
-			wd.showResetPicker(resetPoints)
+			wd.showResetPicker(resetPoints, canExclude)
 		})
 	}()
 }
@@ -1144,7 +1668,7 @@ func (wd *WorkflowDetail) showQuickResetModal(failurePoint temporal.ResetPoint,
 		Done().
 		OnSubmit(func(values map[string]any) {
 			wd.closeModal()
-			wd.executeResetWorkflow(failurePoint.EventID, values["reason"].(string))
+			wd.executeResetWorkflow(failurePoint.EventID, values["reason"].(string), nil)
 		}).
 		OnCancel(func() {
 			wd.closeModal()
@@ -1172,7 +1696,7 @@ func (wd *WorkflowDetail) showQuickResetModal(failurePoint temporal.ResetPoint,
 	contentFlex.AddItem(form, 0, 1, true)
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Quick Reset", theme.IconWarning),
+		Title:    fmt.Sprintf("%s Quick Reset", icons.Warning),
 		Width:    70,
 		Height:   14,
 		Backdrop: true,
@@ -1188,9 +1712,9 @@ func (wd *WorkflowDetail) showQuickResetModal(failurePoint temporal.ResetPoint,
 	wd.app.JigApp().SetFocus(form)
 }
 
-func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint) {
+func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint, canExclude bool) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:     fmt.Sprintf("%s Select Reset Point", theme.IconInfo),
+		Title:     fmt.Sprintf("%s Select Reset Point", icons.Info),
 		Width:     90,
 		Height:    20,
 		MinHeight: 15,
@@ -1217,7 +1741,7 @@ func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint) {
 			row := table.SelectedRow()
 			if row >= 0 && row < len(resetPoints) {
 				wd.closeModal()
-				wd.showResetConfirm(resetPoints[row])
+				wd.showResetConfirm(resetPoints[row], canExclude)
 			}
 			return nil
 		case tcell.KeyEscape:
@@ -1246,15 +1770,30 @@ func (wd *WorkflowDetail) showResetPicker(resetPoints []temporal.ResetPoint) {
 	wd.app.JigApp().SetFocus(table)
 }
 
-func (wd *WorkflowDetail) showResetConfirm(resetPoint temporal.ResetPoint) {
+// showResetConfirm asks for a reason before resetting to resetPoint. When
+// canExclude is set, signals and updates can be left out of the reapply.
+func (wd *WorkflowDetail) showResetConfirm(resetPoint temporal.ResetPoint, canExclude bool) {
 	eventID := resetPoint.EventID
-	form := components.NewFormBuilder().
+	builder := components.NewFormBuilder().
 		Text("reason", "Reason").
 		Value("Reset via tempo").
-		Done().
+		Done()
+	if canExclude {
+		builder = builder.
+			Checkbox("excludeSignals", "Don't reapply signals received after this event").Done().
+			Checkbox("excludeUpdates", "Don't reapply updates received after this event").Done()
+	}
+	form := builder.
 		OnSubmit(func(values map[string]any) {
+			var exclude []temporal.ResetReapplyExclude
+			if skip, _ := values["excludeSignals"].(bool); skip {
+				exclude = append(exclude, temporal.ResetExcludeSignals)
+			}
+			if skip, _ := values["excludeUpdates"].(bool); skip {
+				exclude = append(exclude, temporal.ResetExcludeUpdates)
+			}
 			wd.closeModal()
-			wd.executeResetWorkflow(eventID, values["reason"].(string))
+			wd.executeResetWorkflow(eventID, values["reason"].(string), exclude)
 		}).
 		OnCancel(func() {
 			wd.closeModal()
@@ -1283,23 +1822,33 @@ func (wd *WorkflowDetail) showResetConfirm(resetPoint temporal.ResetPoint) {
 	contentFlex.AddItem(infoText, 7, 0, false)
 	contentFlex.AddItem(form, 0, 1, true)
 
+	height := 16
+	hints := []components.KeyHint{
+		{Key: "Ctrl+S", Description: "Reset"},
+		{Key: "Esc", Description: "Cancel"},
+	}
+	if canExclude {
+		height += 2
+		hints = append([]components.KeyHint{
+			{Key: "Tab", Description: "Next field"},
+			{Key: "Space", Description: "Toggle"},
+		}, hints...)
+	}
+
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Confirm Reset", theme.IconWarning),
+		Title:    fmt.Sprintf("%s Confirm Reset", icons.Warning),
 		Width:    70,
-		Height:   16,
+		Height:   height,
 		Backdrop: true,
 	})
 	modal.SetContent(contentFlex)
-	modal.SetHints([]components.KeyHint{
-		{Key: "Ctrl+S", Description: "Reset"},
-		{Key: "Esc", Description: "Cancel"},
-	})
+	modal.SetHints(hints)
 
 	wd.app.JigApp().Pages().Push(modal)
 	wd.app.JigApp().SetFocus(form)
 }
 
-func (wd *WorkflowDetail) executeResetWorkflow(eventID int64, reason string) {
+func (wd *WorkflowDetail) executeResetWorkflow(eventID int64, reason string, exclude []temporal.ResetReapplyExclude) {
 	provider := wd.app.Provider()
 	if provider == nil {
 		return
@@ -1316,6 +1865,7 @@ func (wd *WorkflowDetail) executeResetWorkflow(eventID int64, reason string) {
 			wd.runID,
 			eventID,
 			reason,
+			exclude,
 		)
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
@@ -1332,7 +1882,7 @@ func (wd *WorkflowDetail) executeResetWorkflow(eventID int64, reason string) {
 
 func (wd *WorkflowDetail) showResetError(message string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Reset Error", theme.IconError),
+		Title:    fmt.Sprintf("%s Reset Error", icons.Error),
 		Width:    50,
 		Height:   8,
 		Backdrop: true,
@@ -1390,7 +1940,7 @@ func (wd *WorkflowDetail) showQueryInput() {
 		Build()
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query Workflow", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Query Workflow", icons.Info),
 		Width:    70,
 		Height:   18,
 		Backdrop: true,
@@ -1432,17 +1982,18 @@ func (wd *WorkflowDetail) executeQuery(queryType, args string) {
 
 		wd.app.JigApp().QueueUpdateDraw(func() {
 			if err != nil {
-				wd.showQueryError(queryType, err.Error())
+				wd.showResultError("Query", queryType, err.Error())
 				return
 			}
-			wd.showQueryResult(queryType, result.Result)
+			wd.showResult("Query", queryType, result.Result)
 		})
 	}()
 }
 
-func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
+// showResult shows the result of a query or update; kind names which.
+func (wd *WorkflowDetail) showResult(kind, name, result string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:     fmt.Sprintf("%s Query Result: %s", theme.IconInfo, queryType),
+		Title:     fmt.Sprintf("%s %s Result: %s", icons.Info, kind, name),
 		Width:     0,
 		Height:    0,
 		MinWidth:  80,
@@ -1459,14 +2010,33 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 	resultView.SetTextColor(theme.Fg())
 
 	// Format the result (attempt to pretty-print JSON)
-	formatted := formatJSONPretty(result)
-	highlighted := highlightFormattedJSONWorkflow(formatted)
-	resultView.SetText(highlighted)
+	resultView.SetText(formatIOContent("Result", result, false))
 
 	panel := components.NewPanel().SetTitle("Result")
 	panel.SetContent(resultView)
 
+	// Show "Copied!" feedback
+	flashCopied := func() {
+		panel.SetTitle(fmt.Sprintf("%s Copied!", icons.Completed))
+		panel.SetTitleColor(temporal.StatusCompleted.Color())
+		go func() {
+			time.Sleep(1 * time.Second)
+			wd.app.JigApp().QueueUpdateDraw(func() {
+				panel.SetTitle("Result")
+				panel.SetTitleColor(0)
+			})
+		}()
+	}
+
+	selection := newLineSelect(resultView, true)
 	resultView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if copied, ok := selection.HandleKey(event); ok {
+			if copied != "" {
+				copyToClipboard(copied)
+				flashCopied()
+			}
+			return nil
+		}
 		switch event.Key() {
 		case tcell.KeyEscape:
 			wd.closeModal()
@@ -1501,16 +2071,16 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 				return nil
 			case 'y':
 				copyToClipboard(result)
-				// Show "Copied!" feedback
-				panel.SetTitle(fmt.Sprintf("%s Copied!", theme.IconCompleted))
-				panel.SetTitleColor(temporal.StatusCompleted.Color())
-				go func() {
-					time.Sleep(1 * time.Second)
-					wd.app.JigApp().QueueUpdateDraw(func() {
-						panel.SetTitle("Result")
-						panel.SetTitleColor(0)
-					})
-				}()
+				flashCopied()
+				return nil
+			case 'v':
+				selection.Start()
+				return nil
+			case 'm':
+				resultView.SetText(formatIOContent("Result", result, true))
+				return nil
+			case 'w', 's':
+				wd.showSavePayload(payloadFileName(wd.workflowID, strings.ToLower(kind), name), result, resultView)
 				return nil
 			case 'q':
 				wd.closeModal()
@@ -1524,6 +2094,9 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 	modal.SetHints([]components.KeyHint{
 		{Key: "j/k", Description: "Scroll"},
 		{Key: "y", Description: "Copy"},
+		{Key: "v", Description: "Select Lines"},
+		{Key: "m", Description: "Render Full"},
+		{Key: "w/s", Description: "Save to File"},
 		{Key: "Esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
@@ -1534,9 +2107,10 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 	wd.app.JigApp().SetFocus(resultView)
 }
 
-func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
+// showResultError shows why a query or update failed; kind names which.
+func (wd *WorkflowDetail) showResultError(kind, name, errMsg string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query Failed: %s", theme.IconError, queryType),
+		Title:    fmt.Sprintf("%s %s Failed: %s", icons.Error, kind, name),
 		Width:    60,
 		Height:   10,
 		Backdrop: true,
@@ -1546,8 +2120,8 @@ func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignLeft)
 	errorText.SetBackgroundColor(theme.Bg())
-	errorText.SetText(fmt.Sprintf("[%s]Error executing query:[-]\n\n[%s]%s[-]",
-		theme.TagError(), theme.TagFg(), errMsg))
+	errorText.SetText(fmt.Sprintf("[%s]Error executing %s:[-]\n\n[%s]%s[-]",
+		theme.TagError(), strings.ToLower(kind), theme.TagFg(), tview.Escape(errMsg)))
 
 	modal.SetContent(errorText)
 	modal.SetHints([]components.KeyHint{
@@ -1563,16 +2137,6 @@ func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
 	wd.app.JigApp().Pages().Push(modal)
 }
 
-// getSelectedEventDetails returns the details for the currently selected event.
-func (wd *WorkflowDetail) getSelectedEventDetails() (string, string) {
-	row := wd.eventTable.SelectedRow()
-	if row < 0 || row >= len(wd.events) {
-		return "", ""
-	}
-	ev := wd.events[row]
-	return ev.Type, formatWorkflowEventDataRaw(&ev)
-}
-
 func formatWorkflowEventDataRaw(ev *temporal.EnhancedHistoryEvent) string {
 	if ev == nil {
 		return ""
@@ -1588,6 +2152,9 @@ func formatWorkflowEventDataRaw(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.Failure != "" {
 		parts = append(parts, fmt.Sprintf("Failure: %s", prettyPrintJSONDetail(ev.Failure)))
 	}
+	if len(ev.FailureChain) > 0 {
+		parts = append(parts, fmt.Sprintf("Chain: %s", temporal.FailureChainSummary(ev.Type, ev.FailureChain)))
+	}
 	if ev.FailureSource != "" {
 		parts = append(parts, fmt.Sprintf("Source: %s", ev.FailureSource))
 	}
@@ -1601,40 +2168,15 @@ func formatWorkflowEventDataRaw(ev *temporal.EnhancedHistoryEvent) string {
 	return strings.Join(parts, "\n\n")
 }
 
-// yankEventData copies the selected event's details to clipboard.
-func (wd *WorkflowDetail) yankEventData() {
-	eventType, data := wd.getSelectedEventDetails()
-	if data == "" {
-		return
-	}
-
-	if err := copyToClipboard(data); err != nil {
-		wd.eventDetailView.SetText(fmt.Sprintf("[%s]%s Failed to copy: %s[-]",
-			theme.TagError(), theme.IconError, err.Error()))
-		return
+// showYankMenu offers the run's IDs, type, CLI command, and link, and the
+// selected event's JSON and payload, for copying.
+func (wd *WorkflowDetail) showYankMenu() {
+	targets := wd.app.workflowYankTargets(wd.workflowID, wd.runID, wd.workflow)
+	row := wd.eventTable.SelectedRow()
+	if row >= 0 && row < len(wd.events) {
+		targets = append(targets, eventYankTargets(&wd.events[row])...)
 	}
-
-	// Show success feedback
-	wd.eventDetailView.SetText(fmt.Sprintf(`
-[%s::b]Copied to clipboard[-:-:-]
-
-[%s]%s[-]
-
-[%s]%s[-]`,
-		theme.TagAccent(),
-		theme.TagAccent(), eventType,
-		temporal.StatusCompleted.ColorTag(), "Event data copied!"))
-
-	// Restore detail after a brief delay
-	go func() {
-		time.Sleep(1500 * time.Millisecond)
-		wd.app.JigApp().QueueUpdateDraw(func() {
-			row := wd.eventTable.SelectedRow()
-			if row >= 0 && row < len(wd.events) {
-				wd.updateEventDetail(wd.events[row])
-			}
-		})
-	}()
+	wd.app.showYankMenu(targets)
 }
 
 // showEventDetailModal shows a full-screen modal with the event details.
@@ -1648,7 +2190,7 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 
 	// Create modal
 	modal := components.NewModal(components.ModalConfig{
-		Title:     fmt.Sprintf("%s Event: %s", theme.IconEvent, truncateEventTypeStr(ev.Type)),
+		Title:     fmt.Sprintf("%s Event: %s", icons.Event, truncateEventTypeStr(ev.Type)),
 		Width:     0,
 		Height:    0,
 		MinWidth:  100,
@@ -1668,24 +2210,25 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 	colorTag := eventColorTag(ev.Type)
 
 	headerText := fmt.Sprintf(`[%s::b]Event ID[-:-:-]     [%s]%d[-]
-[%s::b]Type[-:-:-]         [%s]%s %s[-]
+[%s::b]Type[-:-:-]         [%s]%s %s[-]%s
 [%s::b]Time[-:-:-]         [%s]%s[-]
 
 [%s::b]Details[-:-:-]`,
 		theme.TagFgDim(), theme.TagFg(), ev.ID,
-		theme.TagFgDim(), colorTag, icon, ev.Type,
+		theme.TagFgDim(), colorTag, icon, ev.Type, eventExplanationLine(ev.Type, "              "),
 		theme.TagFgDim(), theme.TagFg(), ev.Time.Format("2006-01-02 15:04:05.000"),
 		theme.TagAccent(),
 	)
 
 	// Format the details with syntax highlighting
-	formattedDetails := formatEventDetails(ev.Details)
-	fullText := headerText + "\n" + formattedDetails + formatFailureSidePanel(&ev)
-
-	detailView.SetText(fullText)
+	renderDetails := func(full bool) {
+		formattedDetails := formatEventDetails(ev.Details, full, "press m to render full / s to save / o to open in $PAGER")
+		detailView.SetText(headerText + "\n" + formattedDetails + formatFailureSidePanel(&ev))
+	}
+	renderDetails(false)
 
 	// Create panel
-	panel := components.NewPanel().SetTitle(fmt.Sprintf("%s Details", theme.IconInfo))
+	panel := components.NewPanel().SetTitle(fmt.Sprintf("%s Details", icons.Info))
 	panel.SetContent(detailView)
 
 	modal.SetContent(panel)
@@ -1693,14 +2236,39 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 		{Key: "j/k", Description: "Scroll"},
 		{Key: "g/G", Description: "Top/Bottom"},
 		{Key: "y", Description: "Copy"},
+		{Key: "v", Description: "Select Lines"},
+		{Key: "m", Description: "Render Full"},
+		{Key: "s", Description: "Save to File"},
+		{Key: "o", Description: "Open in $PAGER"},
 		{Key: "esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
 		wd.closeEventDetailModal()
 	})
 
+	// Show "Copied!" feedback
+	flashCopied := func() {
+		panel.SetTitle(fmt.Sprintf("%s Copied!", icons.Completed))
+		panel.SetTitleColor(temporal.StatusCompleted.Color())
+		go func() {
+			time.Sleep(1 * time.Second)
+			wd.app.JigApp().QueueUpdateDraw(func() {
+				panel.SetTitle(fmt.Sprintf("%s Details", icons.Info))
+				panel.SetTitleColor(0)
+			})
+		}()
+	}
+
 	// Handle input
+	selection := newLineSelect(detailView, true)
 	detailView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if copied, ok := selection.HandleKey(event); ok {
+			if copied != "" {
+				copyToClipboard(copied)
+				flashCopied()
+			}
+			return nil
+		}
 		switch event.Key() {
 		case tcell.KeyEscape:
 			wd.closeEventDetailModal()
@@ -1749,16 +2317,22 @@ func (wd *WorkflowDetail) showEventDetailModal() {
 				// Copy the raw event diagnostics.
 				if data := formatWorkflowEventDataRaw(&ev); data != "" {
 					copyToClipboard(data)
-					// Show "Copied!" feedback
-					panel.SetTitle(fmt.Sprintf("%s Copied!", theme.IconCompleted))
-					panel.SetTitleColor(temporal.StatusCompleted.Color())
-					go func() {
-						time.Sleep(1 * time.Second)
-						wd.app.JigApp().QueueUpdateDraw(func() {
-							panel.SetTitle(fmt.Sprintf("%s Details", theme.IconInfo))
-							panel.SetTitleColor(0)
-						})
-					}()
+					flashCopied()
+				}
+				return nil
+			case 'v':
+				selection.Start()
+				return nil
+			case 'm':
+				renderDetails(true)
+				return nil
+			case 's':
+				wd.showSavePayload(payloadFileName(wd.workflowID, "event", fmt.Sprintf("%d", ev.ID)), eventPayloadContent(&ev), detailView)
+				return nil
+			case 'o':
+				content := formatJSONPretty(eventPayloadContent(&ev)) + "\n"
+				if err := wd.app.openInExternalViewer(payloadFileName(wd.workflowID, "event", fmt.Sprintf("%d", ev.ID)), []byte(content)); err != nil {
+					wd.app.ToastError(err.Error())
 				}
 				return nil
 			case 'q':
@@ -1887,7 +2461,7 @@ func (wd *WorkflowDetail) showIOModal() {
 
 	// Create modal - use percentage-based sizing for larger display
 	modal := components.NewModal(components.ModalConfig{
-		Title:     fmt.Sprintf("%s Input/Output: %s", theme.IconWorkflow, truncateStr(wd.workflow.Type, 30)),
+		Title:     fmt.Sprintf("%s Input/Output: %s", icons.Workflow, truncateStr(wd.workflow.Type, 30)),
 		Width:     0, // 0 means use percentage
 		Height:    0,
 		MinWidth:  120,
@@ -1910,18 +2484,18 @@ func (wd *WorkflowDetail) showIOModal() {
 	outputView.SetTextColor(theme.Fg())
 
 	// Format input
-	inputText := formatIOContent("Input", wd.workflow.Input)
+	inputText := formatIOContent("Input", wd.workflow.Input, false)
 	inputView.SetText(inputText)
 
 	// Format output
-	outputText := formatIOContent("Output", wd.workflow.Output)
+	outputText := formatIOContent("Output", wd.workflow.Output, false)
 	outputView.SetText(outputText)
 
 	// Create panels for each side with visual indicator for focus
-	inputPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Input", theme.IconArrowRight))
+	inputPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Input", icons.ArrowRight))
 	inputPanel.SetContent(inputView)
 
-	outputPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Output", theme.IconArrowLeft))
+	outputPanel := components.NewPanel().SetTitle(fmt.Sprintf("%s Output", icons.ArrowLeft))
 	outputPanel.SetContent(outputView)
 
 	// Layout: side by side
@@ -1935,6 +2509,9 @@ func (wd *WorkflowDetail) showIOModal() {
 		{Key: "tab/h/l", Description: "Switch"},
 		{Key: "j/k", Description: "Scroll"},
 		{Key: "y", Description: "Copy"},
+		{Key: "v", Description: "Select Lines"},
+		{Key: "m", Description: "Render Full"},
+		{Key: "w/s", Description: "Save to File"},
 		{Key: "esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
@@ -1947,14 +2524,14 @@ func (wd *WorkflowDetail) showIOModal() {
 	// Update panel titles and colors to show focus
 	updatePanelTitles := func() {
 		if focusedInput {
-			inputPanel.SetTitle(fmt.Sprintf("%s Input (active)", theme.IconArrowRight))
+			inputPanel.SetTitle(fmt.Sprintf("%s Input (active)", icons.ArrowRight))
 			inputPanel.SetTitleColor(theme.Accent())
-			outputPanel.SetTitle(fmt.Sprintf("%s Output", theme.IconArrowLeft))
+			outputPanel.SetTitle(fmt.Sprintf("%s Output", icons.ArrowLeft))
 			outputPanel.SetTitleColor(0) // Use default (PanelTitle color)
 		} else {
-			inputPanel.SetTitle(fmt.Sprintf("%s Input", theme.IconArrowRight))
+			inputPanel.SetTitle(fmt.Sprintf("%s Input", icons.ArrowRight))
 			inputPanel.SetTitleColor(0) // Use default
-			outputPanel.SetTitle(fmt.Sprintf("%s Output (active)", theme.IconArrowLeft))
+			outputPanel.SetTitle(fmt.Sprintf("%s Output (active)", icons.ArrowLeft))
 			outputPanel.SetTitleColor(theme.Accent())
 		}
 	}
@@ -1987,8 +2564,38 @@ func (wd *WorkflowDetail) showIOModal() {
 		view.ScrollTo(newRow, col)
 	}
 
+	// Show "Copied!" feedback on the focused pane
+	flashCopied := func() {
+		panel := outputPanel
+		if focusedInput {
+			panel = inputPanel
+		}
+		panel.SetTitle(fmt.Sprintf("%s Copied!", icons.Completed))
+		panel.SetTitleColor(temporal.StatusCompleted.Color())
+		go func() {
+			time.Sleep(1 * time.Second)
+			wd.app.JigApp().QueueUpdateDraw(func() {
+				updatePanelTitles()
+			})
+		}()
+	}
+
+	inputSelection := newLineSelect(inputView, true)
+	outputSelection := newLineSelect(outputView, true)
+
 	// Handle input - shared handler for both views
 	inputHandler := func(event *tcell.EventKey) *tcell.EventKey {
+		selection := outputSelection
+		if focusedInput {
+			selection = inputSelection
+		}
+		if copied, ok := selection.HandleKey(event); ok {
+			if copied != "" {
+				copyToClipboard(copied)
+				flashCopied()
+			}
+			return nil
+		}
 		switch event.Key() {
 		case tcell.KeyEscape:
 			wd.closeIOModal()
@@ -2044,26 +2651,32 @@ func (wd *WorkflowDetail) showIOModal() {
 				return nil
 			case 'y':
 				// Copy the content of the focused pane
-				var content string
-				var panel *components.Panel
+				content := wd.workflow.Output
 				if focusedInput {
 					content = wd.workflow.Input
-					panel = inputPanel
-				} else {
-					content = wd.workflow.Output
-					panel = outputPanel
 				}
 				if content != "" {
 					copyToClipboard(content)
-					// Show "Copied!" feedback
-					panel.SetTitle(fmt.Sprintf("%s Copied!", theme.IconCompleted))
-					panel.SetTitleColor(temporal.StatusCompleted.Color())
-					go func() {
-						time.Sleep(1 * time.Second)
-						wd.app.JigApp().QueueUpdateDraw(func() {
-							updatePanelTitles()
-						})
-					}()
+					flashCopied()
+				}
+				return nil
+			case 'v':
+				selection.Start()
+				return nil
+			case 'm':
+				// Render the focused pane's payload without truncation
+				if focusedInput {
+					inputView.SetText(formatIOContent("Input", wd.workflow.Input, true))
+				} else {
+					outputView.SetText(formatIOContent("Output", wd.workflow.Output, true))
+				}
+				return nil
+			case 'w', 's':
+				// Save the focused pane's payload to disk
+				if focusedInput {
+					wd.showSavePayload(payloadFileName(wd.workflowID, "input"), wd.workflow.Input, inputView)
+				} else {
+					wd.showSavePayload(payloadFileName(wd.workflowID, "output"), wd.workflow.Output, outputView)
 				}
 				return nil
 			case 'q':
@@ -2082,16 +2695,23 @@ func (wd *WorkflowDetail) showIOModal() {
 }
 
 // formatIOContent formats input or output content for display.
-func formatIOContent(label, content string) string {
+// Unless full is set, content over the render limit is truncated.
+func formatIOContent(label, content string, full bool) string {
 	if content == "" {
 		return fmt.Sprintf("[%s]No %s[-]", theme.TagFgDim(), strings.ToLower(label))
 	}
 
+	var notice string
+	if head, truncated := truncatePayload(content); truncated && !full {
+		notice = payloadTruncatedNotice(len(content), "press m to render full / s to save to file")
+		content = head
+	}
+
 	// Pretty print if it's JSON
 	formatted := formatJSONPretty(content)
 	highlighted := highlightFormattedJSONWorkflow(formatted)
 
-	return highlighted
+	return highlighted + notice
 }
 
 // closeIOModal closes the IO modal.
@@ -2100,22 +2720,56 @@ func (wd *WorkflowDetail) closeIOModal() {
 	wd.app.JigApp().SetFocus(wd.eventTable)
 }
 
-// jumpToChildWorkflow navigates to the child workflow if the selected event is a child workflow event.
+// jumpToChildWorkflow navigates to the child workflow if the selected event is a child workflow event,
+// to the signaled workflow if it is an external signal, or to the new run if it is a continue-as-new event.
 func (wd *WorkflowDetail) jumpToChildWorkflow() {
+	if !wd.openLinkedWorkflow() {
+		wd.app.ToastWarning("Event does not link to another workflow")
+	}
+}
+
+// openLinkedWorkflow opens the execution the selected event links to,
+// returning false if it links to none.
+func (wd *WorkflowDetail) openLinkedWorkflow() bool {
 	row := wd.eventTable.SelectedRow()
 	if row < 0 || row >= len(wd.events) {
-		return
+		return false
 	}
 
 	ev := wd.events[row]
 
-	// Check if this event has child workflow info
-	if ev.ChildWorkflowID == "" || ev.ChildRunID == "" {
-		return
+	// The continue-as-new event links to the run that replaced this one
+	if ev.NewExecutionRunID != "" {
+		wd.openContinuedAsNewRun()
+		return true
+	}
+
+	linkedID, linkedRunID := linkedExecutionOf([]*temporal.EnhancedHistoryEvent{&ev}, wd.allEvents)
+	if linkedID == "" {
+		return false
+	}
+	wd.app.NavigateToWorkflowDetail(linkedID, linkedRunID)
+	return true
+}
+
+// continuedAsNewRunID returns the run this one continued as, or "" if the
+// run did not end with continue-as-new.
+func (wd *WorkflowDetail) continuedAsNewRunID() string {
+	for i := len(wd.allEvents) - 1; i >= 0; i-- {
+		if wd.allEvents[i].NewExecutionRunID != "" {
+			return wd.allEvents[i].NewExecutionRunID
+		}
 	}
+	return ""
+}
 
-	// Navigate to the child workflow
-	wd.app.NavigateToWorkflowDetail(ev.ChildWorkflowID, ev.ChildRunID)
+// openContinuedAsNewRun opens the run started by continue-as-new.
+func (wd *WorkflowDetail) openContinuedAsNewRun() {
+	newRunID := wd.continuedAsNewRunID()
+	if wd.workflow == nil || newRunID == "" {
+		return
+	}
+	wd.app.NavigateToWorkflowDetail(wd.workflow.ID, newRunID)
 }
 
 func (wd *WorkflowDetail) showWorkflowGraph() {
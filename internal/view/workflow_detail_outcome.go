@@ -0,0 +1,131 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// outcomePreviewLen caps the result and failure text shown in the banner.
+const outcomePreviewLen = 160
+
+// closeEvent returns the event that closed the run, or nil while it is open.
+func closeEvent(events []temporal.EnhancedHistoryEvent) *temporal.EnhancedHistoryEvent {
+	for i := len(events) - 1; i >= 0; i-- {
+		switch events[i].Type {
+		case "WorkflowExecutionCompleted", "WorkflowExecutionFailed", "WorkflowExecutionTimedOut",
+			"WorkflowExecutionCanceled", "WorkflowExecutionTerminated", "WorkflowExecutionContinuedAsNew":
+			return &events[i]
+		}
+	}
+	return nil
+}
+
+// oneLine collapses whitespace so a payload or message fits on one line.
+func oneLine(s string, maxLen int) string {
+	return truncateStr(strings.Join(strings.Fields(s), " "), maxLen)
+}
+
+// failureChain lists a failure's causes, outermost first, without stack
+// traces, e.g. "activity error ← connection refused".
+func failureChain(ev *temporal.EnhancedHistoryEvent) string {
+	if len(ev.FailureChain) < 2 {
+		return ""
+	}
+	causes := make([]string, 0, len(ev.FailureChain)-1)
+	for _, link := range ev.FailureChain[1:] {
+		causes = append(causes, link.Message)
+	}
+	return strings.Join(causes, " ← ")
+}
+
+// formatOutcome renders the banner lines for the event that closed a run.
+func formatOutcome(ev *temporal.EnhancedHistoryEvent) []string {
+	// Close event types are the status prefixed with "WorkflowExecution"
+	status := temporal.GetWorkflowStatus(strings.TrimPrefix(ev.Type, "WorkflowExecution"))
+	head := fmt.Sprintf(" [%s::b]%s %s[-:-:-]", status.ColorTag(), status.Icon(), status.Name())
+
+	switch ev.Type {
+	case "WorkflowExecutionCompleted", "WorkflowExecutionCanceled":
+		if ev.Result == "" {
+			return []string{head}
+		}
+		return []string{fmt.Sprintf("%s  [%s]%s[-]", head, theme.TagFg(), tview.Escape(oneLine(ev.Result, outcomePreviewLen)))}
+
+	case "WorkflowExecutionContinuedAsNew":
+		return []string{fmt.Sprintf("%s  [%s]→ %s[-]", head, theme.TagFg(), ev.NewExecutionRunID)}
+	}
+
+	reason := ev.Failure
+	if reason == "" {
+		reason = "no reason given"
+	}
+	lines := []string{fmt.Sprintf("%s[%s::b]: %s[-:-:-]", head, status.ColorTag(), tview.Escape(oneLine(reason, outcomePreviewLen)))}
+	if chain := failureChain(ev); chain != "" {
+		lines = append(lines, fmt.Sprintf("   [%s]Caused by:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), tview.Escape(oneLine(chain, outcomePreviewLen))))
+	}
+	return lines
+}
+
+// updateOutcome shows the result or failure of a closed run in a banner
+// above the panels. While the run is open the banner only warns that no
+// worker is polling its task queue, and is hidden otherwise.
+func (wd *WorkflowDetail) updateOutcome() {
+	ev := closeEvent(wd.allEvents)
+	if ev == nil {
+		if wd.idleTaskQueue != "" && wd.workflow != nil && wd.workflow.Status == "Running" {
+			wd.outcomeView.SetText(fmt.Sprintf(" [%s::b]%s No active workers on %s[-:-:-]  [%s]nothing has polled it in the last %s[-]",
+				theme.TagWarning(), icons.Warning, tview.Escape(wd.idleTaskQueue), theme.TagFgDim(), pollerStaleAfter))
+			wd.ResizeItem(wd.outcomeView, 1, 0)
+			return
+		}
+		wd.outcomeView.SetText("")
+		wd.ResizeItem(wd.outcomeView, 0, 0)
+		return
+	}
+	lines := formatOutcome(ev)
+	wd.outcomeView.SetText(strings.Join(lines, "\n"))
+	wd.ResizeItem(wd.outcomeView, len(lines), 0)
+}
+
+// checkWorkers looks for recent pollers on a running workflow's task queue
+// and warns in the banner if there are none, the usual reason a run makes no
+// progress. Failing to describe the queue shows no warning.
+func (wd *WorkflowDetail) checkWorkers(provider temporal.Provider, namespace string) {
+	if wd.workflow == nil || wd.workflow.Status != "Running" || wd.workflow.TaskQueue == "" {
+		return
+	}
+	taskQueue := wd.workflow.TaskQueue
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		_, pollers, err := provider.DescribeTaskQueue(ctx, namespace, taskQueue)
+		if err != nil {
+			return
+		}
+
+		now := time.Now()
+		active := 0
+		for _, p := range pollers {
+			if now.Sub(p.LastAccessTime) <= pollerStaleAfter {
+				active++
+			}
+		}
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			wd.idleTaskQueue = ""
+			if active == 0 {
+				wd.idleTaskQueue = taskQueue
+			}
+			wd.updateOutcome()
+		})
+	}()
+}
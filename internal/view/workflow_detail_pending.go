@@ -0,0 +1,402 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// formatPendingActivities renders the activities section of the pending
+// panel: each activity's state and attempt, when it was scheduled and last started, its
+// last failure, and what it last reported in a heartbeat.
+func formatPendingActivities(activities []temporal.PendingActivity, now time.Time) string {
+	var sb strings.Builder
+	for i, pa := range activities {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("\n[%s]%s %s[-] [%s]%s · %s[-]",
+			temporal.StatusRunning.ColorTag(), icons.Activity, tview.Escape(pa.ActivityType),
+			theme.TagFgDim(), pa.State, formatAttempt(pa)))
+		if !pa.ScheduledTime.IsZero() {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Scheduled[-]  [%s]%s[-]",
+				theme.TagFgDim(), theme.TagFg(), formatRelativeTime(now, pa.ScheduledTime)))
+		}
+		if pa.LastStartedTime != nil {
+			worker := ""
+			if pa.LastWorkerIdentity != "" {
+				worker = " on " + tview.Escape(pa.LastWorkerIdentity)
+			}
+			sb.WriteString(fmt.Sprintf("\n  [%s]Started[-]    [%s]%s%s[-]",
+				theme.TagFgDim(), theme.TagFg(), formatRelativeTime(now, *pa.LastStartedTime), worker))
+		}
+		if pa.IsBackingOff() {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Retry[-]      [%s]%s[-]",
+				theme.TagFgDim(), theme.TagWarning(), formatRetryCountdown(pa, now)))
+		}
+		if pa.LastHeartbeatTime != nil || pa.HeartbeatDetails != "" {
+			heartbeat := "never"
+			if pa.LastHeartbeatTime != nil {
+				heartbeat = formatRelativeTime(now, *pa.LastHeartbeatTime)
+			}
+			if pa.HeartbeatDetails != "" {
+				heartbeat += " " + tview.Escape(pa.HeartbeatDetails)
+			}
+			sb.WriteString(fmt.Sprintf("\n  [%s]Heartbeat[-]  [%s]%s[-]",
+				theme.TagFgDim(), theme.TagFg(), heartbeat))
+		}
+		if pa.LastFailure != "" {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Failure[-]    [%s]%s[-]",
+				theme.TagFgDim(), theme.TagError(), tview.Escape(pa.LastFailure)))
+		}
+	}
+	return sb.String()
+}
+
+// formatPendingChildren renders the child workflows the run is waiting on.
+func formatPendingChildren(children []temporal.PendingChild) string {
+	var sb strings.Builder
+	for i, pc := range children {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("\n[%s]%s %s[-] [%s]initiated #%d[-]",
+			temporal.StatusRunning.ColorTag(), icons.Workflow, tview.Escape(pc.WorkflowType),
+			theme.TagFgDim(), pc.InitiatedEventID))
+		sb.WriteString(fmt.Sprintf("\n  [%s]Workflow[-]   [%s]%s[-]",
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(pc.WorkflowID)))
+		if pc.RunID != "" {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Run[-]        [%s]%s[-]",
+				theme.TagFgDim(), theme.TagFgDim(), pc.RunID))
+		}
+	}
+	return sb.String()
+}
+
+// formatPendingNexusOperations renders the Nexus operations the run is
+// waiting on.
+func formatPendingNexusOperations(ops []temporal.PendingNexusOperation, now time.Time) string {
+	var sb strings.Builder
+	for i, op := range ops {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("\n[%s]%s %s.%s[-] [%s]%s · attempt %d[-]",
+			temporal.StatusRunning.ColorTag(), icons.Server, tview.Escape(op.Service), tview.Escape(op.Operation),
+			theme.TagFgDim(), op.State, op.Attempt))
+		sb.WriteString(fmt.Sprintf("\n  [%s]Endpoint[-]   [%s]%s[-]",
+			theme.TagFgDim(), theme.TagFg(), tview.Escape(op.Endpoint)))
+		if !op.ScheduledTime.IsZero() {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Scheduled[-]  [%s]%s[-]",
+				theme.TagFgDim(), theme.TagFg(), formatRelativeTime(now, op.ScheduledTime)))
+		}
+		if op.BlockedReason != "" {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Blocked[-]    [%s]%s[-]",
+				theme.TagFgDim(), theme.TagWarning(), tview.Escape(op.BlockedReason)))
+		}
+		if op.LastFailure != "" {
+			sb.WriteString(fmt.Sprintf("\n  [%s]Failure[-]    [%s]%s[-]",
+				theme.TagFgDim(), theme.TagError(), tview.Escape(op.LastFailure)))
+		}
+	}
+	return sb.String()
+}
+
+// formatPendingTimers renders the timers that have not fired yet.
+func formatPendingTimers(timers []temporal.PendingTimer, now time.Time) string {
+	var sb strings.Builder
+	for _, t := range timers {
+		when := fmt.Sprintf("started %s", formatRelativeTime(now, t.StartedTime))
+		if !t.FireTime.IsZero() {
+			if remaining := t.FireTime.Sub(now); remaining > 0 {
+				when = fmt.Sprintf("fires in %s", temporal.FormatDuration(remaining.Round(time.Second)))
+			} else {
+				when = "firing now"
+			}
+		}
+		sb.WriteString(fmt.Sprintf("\n[%s]%s %s[-] [%s]%s · #%d[-]",
+			temporal.StatusRunning.ColorTag(), icons.Pending, tview.Escape(t.TimerID),
+			theme.TagFgDim(), when, t.StartedEventID))
+	}
+	return sb.String()
+}
+
+// pendingSection renders a titled section of the pending panel.
+func pendingSection(title, body string) string {
+	return fmt.Sprintf("\n[%s::b]%s[-:-:-]%s\n", theme.TagFgDim(), title, body)
+}
+
+// renderPending fills the pending panel with what the run is waiting on:
+// activities, child workflows, Nexus operations, and timers. The panel is
+// hidden when nothing is pending.
+func (wd *WorkflowDetail) renderPending(now time.Time) {
+	w := wd.workflow
+	if w == nil {
+		wd.leftFlex.ResizeItem(wd.pendingPanel, 0, 0)
+		wd.pendingView.SetText("")
+		return
+	}
+	var timers []temporal.PendingTimer
+	if w.Status == "Running" {
+		timers = temporal.PendingTimers(wd.allEvents)
+	}
+
+	var sb strings.Builder
+	var counts []string
+	if n := len(w.PendingActivities); n > 0 {
+		sb.WriteString(pendingSection("Activities", formatPendingActivities(w.PendingActivities, now)))
+		counts = append(counts, pluralize(n, "activity", "activities"))
+	}
+	if n := len(w.PendingChildren); n > 0 {
+		sb.WriteString(pendingSection("Child Workflows", formatPendingChildren(w.PendingChildren)))
+		counts = append(counts, pluralize(n, "child", "children"))
+	}
+	if n := len(w.PendingNexusOperations); n > 0 {
+		sb.WriteString(pendingSection("Nexus Operations", formatPendingNexusOperations(w.PendingNexusOperations, now)))
+		counts = append(counts, pluralize(n, "Nexus operation", "Nexus operations"))
+	}
+	if n := len(timers); n > 0 {
+		sb.WriteString(pendingSection("Timers", formatPendingTimers(timers, now)))
+		counts = append(counts, pluralize(n, "timer", "timers"))
+	}
+
+	if len(counts) == 0 {
+		wd.leftFlex.ResizeItem(wd.pendingPanel, 0, 0)
+		wd.pendingView.SetText("")
+		return
+	}
+	wd.leftFlex.ResizeItem(wd.pendingPanel, 0, 1)
+	wd.pendingPanel.SetTitle(fmt.Sprintf("%s Pending: %s", icons.Pending, strings.Join(counts, " · ")))
+	wd.pendingView.SetText(strings.TrimPrefix(sb.String(), "\n"))
+}
+
+// pluralize formats a count with the singular or plural noun.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+// formatRetryCountdown describes when a backing-off activity will next be attempted,
+// e.g. "next retry in 12s (attempt 3/5)".
+func formatRetryCountdown(pa temporal.PendingActivity, now time.Time) string {
+	if pa.NextAttemptTime == nil {
+		return formatAttempt(pa)
+	}
+	remaining := pa.NextAttemptTime.Sub(now)
+	if remaining <= 0 {
+		return fmt.Sprintf("retrying now (%s)", formatAttempt(pa))
+	}
+	return fmt.Sprintf("next retry in %s (%s)", remaining.Round(time.Second), formatAttempt(pa))
+}
+
+// formatAttempt formats the attempt counter, using ∞ when retries are unlimited.
+func formatAttempt(pa temporal.PendingActivity) string {
+	if pa.MaximumAttempts > 0 {
+		return fmt.Sprintf("attempt %d/%d", pa.Attempt, pa.MaximumAttempts)
+	}
+	return fmt.Sprintf("attempt %d/∞", pa.Attempt)
+}
+
+// hasRetryCountdown returns true if any pending activity has a retry scheduled in the future.
+func (wd *WorkflowDetail) hasRetryCountdown() bool {
+	if wd.workflow == nil {
+		return false
+	}
+	now := time.Now()
+	for _, pa := range wd.workflow.PendingActivities {
+		if pa.IsBackingOff() && pa.NextAttemptTime.After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// startRetryCountdown re-renders the workflow panel every second while an
+// activity is backing off, so the retry countdown stays live.
+func (wd *WorkflowDetail) startRetryCountdown() {
+	if wd.countdownStop != nil || !wd.hasRetryCountdown() {
+		return
+	}
+
+	stop := make(chan struct{})
+	wd.countdownStop = stop
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				wd.app.JigApp().QueueUpdateDraw(func() {
+					if wd.countdownStop != stop {
+						return
+					}
+					wd.render()
+					if !wd.hasRetryCountdown() {
+						wd.stopRetryCountdown()
+					}
+				})
+			}
+		}
+	}()
+}
+
+// stopRetryCountdown stops the retry countdown ticker if it is running.
+func (wd *WorkflowDetail) stopRetryCountdown() {
+	if wd.countdownStop != nil {
+		close(wd.countdownStop)
+		wd.countdownStop = nil
+	}
+}
+
+// showPendingActivityPicker lets the user choose a pending activity to cancel.
+func (wd *WorkflowDetail) showPendingActivityPicker() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
+	if wd.workflow == nil || wd.workflow.Status != "Running" || len(wd.workflow.PendingActivities) == 0 {
+		return
+	}
+	activities := wd.workflow.PendingActivities
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Cancel Pending Activity", icons.Activity),
+		Width:     90,
+		Height:    16,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("ACTIVITY ID", "TYPE", "STATE", "ATTEMPT")
+	table.SetBackgroundColor(theme.Bg())
+
+	for _, pa := range activities {
+		table.AddRow(
+			truncateStr(pa.ActivityID, 20),
+			truncateStr(pa.ActivityType, 30),
+			pa.State,
+			formatAttempt(pa),
+		)
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			row := table.SelectedRow()
+			if row >= 0 && row < len(activities) {
+				wd.closeModal()
+				wd.showActivityCancelConfirm(activities[row])
+			}
+			return nil
+		case tcell.KeyEscape:
+			wd.closeModal()
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == 'q' {
+				wd.closeModal()
+				return nil
+			}
+		}
+		return event
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Select"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal()
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(table)
+}
+
+// showActivityCancelConfirm asks for a reason before canceling a pending activity.
+// Cancellation is performed by pausing the activity on the server, so the note
+// explains what the workflow will observe.
+func (wd *WorkflowDetail) showActivityCancelConfirm(pa temporal.PendingActivity) {
+	form := components.NewFormBuilder().
+		Text("reason", "Reason (optional)").
+		Value("Cancelled via tempo").
+		Done().
+		OnSubmit(func(values map[string]any) {
+			reason := values["reason"].(string)
+			wd.closeModal()
+			wd.executeActivityCancel(pa, reason)
+		}).
+		OnCancel(func() {
+			wd.closeModal()
+		}).
+		Build()
+
+	note := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	note.SetBackgroundColor(theme.Bg())
+	note.SetText(fmt.Sprintf("[%s]%s[-] [%s](%s)[-]\n[%s]The activity is paused: no further attempts are made and a heartbeating activity is cancelled. The workflow still sees it as pending.[-]",
+		theme.TagFg(), tview.Escape(pa.ActivityType), theme.TagFgDim(), tview.Escape(pa.ActivityID), theme.TagFgDim()))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(note, 4, 0, false).
+		AddItem(form, 0, 1, true)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Cancel Activity", icons.Warning),
+		Width:    70,
+		Height:   14,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Confirm"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(form)
+}
+
+// executeActivityCancel requests cancellation of a pending activity and refreshes the view.
+func (wd *WorkflowDetail) executeActivityCancel(pa temporal.PendingActivity, reason string) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := provider.RequestActivityCancel(
+			ctx,
+			wd.app.CurrentNamespace(),
+			wd.workflowID,
+			wd.runID,
+			pa.ActivityID,
+			reason,
+		)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showError(err)
+				return
+			}
+			wd.app.ToastSuccess(fmt.Sprintf("Activity %s paused", pa.ActivityType))
+			wd.loadData()
+		})
+	}()
+}
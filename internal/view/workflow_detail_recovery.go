@@ -0,0 +1,142 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/rivo/tview"
+)
+
+// recoveryAction returns the configured recovery action for the current workflow's type.
+func (wd *WorkflowDetail) recoveryAction() (config.RecoveryAction, bool) {
+	cfg := wd.app.Config()
+	if cfg == nil || wd.workflow == nil {
+		return config.RecoveryAction{}, false
+	}
+	return cfg.RecoveryActionFor(wd.workflow.Type)
+}
+
+// showRecoveryConfirm previews the recovery action configured for this
+// workflow type and runs it on confirmation.
+func (wd *WorkflowDetail) showRecoveryConfirm() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
+	if wd.workflow == nil {
+		return
+	}
+	action, ok := wd.recoveryAction()
+	if !ok {
+		wd.app.ToastWarning(fmt.Sprintf("No recovery action configured for %s", wd.workflow.Type))
+		return
+	}
+	if err := action.Validate(); err != nil {
+		wd.app.ToastError(fmt.Sprintf("%s: %s", wd.workflow.Type, err.Error()))
+		return
+	}
+
+	namespace := wd.app.CurrentNamespace()
+	var confirm confirmation
+	var steps string
+	switch action.Action {
+	case config.RecoveryActionSignal:
+		if wd.workflow.Status != "Running" {
+			wd.app.ToastWarning("Signal recovery requires a running workflow")
+			return
+		}
+		steps = fmt.Sprintf("Send signal [%s]%s[-] to run %s", theme.TagAccent(), tview.Escape(action.Signal), truncateStr(wd.runID, 25))
+		if action.Input != "" {
+			steps += fmt.Sprintf("\nInput: [%s]%s[-]", theme.TagFgDim(), tview.Escape(action.Input))
+		}
+	case config.RecoveryActionRerun:
+		if unavailable := wd.rerunUnavailable(); unavailable != "" {
+			wd.app.ToastWarning(unavailable)
+			return
+		}
+		steps = "Start a new run with the original input"
+		if wd.workflow.Status == "Running" {
+			steps = fmt.Sprintf("Terminate run %s, then start a new run with the original input", truncateStr(wd.runID, 25))
+			confirm = wd.app.confirmationFor("terminate", namespace, "")
+		}
+	}
+
+	description := action.Description
+	if description == "" {
+		description = fmt.Sprintf("Recovery runbook for %s", wd.workflow.Type)
+	}
+
+	text := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	text.SetBackgroundColor(theme.Bg())
+	text.SetText(fmt.Sprintf("[%s::b]%s[-:-:-]\n\n[%s]%s[-]",
+		theme.TagFg(), tview.Escape(description), theme.TagFg(), steps) + confirm.warning(namespace))
+
+	modalHeight := 12
+	if confirm.required() {
+		modalHeight += 5
+	}
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Recover %s", icons.Warning, wd.workflow.Type),
+		Width:    70,
+		Height:   modalHeight,
+		Backdrop: true,
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal()
+	})
+
+	// Terminating a run in a danger namespace needs the typed phrase
+	if confirm.required() {
+		form := confirm.addField(components.NewFormBuilder(), "").
+			OnSubmit(func(values map[string]any) {
+				if !confirm.matches(values["confirm"].(string)) {
+					return
+				}
+				wd.closeModal()
+				wd.executeRecovery(action)
+			}).
+			OnCancel(func() {
+				wd.closeModal()
+			}).
+			Build()
+
+		content := tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(text, 0, 1, false).
+			AddItem(form, 4, 0, true)
+		content.SetBackgroundColor(theme.Bg())
+
+		modal.SetContent(content)
+		modal.SetHints([]components.KeyHint{
+			{Key: "Ctrl+S", Description: "Run"},
+			{Key: "Esc", Description: "Cancel"},
+		})
+		wd.app.JigApp().Pages().Push(modal)
+		wd.app.JigApp().SetFocus(form)
+		return
+	}
+
+	modal.SetContent(text)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Run"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		wd.closeModal()
+		wd.executeRecovery(action)
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+}
+
+// executeRecovery runs a validated recovery action against the current workflow.
+func (wd *WorkflowDetail) executeRecovery(action config.RecoveryAction) {
+	switch action.Action {
+	case config.RecoveryActionSignal:
+		wd.executeSignalWorkflow(action.Signal, action.Input)
+	case config.RecoveryActionRerun:
+		wd.executeTerminateAndRerun(fmt.Sprintf("Recovery action for %s via tempo", wd.workflow.Type))
+	}
+}
@@ -0,0 +1,291 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// remediation is one of the guided fixes offered for a non-determinism failure.
+type remediation struct {
+	label    string
+	summary  string
+	disabled string // Why the option is unavailable, empty if it can be used
+	preview  string
+	action   string // Danger confirmation action, e.g. "terminate", empty if none
+	run      func(reason string)
+}
+
+// hasNonDeterminismFailure returns true if the loaded history contains a
+// workflow task that failed due to non-determinism.
+func (wd *WorkflowDetail) hasNonDeterminismFailure() bool {
+	_, ok := temporal.FirstNonDeterminismFailure(wd.allEvents)
+	return ok
+}
+
+// showRemediation offers one-step fixes for a workflow broken by a bad deploy:
+// reset to the last good workflow task, or terminate and re-run with the
+// original input.
+func (wd *WorkflowDetail) showRemediation() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+
+	if wd.workflow == nil {
+		return
+	}
+	failure, ok := temporal.FirstNonDeterminismFailure(wd.allEvents)
+	if !ok {
+		wd.app.ToastWarning("No non-determinism failure in this history")
+		return
+	}
+	options := wd.remediations(failure)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Remediate Non-Determinism", icons.Warning),
+		Width:     90,
+		Height:    12,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	note := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	note.SetBackgroundColor(theme.Bg())
+	note.SetText(fmt.Sprintf("[%s]Workflow task failed at event %d:[-] [%s]%s[-]",
+		theme.TagFgDim(), failure.ID, theme.TagError(), tview.Escape(truncateStr(failure.Failure, 120))))
+
+	table := components.NewTable()
+	table.SetHeaders("ACTION", "WHAT HAPPENS")
+	table.SetBackgroundColor(theme.Bg())
+	for _, opt := range options {
+		if opt.disabled != "" {
+			table.AddRowWithColor(theme.FgDim(), opt.label, opt.disabled)
+		} else {
+			table.AddRow(opt.label, opt.summary)
+		}
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			row := table.SelectedRow()
+			if row >= 0 && row < len(options) {
+				opt := options[row]
+				if opt.disabled != "" {
+					wd.app.ToastWarning(opt.disabled)
+					return nil
+				}
+				wd.closeModal()
+				wd.showRemediationConfirm(opt)
+			}
+			return nil
+		case tcell.KeyEscape:
+			wd.closeModal()
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == 'q' {
+				wd.closeModal()
+				return nil
+			}
+		}
+		return event
+	})
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(note, 2, 0, false).
+		AddItem(table, 0, 1, true)
+
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Select"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal()
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(table)
+}
+
+// remediations builds the available fixes for a non-determinism failure.
+func (wd *WorkflowDetail) remediations(failure temporal.EnhancedHistoryEvent) []remediation {
+	w := wd.workflow
+	var options []remediation
+
+	reset := remediation{label: "Reset to last good task"}
+	if rp, ok := temporal.LastGoodWorkflowTask(wd.allEvents, failure.ID); ok {
+		reset.label = fmt.Sprintf("Reset to event %d", rp.EventID)
+		reset.summary = "New run replays history up to the last good workflow task"
+		reset.preview = fmt.Sprintf(`[%s]Reset to event %d[-] [%s](%s, %s)[-]
+
+[%s]- The current run is terminated.
+- A new run replays history up to event %d using the deployed code.
+- Events after %d, including the failed task at event %d, are discarded and re-executed.[-]`,
+			theme.TagAccent(), rp.EventID, theme.TagFgDim(), rp.EventType, rp.Timestamp.Format("2006-01-02 15:04:05"),
+			theme.TagFg(), rp.EventID, rp.EventID, failure.ID)
+		eventID := rp.EventID
+		reset.run = func(reason string) {
+			wd.executeResetWorkflow(eventID, reason, nil)
+		}
+	} else {
+		reset.disabled = "No completed workflow task before the failure"
+	}
+	options = append(options, reset)
+
+	rerun := remediation{
+		label:   "Terminate and re-run",
+		summary: "Start a fresh run with the original input",
+	}
+	if unavailable := wd.rerunUnavailable(); unavailable != "" {
+		rerun.disabled = unavailable
+	} else {
+		steps := fmt.Sprintf("- A new run of %s starts on %s with workflow ID %s.\n- The original input is resent as recorded; completed activities run again.",
+			w.Type, w.TaskQueue, w.ID)
+		if w.Status == "Running" {
+			steps = fmt.Sprintf("- Run %s is terminated.\n", w.RunID) + steps
+			rerun.action = "terminate"
+		}
+		rerun.preview = fmt.Sprintf("[%s]Terminate and re-run[-]\n\n[%s]%s[-]",
+			theme.TagAccent(), theme.TagFg(), tview.Escape(steps))
+		rerun.run = func(reason string) {
+			wd.executeTerminateAndRerun(reason)
+		}
+	}
+	options = append(options, rerun)
+
+	return options
+}
+
+// showRemediationConfirm previews what a remediation will do and asks for a reason.
+func (wd *WorkflowDetail) showRemediationConfirm(opt remediation) {
+	namespace := wd.app.CurrentNamespace()
+	var confirm confirmation
+	if opt.action != "" {
+		confirm = wd.app.confirmationFor(opt.action, namespace, "")
+	}
+
+	builder := components.NewFormBuilder().
+		Text("reason", "Reason").
+		Value("Remediated non-determinism via tempo").
+		Done()
+	modalHeight := 16
+	if confirm.required() {
+		builder = confirm.addField(builder, "")
+		modalHeight += 4
+	}
+
+	form := builder.
+		OnSubmit(func(values map[string]any) {
+			if confirm.required() && !confirm.matches(values["confirm"].(string)) {
+				return
+			}
+			wd.closeModal()
+			opt.run(values["reason"].(string))
+		}).
+		OnCancel(func() {
+			wd.closeModal()
+		}).
+		Build()
+
+	preview := tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	preview.SetBackgroundColor(theme.Bg())
+	preview.SetText(opt.preview + confirm.warning(namespace))
+
+	previewHeight := 7
+	if confirm.danger {
+		previewHeight++
+		modalHeight++
+	}
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(preview, previewHeight, 0, false).
+		AddItem(form, 0, 1, true)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Confirm: %s", icons.Warning, opt.label),
+		Width:    80,
+		Height:   modalHeight,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Ctrl+S", Description: "Confirm"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(form)
+}
+
+// rerunUnavailable returns why the original input cannot be resent, or ""
+// when a re-run can start with it.
+func (wd *WorkflowDetail) rerunUnavailable() string {
+	if wd.inputMissing {
+		return "Original input was not loaded with the recent history"
+	}
+	if input := strings.TrimSpace(wd.workflow.Input); input != "" && !json.Valid([]byte(input)) {
+		return "Original input is not JSON and cannot be resent"
+	}
+	return ""
+}
+
+// executeTerminateAndRerun terminates the current run if it is still running,
+// then starts a new run with the same ID, type, task queue, and input. Nothing
+// is terminated when the input could not be resent.
+func (wd *WorkflowDetail) executeTerminateAndRerun(reason string) {
+	provider := wd.app.Provider()
+	if provider == nil || wd.workflow == nil {
+		return
+	}
+	if unavailable := wd.rerunUnavailable(); unavailable != "" {
+		wd.app.ToastWarning(unavailable)
+		return
+	}
+	w := *wd.workflow
+	namespace := wd.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var err error
+		if w.Status == "Running" {
+			if err = provider.TerminateWorkflow(ctx, namespace, w.ID, w.RunID, reason); err != nil {
+				err = fmt.Errorf("terminate failed, nothing was started: %w", err)
+			}
+		}
+
+		var newRunID string
+		if err == nil {
+			newRunID, err = provider.StartWorkflow(ctx, namespace, temporal.StartWorkflowRequest{
+				WorkflowID:   w.ID,
+				WorkflowType: w.Type,
+				TaskQueue:    w.TaskQueue,
+				Input:        []byte(strings.TrimSpace(w.Input)),
+			})
+			if err != nil && w.Status == "Running" {
+				err = fmt.Errorf("run was terminated but re-run failed to start: %w", err)
+			}
+		}
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showError(err)
+				return
+			}
+			wd.app.ToastSuccess(fmt.Sprintf("Started new run %s", truncateStr(newRunID, 12)))
+			wd.runID = newRunID
+			wd.loadData()
+		})
+	}()
+}
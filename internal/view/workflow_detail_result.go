@@ -0,0 +1,106 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/rivo/tview"
+)
+
+// waitSpinnerFrames animate the wait for a workflow result.
+var waitSpinnerFrames = []string{"|", "/", "-", "\\"}
+
+// showWaitForResult waits for a running workflow to close, with a spinner
+// and the time waited so far, then shows its result or failure. Esc stops
+// waiting; the workflow itself is not affected.
+func (wd *WorkflowDetail) showWaitForResult() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		wd.app.ToastWarning("Waiting for a result needs a server connection")
+		return
+	}
+	if wd.workflow != nil && wd.workflow.Status != "Running" {
+		wd.app.ToastWarning(fmt.Sprintf("Workflow is already %s; press i for its result", wd.workflow.Status))
+		return
+	}
+
+	namespace := wd.app.CurrentNamespace()
+	workflowID, runID := wd.workflowID, wd.runID
+
+	status := tview.NewTextView().SetDynamicColors(true)
+	status.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Waiting for Result: %s", icons.Running, workflowID),
+		Width:    70,
+		Height:   8,
+		Backdrop: true,
+	})
+	modal.SetContent(status)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Esc", Description: "Stop waiting"},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	modal.SetOnCancel(func() {
+		cancel()
+		wd.closeModal()
+	})
+
+	started := time.Now()
+	frame := 0
+	showWaiting := func() {
+		status.SetText(fmt.Sprintf("\n [%s]%s[-] [%s]Waiting for the workflow to close... %s[-]\n\n [%s]Continue-as-new is followed to the final run.[-]",
+			theme.TagAccent(), waitSpinnerFrames[frame%len(waitSpinnerFrames)],
+			theme.TagFg(), time.Since(started).Truncate(time.Second),
+			theme.TagFgDim()))
+	}
+	showWaiting()
+
+	// Animate the spinner until the wait ends
+	go func() {
+		ticker := time.NewTicker(250 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				wd.app.JigApp().QueueUpdateDraw(func() {
+					if ctx.Err() == nil {
+						frame++
+						showWaiting()
+					}
+				})
+			}
+		}
+	}()
+
+	go func() {
+		result, err := provider.WaitForWorkflowResult(ctx, namespace, workflowID, runID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return // Stopped waiting
+			}
+			cancel()
+			wd.closeModal()
+			switch {
+			case err != nil:
+				wd.showResultError("Workflow", workflowID, err.Error())
+			case result.Failure != "":
+				wd.showResultError("Workflow", workflowID, result.Failure)
+			default:
+				wd.showResult("Workflow", workflowID, result.Result)
+			}
+			wd.loadData() // Refresh to show how it closed
+		})
+	}()
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(modal)
+}
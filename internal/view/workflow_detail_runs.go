@@ -0,0 +1,148 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// runChainLimit caps how many runs of a workflow ID are listed to place the
+// current run in its chain.
+const runChainLimit = 1000
+
+// loadRunChain lists the runs of the workflow ID, so the detail can show
+// where this run sits in its chain and step to its neighbours. Failing to
+// list them leaves only the links recorded in history.
+func (wd *WorkflowDetail) loadRunChain(provider temporal.Provider, namespace string) {
+	if wd.workflow == nil {
+		return
+	}
+	current := *wd.workflow
+	pageSize := wd.app.PageSize()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		var runs []temporal.Workflow
+		complete := true
+		token := ""
+		for {
+			page, next, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{
+				PageSize:  pageSize,
+				PageToken: token,
+				Query:     fmt.Sprintf("WorkflowId = '%s'", current.ID),
+			})
+			if err != nil {
+				return
+			}
+			runs = append(runs, page...)
+			if next == "" {
+				break
+			}
+			if len(runs) >= runChainLimit {
+				complete = false
+				break
+			}
+			token = next
+		}
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if wd.workflow == nil || wd.workflow.RunID != current.RunID {
+				return
+			}
+			wd.runChain, wd.runIndex = temporal.RunChain(runs, current)
+			wd.runChainComplete = complete
+			wd.render()
+			wd.app.setFooterHints(wd.Hints())
+		})
+	}()
+}
+
+// previousRunID returns the run this one continued from, or else the run of
+// the same workflow ID that started before it, or "" if there is none.
+func (wd *WorkflowDetail) previousRunID() string {
+	for _, ev := range wd.allEvents {
+		if ev.Type == "WorkflowExecutionStarted" && ev.ContinuedExecutionRunID != "" {
+			return ev.ContinuedExecutionRunID
+		}
+	}
+	if wd.runIndex > 0 && wd.runIndex < len(wd.runChain) {
+		return wd.runChain[wd.runIndex-1].RunID
+	}
+	return ""
+}
+
+// nextRunID returns the run this one continued as, or else the run of the
+// same workflow ID that started after it, or "" if there is none.
+func (wd *WorkflowDetail) nextRunID() string {
+	if runID := wd.continuedAsNewRunID(); runID != "" {
+		return runID
+	}
+	if wd.runIndex >= 0 && wd.runIndex+1 < len(wd.runChain) {
+		return wd.runChain[wd.runIndex+1].RunID
+	}
+	return ""
+}
+
+// openAdjacentRun opens the previous (dir < 0) or next run in the chain.
+func (wd *WorkflowDetail) openAdjacentRun(dir int) {
+	if wd.workflow == nil {
+		return
+	}
+	runID, label := wd.nextRunID(), "later"
+	if dir < 0 {
+		runID, label = wd.previousRunID(), "earlier"
+	}
+	if runID == "" {
+		wd.app.ToastWarning(fmt.Sprintf("No %s run of this workflow ID", label))
+		return
+	}
+	wd.app.NavigateToWorkflowDetail(wd.workflow.ID, runID)
+}
+
+// openLatestRun opens the most recent run of the workflow ID, for when the
+// run being shown has since continued as new or been reset.
+func (wd *WorkflowDetail) openLatestRun() {
+	provider := wd.app.Provider()
+	if provider == nil || wd.workflow == nil {
+		return
+	}
+	workflowID, runID := wd.workflow.ID, wd.workflow.RunID
+	namespace := wd.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		latest, err := provider.GetWorkflow(ctx, namespace, workflowID, "")
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			switch {
+			case err != nil:
+				wd.app.ToastError(fmt.Sprintf("Failed to find the latest run: %v", err))
+			case latest.RunID == runID:
+				wd.app.ToastSuccess("This is the latest run")
+			default:
+				wd.app.NavigateToWorkflowDetail(workflowID, latest.RunID)
+			}
+		})
+	}()
+}
+
+// formatRunChain renders the run's position in its chain, e.g. "run 3 of 7",
+// or "" when the workflow ID has a single run.
+func (wd *WorkflowDetail) formatRunChain() string {
+	if wd.runIndex < 0 || wd.runIndex >= len(wd.runChain) || len(wd.runChain) < 2 {
+		return ""
+	}
+	position := fmt.Sprintf("run %d of %d", wd.runIndex+1, len(wd.runChain))
+	if !wd.runChainComplete {
+		position = fmt.Sprintf("run %d of the latest %d", wd.runIndex+1, len(wd.runChain))
+	}
+	return fmt.Sprintf("\n[%s::b]Chain[-:-:-]        [%s]%s[-] [%s]([ / ] to step)[-]",
+		theme.TagFgDim(), theme.TagFg(), position, theme.TagFgDim())
+}
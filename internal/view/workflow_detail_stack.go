@@ -0,0 +1,268 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// stackTraceQuery is the built-in query that returns a workflow's
+// goroutine or coroutine stacks.
+const stackTraceQuery = "__stack_trace"
+
+// stackTracePollInterval is how often the live stack trace re-runs the query.
+const stackTracePollInterval = 2 * time.Second
+
+// stackTraceText unwraps a stack trace query result, which is a JSON string.
+func stackTraceText(result string) string {
+	var trace string
+	if err := json.Unmarshal([]byte(result), &trace); err == nil {
+		return trace
+	}
+	return result
+}
+
+// formatStackTraceDiff renders a stack trace, marking the lines that were
+// not in the previous one so changes between polls stand out.
+func formatStackTraceDiff(trace, previous string) string {
+	seen := make(map[string]bool)
+	if previous != "" {
+		for _, line := range strings.Split(previous, "\n") {
+			seen[line] = true
+		}
+	}
+
+	var sb strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(trace, "\n"), "\n") {
+		if previous != "" && !seen[line] {
+			sb.WriteString(fmt.Sprintf("[%s]+ %s[-]\n", theme.TagWarning(), tview.Escape(line)))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("[%s]  %s[-]\n", theme.TagFg(), tview.Escape(line)))
+	}
+	return sb.String()
+}
+
+// pollingModal is a modal that stops its poller when it leaves the page
+// stack, however it was dismissed.
+type pollingModal struct {
+	*components.Modal
+	stop func()
+}
+
+// Stop implements nav.Component.
+func (m *pollingModal) Stop() {
+	m.stop()
+}
+
+// showLiveStackTrace runs the __stack_trace query every couple of seconds
+// and shows the latest result, with lines that changed since the previous
+// poll marked. Polling stops when the modal is closed or the workflow is no
+// longer running.
+func (wd *WorkflowDetail) showLiveStackTrace() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		wd.app.ToastWarning("Stack traces need a server connection")
+		return
+	}
+	if wd.workflow == nil || wd.workflow.Status != "Running" {
+		wd.app.ToastWarning("Stack traces are only available for running workflows")
+		return
+	}
+
+	namespace := wd.app.CurrentNamespace()
+	workflowID, runID := wd.workflowID, wd.runID
+
+	status := tview.NewTextView().SetDynamicColors(true)
+	status.SetBackgroundColor(theme.Bg())
+	status.SetText(fmt.Sprintf("[%s]Querying %s...[-]", theme.TagFgDim(), stackTraceQuery))
+
+	traceView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(false)
+	traceView.SetBackgroundColor(theme.Bg())
+	traceView.SetTextColor(theme.Fg())
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow)
+	content.SetBackgroundColor(theme.Bg())
+	content.AddItem(status, 1, 0, false)
+	content.AddItem(traceView, 0, 1, true)
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Stack Trace: %s", icons.Running, workflowID),
+		MinWidth:  100,
+		MinHeight: 25,
+		Backdrop:  true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Scroll"},
+		{Key: "space", Description: "Pause/Resume"},
+		{Key: "y", Description: "Copy"},
+		{Key: "Esc", Description: "Close"},
+	})
+
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	closeTrace := func() {
+		wd.closeModal()
+	}
+	modal.SetOnCancel(closeTrace)
+
+	// paused and stopped are read by the polling goroutine
+	var (
+		current   string
+		previous  string
+		polls     int
+		unchanged int
+		paused    atomic.Bool
+		stopped   atomic.Bool
+	)
+	showStatus := func(msg string) {
+		state := fmt.Sprintf("polling every %s", stackTracePollInterval)
+		if paused.Load() {
+			state = "paused"
+		}
+		if stopped.Load() {
+			state = "stopped"
+		}
+		status.SetText(fmt.Sprintf("[%s]%s · %s[-]", theme.TagFgDim(), msg, state))
+	}
+
+	// apply runs on the UI goroutine with the outcome of one poll
+	apply := func(trace, wfStatus string, err error) {
+		if stopped.Load() {
+			return
+		}
+		polls++
+		at := time.Now().Format("15:04:05")
+		switch {
+		case err != nil:
+			showStatus(fmt.Sprintf("[%s]%s[-] [%s]at %s", theme.TagError(), tview.Escape(err.Error()), theme.TagFgDim(), at))
+			return
+		case wfStatus != "" && wfStatus != "Running":
+			stopped.Store(true)
+			showStatus(fmt.Sprintf("Workflow is %s, last trace from before it closed", wfStatus))
+			return
+		}
+
+		if trace == current {
+			unchanged++
+			showStatus(fmt.Sprintf("Updated %s, unchanged for %d polls", at, unchanged))
+			return
+		}
+		previous, current, unchanged = current, trace, 0
+		row, col := traceView.GetScrollOffset()
+		traceView.SetText(formatStackTraceDiff(current, previous))
+		traceView.ScrollTo(row, col)
+		if polls == 1 {
+			showStatus(fmt.Sprintf("Updated %s", at))
+		} else {
+			showStatus(fmt.Sprintf("Updated %s, changed lines marked +", at))
+		}
+	}
+
+	poll := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var trace, wfStatus string
+		result, err := provider.QueryWorkflow(ctx, namespace, workflowID, runID, stackTraceQuery, nil)
+		if err == nil && result.Error != "" {
+			err = fmt.Errorf("%s", result.Error)
+		}
+		if err == nil {
+			trace = stackTraceText(result.Result)
+			if wf, descErr := provider.GetWorkflow(ctx, namespace, workflowID, runID); descErr == nil {
+				wfStatus = wf.Status
+			}
+		}
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			apply(trace, wfStatus, err)
+		})
+	}
+
+	go func() {
+		poll()
+		ticker := time.NewTicker(stackTracePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if paused.Load() || stopped.Load() {
+					continue
+				}
+				poll()
+			}
+		}
+	}()
+
+	traceView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			closeTrace()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'j':
+				row, col := traceView.GetScrollOffset()
+				traceView.ScrollTo(row+1, col)
+				return nil
+			case 'k':
+				row, col := traceView.GetScrollOffset()
+				if row > 0 {
+					traceView.ScrollTo(row-1, col)
+				}
+				return nil
+			case 'g':
+				traceView.ScrollTo(0, 0)
+				return nil
+			case 'G':
+				traceView.ScrollToEnd()
+				return nil
+			case ' ':
+				if !stopped.Load() {
+					paused.Store(!paused.Load())
+					showStatus(fmt.Sprintf("%d polls", polls))
+				}
+				return nil
+			case 'y':
+				if current == "" {
+					return nil
+				}
+				if err := copyToClipboard(current); err != nil {
+					wd.app.ToastError(fmt.Sprintf("Failed to copy: %v", err))
+				} else {
+					wd.app.ToastSuccess("Stack trace copied")
+				}
+				return nil
+			case 'q':
+				closeTrace()
+				return nil
+			}
+		}
+		return event
+	})
+
+	wd.app.JigApp().Pages().Push(&pollingModal{
+		Modal: modal,
+		stop: func() {
+			stopOnce.Do(func() { close(stop) })
+		},
+	})
+	wd.app.JigApp().SetFocus(traceView)
+}
@@ -0,0 +1,113 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+)
+
+// updateTimeout bounds how long tempo waits for an update handler to finish.
+const updateTimeout = 30 * time.Second
+
+// showUpdateInput asks for an update name and input, then sends the update
+// and waits for its result.
+func (wd *WorkflowDetail) showUpdateInput() {
+	if wd.app.blockReadOnly() {
+		return
+	}
+	if wd.workflow != nil && wd.workflow.Status != "Running" {
+		wd.app.ToastWarning(fmt.Sprintf("Workflow is %s; only running workflows accept updates", wd.workflow.Status))
+		return
+	}
+
+	form := components.NewFormBuilder().
+		Text("updateName", "Update Name").
+		Placeholder("Enter update handler name").
+		Validate(validators.Required()).
+		Done().
+		Text("args", "Arguments (JSON, optional)").
+		Placeholder("{}").
+		Done().
+		OnSubmit(func(values map[string]any) {
+			updateName := values["updateName"].(string)
+			args := values["args"].(string)
+			wd.closeModal()
+			wd.executeUpdate(updateName, args)
+		}).
+		OnCancel(func() {
+			wd.closeModal()
+		}).
+		Build()
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Update Workflow", icons.Signal),
+		Width:    70,
+		Height:   16,
+		Backdrop: true,
+	})
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+S", Description: "Send update"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wd.app.JigApp().Pages().Push(modal)
+	wd.app.JigApp().SetFocus(form)
+}
+
+// executeUpdate sends the update and shows its result, or why the workflow
+// rejected or failed it.
+func (wd *WorkflowDetail) executeUpdate(updateName, args string) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+	workflowID, runID := wd.workflowID, wd.runID
+	wd.app.ToastSuccess(fmt.Sprintf("Sent update %s, waiting for result...", updateName))
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), updateTimeout)
+		defer cancel()
+
+		var argsBytes []byte
+		if args != "" {
+			argsBytes = []byte(args)
+		}
+
+		result, err := provider.UpdateWorkflow(
+			ctx,
+			wd.app.CurrentNamespace(),
+			workflowID,
+			runID,
+			updateName,
+			argsBytes,
+		)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if errors.Is(err, context.DeadlineExceeded) {
+				// The update may still complete; its events show in the history
+				wd.app.RecordSentOp(sentUpdate, workflowID, runID, updateName)
+				wd.app.ToastWarning(fmt.Sprintf("Update %s may still be running: timed out waiting %s for its result", updateName, updateTimeout))
+				wd.loadData()
+				return
+			}
+			if err != nil {
+				wd.showResultError("Update", updateName, err.Error())
+				return
+			}
+			wd.app.RecordSentOp(sentUpdate, workflowID, runID, updateName)
+			if result.Failure != "" {
+				wd.showResultError("Update", updateName, result.Failure)
+			} else {
+				wd.showResult("Update", updateName, result.Result)
+			}
+			wd.loadData() // Refresh to show the update events
+		})
+	}()
+}
@@ -1,14 +1,18 @@
 package view
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -73,11 +77,11 @@ func (wd *WorkflowDiff) setup() {
 	wd.leftEvents.SetHeaders("EVENT", "TYPE", "TIME")
 
 	leftContent := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(wd.leftInfo, 8, 0, false).
+		AddItem(wd.leftInfo, 10, 0, false).
 		AddItem(wd.leftEvents, 0, 1, true)
 	leftContent.SetBackgroundColor(theme.Bg())
 
-	wd.leftPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow A", theme.IconWorkflow))
+	wd.leftPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow A", icons.Workflow))
 	wd.leftPanel.SetContent(leftContent)
 
 	// Create right side components
@@ -87,11 +91,11 @@ func (wd *WorkflowDiff) setup() {
 	wd.rightEvents.SetHeaders("EVENT", "TYPE", "TIME")
 
 	rightContent := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(wd.rightInfo, 8, 0, false).
+		AddItem(wd.rightInfo, 10, 0, false).
 		AddItem(wd.rightEvents, 0, 1, true)
 	rightContent.SetBackgroundColor(theme.Bg())
 
-	wd.rightPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow B", theme.IconWorkflow))
+	wd.rightPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow B", icons.Workflow))
 	wd.rightPanel.SetContent(rightContent)
 
 	// Build layout
@@ -104,6 +108,11 @@ func (wd *WorkflowDiff) Name() string {
 	return "workflow-diff"
 }
 
+// Reload reloads both workflows.
+func (wd *WorkflowDiff) Reload() {
+	wd.loadData()
+}
+
 // Start is called when the view becomes active.
 func (wd *WorkflowDiff) Start() {
 	wd.leftEvents.SetInputCapture(wd.inputHandler)
@@ -139,6 +148,12 @@ func (wd *WorkflowDiff) RefreshTheme() {
 	wd.rightEvents.SetBackgroundColor(bg)
 
 	// Re-render content with new theme colors
+	wd.updateComparison()
+}
+
+// updateComparison re-renders both sides. Each side marks what differs from
+// the other, so both must be redrawn whenever either changes.
+func (wd *WorkflowDiff) updateComparison() {
 	wd.updateLeftInfo()
 	wd.updateRightInfo()
 	wd.updateLeftEvents()
@@ -151,6 +166,7 @@ func (wd *WorkflowDiff) Hints() []KeyHint {
 		{Key: "Tab", Description: "Switch Panel"},
 		{Key: "a", Description: "Set Left"},
 		{Key: "b", Description: "Set Right"},
+		{Key: "h", Description: "Diff History Files"},
 		{Key: "r", Description: "Refresh"},
 		{Key: "esc", Description: "Back"},
 	}
@@ -191,6 +207,10 @@ func (wd *WorkflowDiff) inputHandler(event *tcell.EventKey) *tcell.EventKey {
 		OnRune('r', func(e *tcell.EventKey) bool {
 			wd.loadData()
 			return true
+		}).
+		OnRune('h', func(e *tcell.EventKey) bool {
+			wd.promptHistoryFiles()
+			return true
 		})
 
 	if bindings.Handle(event) {
@@ -218,7 +238,8 @@ func (wd *WorkflowDiff) showEmptyState() {
 [%s]No workflows selected for comparison.[-]
 
 [%s]Press 'a' to set the left workflow
-Press 'b' to set the right workflow[-]`,
+Press 'b' to set the right workflow
+Press 'h' to compare two exported history files[-]`,
 		theme.TagAccent(),
 		theme.TagFgDim(),
 		theme.TagFg())
@@ -236,7 +257,7 @@ func (wd *WorkflowDiff) promptWorkflowInput(isLeft bool) {
 	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Set %s Workflow", theme.IconWorkflow, side),
+		Title:    fmt.Sprintf("%s Set %s Workflow", icons.Workflow, side),
 		Width:    70,
 		Height:   14,
 		Backdrop: true,
@@ -305,16 +326,13 @@ func (wd *WorkflowDiff) loadWorkflow(isLeft bool, workflowID, runID string) {
 			if isLeft {
 				wd.workflowA = workflow
 				wd.eventsA = events
-				wd.leftPanel.SetTitle(fmt.Sprintf("%s Workflow A: %s", theme.IconWorkflow, truncate(workflow.ID, 25)))
-				wd.updateLeftInfo()
-				wd.updateLeftEvents()
+				wd.leftPanel.SetTitle(fmt.Sprintf("%s Workflow A: %s", icons.Workflow, truncate(workflow.ID, 25)))
 			} else {
 				wd.workflowB = workflow
 				wd.eventsB = events
-				wd.rightPanel.SetTitle(fmt.Sprintf("%s Workflow B: %s", theme.IconWorkflow, truncate(workflow.ID, 25)))
-				wd.updateRightInfo()
-				wd.updateRightEvents()
+				wd.rightPanel.SetTitle(fmt.Sprintf("%s Workflow B: %s", icons.Workflow, truncate(workflow.ID, 25)))
 			}
+			wd.updateComparison()
 		})
 	}()
 }
@@ -333,7 +351,7 @@ func (wd *WorkflowDiff) updateLeftInfo() {
 		wd.leftInfo.SetText("")
 		return
 	}
-	wd.leftInfo.SetText(wd.formatWorkflowInfo(wd.workflowA, len(wd.eventsA)))
+	wd.leftInfo.SetText(wd.formatWorkflowInfo(wd.workflowA, wd.workflowB, wd.eventsA, wd.eventsB))
 }
 
 func (wd *WorkflowDiff) updateRightInfo() {
@@ -341,10 +359,12 @@ func (wd *WorkflowDiff) updateRightInfo() {
 		wd.rightInfo.SetText("")
 		return
 	}
-	wd.rightInfo.SetText(wd.formatWorkflowInfo(wd.workflowB, len(wd.eventsB)))
+	wd.rightInfo.SetText(wd.formatWorkflowInfo(wd.workflowB, wd.workflowA, wd.eventsB, wd.eventsA))
 }
 
-func (wd *WorkflowDiff) formatWorkflowInfo(w *temporal.Workflow, eventCount int) string {
+// formatWorkflowInfo renders one side's summary. Input, output, and event
+// sequence are compared against the other side once it has loaded.
+func (wd *WorkflowDiff) formatWorkflowInfo(w, other *temporal.Workflow, events, otherEvents []temporal.HistoryEvent) string {
 	statusHandle := temporal.GetWorkflowStatus(w.Status)
 	statusColor := statusHandle.ColorTag()
 	statusIcon := statusHandle.Icon()
@@ -356,48 +376,97 @@ func (wd *WorkflowDiff) formatWorkflowInfo(w *temporal.Workflow, eventCount int)
 		duration = time.Since(w.StartTime).Round(time.Second).String() + " (running)"
 	}
 
+	// Only compare once the other side's details and history have loaded.
+	eventsText := fmt.Sprintf("[%s]%d[-]", theme.TagAccent(), len(events))
+	var otherInput, otherOutput *string
+	if other != nil && len(otherEvents) > 0 {
+		if at, ok := firstEventTypeDivergence(events, otherEvents); ok {
+			eventsText += fmt.Sprintf(" [%s](diverges at #%d)[-]", theme.TagWarning(), at+1)
+		}
+		otherInput, otherOutput = &other.Input, &other.Output
+	}
+
 	return fmt.Sprintf(`[%s]Type:[-] [%s]%s[-]
 [%s]Status:[-] [%s]%s %s[-]
 [%s]Started:[-] [%s]%s[-]
 [%s]Duration:[-] [%s]%s[-]
-[%s]Events:[-] [%s]%d[-]
-[%s]Task Queue:[-] [%s]%s[-]`,
+[%s]Events:[-] %s
+[%s]Task Queue:[-] [%s]%s[-]
+[%s]Input:[-] %s
+[%s]Output:[-] %s`,
 		theme.TagFgDim(), theme.TagFg(), w.Type,
 		theme.TagFgDim(), statusColor, statusIcon, w.Status,
 		theme.TagFgDim(), theme.TagFg(), w.StartTime.Format("2006-01-02 15:04:05"),
 		theme.TagFgDim(), theme.TagFg(), duration,
-		theme.TagFgDim(), theme.TagAccent(), eventCount,
-		theme.TagFgDim(), theme.TagFg(), w.TaskQueue)
+		theme.TagFgDim(), eventsText,
+		theme.TagFgDim(), theme.TagFg(), w.TaskQueue,
+		theme.TagFgDim(), formatDiffPayload(w.Input, otherInput),
+		theme.TagFgDim(), formatDiffPayload(w.Output, otherOutput))
 }
 
-func (wd *WorkflowDiff) updateLeftEvents() {
-	wd.leftEvents.ClearRows()
-	for _, e := range wd.eventsA {
-		wd.leftEvents.AddRow(
-			fmt.Sprintf("%d", e.ID),
-			e.Type,
-			e.Time.Format("15:04:05"),
-		)
+// compactPayload collapses a payload to a single line for comparison.
+func compactPayload(s string) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err == nil {
+		return buf.String()
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// formatDiffPayload renders a one-line payload preview, flagged when it
+// differs from the other side's payload. other is nil if that side is not loaded.
+func formatDiffPayload(payload string, other *string) string {
+	compact := compactPayload(payload)
+	text := fmt.Sprintf("[%s]%s[-]", theme.TagFg(), tview.Escape(truncate(compact, 40)))
+	if compact == "" {
+		text = fmt.Sprintf("[%s]-[-]", theme.TagFgDim())
 	}
-	if wd.leftEvents.RowCount() > 0 {
-		wd.leftEvents.SelectRow(0)
+	if other != nil && compact != compactPayload(*other) {
+		text += fmt.Sprintf(" [%s](differs)[-]", theme.TagWarning())
 	}
+	return text
 }
 
-func (wd *WorkflowDiff) updateRightEvents() {
-	wd.rightEvents.ClearRows()
-	for _, e := range wd.eventsB {
-		wd.rightEvents.AddRow(
-			fmt.Sprintf("%d", e.ID),
-			e.Type,
-			e.Time.Format("15:04:05"),
-		)
+// firstEventTypeDivergence returns the index of the first event whose type
+// differs between the two sequences, or where one sequence ends early.
+func firstEventTypeDivergence(a, b []temporal.HistoryEvent) (int, bool) {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i].Type != b[i].Type {
+			return i, true
+		}
+	}
+	if len(a) != len(b) {
+		return min(len(a), len(b)), true
+	}
+	return 0, false
+}
+
+// addDiffEventRows fills table with events, highlighting rows whose type
+// differs from the event at the same position on the other side.
+func addDiffEventRows(table *components.Table, events, otherEvents []temporal.HistoryEvent, compare bool) {
+	table.ClearRows()
+	for i, e := range events {
+		cells := []string{fmt.Sprintf("%d", e.ID), e.Type, e.Time.Format("15:04:05")}
+		if compare && (i >= len(otherEvents) || otherEvents[i].Type != e.Type) {
+			warn := theme.Warning()
+			table.AddColoredRow(cells, []tcell.Color{warn, warn, warn})
+		} else {
+			table.AddRow(cells...)
+		}
 	}
-	if wd.rightEvents.RowCount() > 0 {
-		wd.rightEvents.SelectRow(0)
+	if table.RowCount() > 0 {
+		table.SelectRow(0)
 	}
 }
 
+func (wd *WorkflowDiff) updateLeftEvents() {
+	addDiffEventRows(wd.leftEvents, wd.eventsA, wd.eventsB, len(wd.eventsB) > 0)
+}
+
+func (wd *WorkflowDiff) updateRightEvents() {
+	addDiffEventRows(wd.rightEvents, wd.eventsB, wd.eventsA, len(wd.eventsA) > 0)
+}
+
 // SetWorkflowA sets the left workflow for comparison.
 func (wd *WorkflowDiff) SetWorkflowA(w *temporal.Workflow) {
 	wd.workflowA = w
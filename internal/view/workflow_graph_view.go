@@ -7,6 +7,7 @@ import (
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -63,11 +64,11 @@ func (wg *WorkflowGraphView) setup() {
 
 	// Wrap in panels with titles
 	treePanel := components.NewPanel().
-		SetTitle(fmt.Sprintf("%s Hierarchy", theme.IconNamespace)).
+		SetTitle(fmt.Sprintf("%s Hierarchy", icons.Namespace)).
 		SetContent(wg.tree)
 
 	graphPanel := components.NewPanel().
-		SetTitle(fmt.Sprintf("%s Graph View", theme.IconGrid)).
+		SetTitle(fmt.Sprintf("%s Graph View", icons.Grid)).
 		SetContent(wg.graph)
 
 	// Create split layout
@@ -109,6 +110,7 @@ func (wg *WorkflowGraphView) Hints() []KeyHint {
 		{Key: "Tab", Description: "Switch Pane"},
 		{Key: "c", Description: "Center Graph"},
 		{Key: "+/-", Description: "Depth"},
+		{Key: "u", Description: "Up to Parent"},
 		{Key: "Enter", Description: "Open Detail"},
 		{Key: "r", Description: "Refresh"},
 		{Key: "?", Description: "Help"},
@@ -140,6 +142,9 @@ func (wg *WorkflowGraphView) handleInput(event *tcell.EventKey) *tcell.EventKey
 		case 'r':
 			wg.loadData()
 			return nil
+		case 'u':
+			wg.showParent()
+			return nil
 		case 'c':
 			// Center graph on current selection
 			if node := wg.tree.GetSelected(); node != nil {
@@ -170,6 +175,20 @@ func (wg *WorkflowGraphView) adjustDepth(delta int) {
 	}
 }
 
+// showParent re-roots the graph at the current workflow's parent, so repeated
+// presses walk up to the top of the hierarchy.
+func (wg *WorkflowGraphView) showParent() {
+	if wg.loading || wg.relationships == nil {
+		return
+	}
+	if wg.relationships.Parent == nil {
+		wg.app.ToastWarning("Workflow has no parent")
+		return
+	}
+	wg.workflow = wg.relationships.Parent
+	wg.loadData()
+}
+
 func (wg *WorkflowGraphView) loadData() {
 	if wg.loading {
 		return
@@ -7,6 +7,7 @@ import (
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/input"
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -15,6 +16,7 @@ import (
 // WorkflowList displays a list of workflows with a preview panel.
 type WorkflowList struct {
 	*components.MasterDetailView
+	freshness
 	app              *App
 	namespace        string
 	table            *components.Table
@@ -29,7 +31,10 @@ type WorkflowList struct {
 	autoRefresh      bool
 	refreshTicker    *time.Ticker
 	stopRefresh      chan struct{}
+	batchJobStop     chan struct{} // Stops following a started server batch job
+	batchJobPreview  bool          // The preview shows the batch job's progress
 	selectionMode    bool     // Multi-select mode active
+	selected         map[workflowKey]temporal.Workflow // Selection set, persists across reloads
 	searchHistory    []string // History of visibility queries
 	historyIndex     int      // Current position in history (-1 = not browsing)
 	maxHistorySize   int      // Maximum number of history entries
@@ -38,6 +43,19 @@ type WorkflowList struct {
 	lastCompletionQuery string              // Last query sent to server (to avoid duplicates)
 	originalWorkflows   []temporal.Workflow // Original workflows before server search
 	preloaded           bool               // True if workflows were provided at construction time
+	// Sorting
+	sortIndex          int      // Index into workflowSorts
+	sortPath           sortPath // How the loaded rows were sorted
+	sortProgress       int      // Rows fetched so far while loading all pages to sort
+	sortAnnounce       bool     // Toast the sort path after the next load
+	orderByUnsupported bool     // The server rejected ORDER BY; sort locally instead
+	// Pagination
+	nextPageToken string // Token for the next page of the current query, "" if none
+	pageQuery     string // Exact query the loaded pages were fetched with
+	loadingMore   bool   // A next page is being fetched
+	// Server-side counts for the footer stats
+	counts      *temporal.WorkflowCounts // Counts for countsQuery, nil until loaded
+	countsQuery string                   // Visibility query the counts were taken for
 }
 
 // NewWorkflowList creates a new workflow list view.
@@ -48,6 +66,7 @@ func NewWorkflowList(app *App, namespace string) *WorkflowList {
 		table:          components.NewTable(),
 		preview:        tview.NewTextView(),
 		workflows:      []temporal.Workflow{},
+		selected:       make(map[workflowKey]temporal.Workflow),
 		stopRefresh:    make(chan struct{}, 1), // Buffered to ensure stop signal isn't lost
 		searchHistory:  make([]string, 0, 50),
 		historyIndex:   -1,
@@ -92,7 +111,7 @@ func (wl *WorkflowList) CommandContext() (workflowID, runID, workflowType string
 }
 
 func (wl *WorkflowList) setup() {
-	wl.table.SetHeaders("WORKFLOW ID", "STATUS", "TYPE", "START TIME")
+	wl.table.SetHeaders(wl.listHeaders()...)
 	wl.table.SetBorder(false)
 	wl.table.SetBackgroundColor(theme.Bg())
 
@@ -128,31 +147,41 @@ func (wl *WorkflowList) setup() {
 	}
 
 	wl.emptyState = components.NewEmptyState().
-		SetIcon(theme.IconInfo).
+		SetIcon(icons.Info).
 		SetTitle("No Workflows").
 		SetMessage("No workflows found in this namespace")
 	wl.emptyState.SetInputCapture(emptyInputCapture)
 
 	wl.noResultsState = components.NewEmptyState().
-		SetIcon(theme.IconSearch).
+		SetIcon(icons.Search).
 		SetTitle("No Results").
 		SetMessage("No workflows match the current filter")
 	wl.noResultsState.SetInputCapture(emptyInputCapture)
 
 	// Create MasterDetailView
 	wl.MasterDetailView = components.NewMasterDetailView().
-		SetMasterTitle(fmt.Sprintf("%s Workflows", theme.IconWorkflow)).
-		SetDetailTitle(fmt.Sprintf("%s Preview", theme.IconInfo)).
+		SetMasterTitle(fmt.Sprintf("%s Workflows", icons.Workflow)).
+		SetDetailTitle(fmt.Sprintf("%s Preview", icons.Info)).
 		SetMasterContent(wl.table).
 		SetDetailContent(wl.preview).
 		SetRatio(0.6).
-		ConfigureEmpty(theme.IconInfo, "No Selection", "Select a workflow to view details")
+		ConfigureEmpty(icons.Info, "No Selection", "Select a workflow to view details")
+
+	// Compact density hides the preview by default, giving the list the full width
+	if wl.compact() {
+		wl.HideDetail()
+	}
 
 	// Selection change handler to update preview
 	wl.table.SetSelectionChangedFunc(func(row, col int) {
+		wl.batchJobPreview = false
 		if row > 0 && row-1 < len(wl.workflows) {
 			wl.updatePreview(wl.workflows[row-1])
 		}
+		// Reaching the last row fetches the next page
+		if row > 0 && row == len(wl.workflows) {
+			wl.loadMore()
+		}
 	})
 
 	// Selection handler for drill-down
@@ -162,6 +191,14 @@ func (wl *WorkflowList) setup() {
 			wl.app.NavigateToWorkflowDetail(wf.ID, wf.RunID)
 		}
 	})
+
+	wl.updatePanelTitle()
+}
+
+// compact returns true if the workflow list uses the compact density.
+func (wl *WorkflowList) compact() bool {
+	cfg := wl.app.Config()
+	return cfg != nil && cfg.CompactDensity()
 }
 
 func (wl *WorkflowList) togglePreview() {
@@ -190,12 +227,17 @@ func (wl *WorkflowList) Name() string {
 	return "workflows"
 }
 
+// Reload reloads the workflow list.
+func (wl *WorkflowList) Reload() {
+	wl.loadData()
+}
+
 // Start is called when the view becomes active.
 func (wl *WorkflowList) Start() {
 	bindings := input.NewKeyBindings().
 		OnRune(' ', func(e *tcell.EventKey) bool {
 			if wl.selectionMode {
-				wl.table.ToggleSelection()
+				wl.toggleRowSelection()
 				wl.updateSelectionPreview()
 				return true
 			}
@@ -217,11 +259,23 @@ func (wl *WorkflowList) Start() {
 			wl.showDateRangePicker()
 			return true
 		}).
+		OnRune('Q', func(e *tcell.EventKey) bool {
+			wl.filterBySameTaskQueue()
+			return true
+		}).
+		OnRune('=', func(e *tcell.EventKey) bool {
+			wl.showPivotMenu()
+			return true
+		}).
 		OnRune('t', func(e *tcell.EventKey) bool {
 			wl.app.NavigateToTaskQueues()
 			return true
 		}).
 		OnRune('s', func(e *tcell.EventKey) bool {
+			if wl.selectionMode && len(wl.selected) > 0 {
+				wl.showBatchSignalConfirm()
+				return true
+			}
 			wl.app.NavigateToSchedules()
 			return true
 		}).
@@ -238,7 +292,11 @@ func (wl *WorkflowList) Start() {
 			return true
 		}).
 		OnRune('y', func(e *tcell.EventKey) bool {
-			wl.copyWorkflowID()
+			wl.showYankMenu()
+			return true
+		}).
+		OnRune('Y', func(e *tcell.EventKey) bool {
+			wl.app.tableToClipboard(wl.table)
 			return true
 		}).
 		OnRune('v', func(e *tcell.EventKey) bool {
@@ -246,14 +304,14 @@ func (wl *WorkflowList) Start() {
 			return true
 		}).
 		OnRune('c', func(e *tcell.EventKey) bool {
-			if wl.selectionMode && len(wl.table.GetSelectedRows()) > 0 {
+			if wl.selectionMode && len(wl.selected) > 0 {
 				wl.showBatchCancelConfirm()
 				return true
 			}
 			return false
 		}).
 		OnRune('X', func(e *tcell.EventKey) bool {
-			if wl.selectionMode && len(wl.table.GetSelectedRows()) > 0 {
+			if wl.selectionMode && len(wl.selected) > 0 {
 				wl.showBatchTerminateConfirm()
 				return true
 			}
@@ -266,6 +324,21 @@ func (wl *WorkflowList) Start() {
 			}
 			return false
 		}).
+		OnRune('B', func(e *tcell.EventKey) bool {
+			if wl.visibilityQuery != "" && !wl.selectionMode {
+				wl.showServerBatchMenu()
+				return true
+			}
+			return false
+		}).
+		OnRune('A', func(e *tcell.EventKey) bool {
+			wl.showColumnChooser()
+			return true
+		}).
+		OnRune('J', func(e *tcell.EventKey) bool {
+			wl.app.NavigateToBatchOperations(wl.namespace)
+			return true
+		}).
 		OnRune('L', func(e *tcell.EventKey) bool {
 			wl.showSavedFilters()
 			return true
@@ -291,7 +364,7 @@ func (wl *WorkflowList) Start() {
 		}).
 		OnCtrlRune('a', func(e *tcell.EventKey) bool {
 			if wl.selectionMode {
-				wl.table.SelectAll()
+				wl.selectAllVisible()
 				wl.updateSelectionPreview()
 				return true
 			}
@@ -300,7 +373,28 @@ func (wl *WorkflowList) Start() {
 		OnRune('o', func(e *tcell.EventKey) bool {
 			wl.showWorkflowGraph()
 			return true
+		}).
+		OnRune('O', func(e *tcell.EventKey) bool {
+			wl.cycleSort()
+			return true
+		}).
+		OnRune('*', func(e *tcell.EventKey) bool {
+			wl.toggleFavoriteType()
+			return true
+		})
+
+	// Number keys switch between pinned query tabs (0 = all workflows)
+	for i := 0; i <= maxPinnedTabs; i++ {
+		index := i - 1
+		bindings.OnRune(rune('0'+i), func(e *tcell.EventKey) bool {
+			if wl.selectionMode || len(wl.pinnedQueries()) == 0 {
+				return false
+			}
+			return wl.selectPinnedTab(index)
 		})
+	}
+
+	addEdgeNav(bindings, tableEdgeNav{wl.table}, true)
 
 	wl.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if bindings.Handle(event) {
@@ -316,6 +410,7 @@ func (wl *WorkflowList) Start() {
 func (wl *WorkflowList) Stop() {
 	wl.table.SetInputCapture(nil)
 	wl.stopAutoRefresh()
+	wl.stopFollowingBatchJob()
 	wl.app.ClearWorkflowStats()
 }
 
@@ -327,9 +422,10 @@ func (wl *WorkflowList) Hints() []KeyHint {
 			{Key: "Ctrl+A", Description: "Select All"},
 			{Key: "v", Description: "Exit Select"},
 		}
-		if len(wl.table.GetSelectedRows()) > 0 {
+		if len(wl.selected) > 0 && !wl.app.readOnlyNamespace() {
 			hints = append(hints,
 				KeyHint{Key: "c", Description: "Cancel"},
+				KeyHint{Key: "s", Description: "Signal"},
 				KeyHint{Key: "X", Description: "Terminate"},
 			)
 		}
@@ -343,21 +439,40 @@ func (wl *WorkflowList) Hints() []KeyHint {
 		{Key: "F", Description: "Query"},
 		{Key: "f", Description: "Templates"},
 		{Key: "D", Description: "Date Range"},
+		{Key: "Q", Description: "Same Queue"},
+		{Key: "=", Description: "Filter by Value"},
+	}
+	if len(wl.pinnedQueries()) > 0 {
+		hints = append(hints, KeyHint{Key: "0-9", Description: "Pinned Tabs"})
 	}
 	if wl.visibilityQuery != "" {
 		hints = append(hints,
 			KeyHint{Key: "C", Description: "Clear Query"},
 			KeyHint{Key: "S", Description: "Save Filter"},
 		)
+		if !wl.app.readOnlyNamespace() {
+			hints = append(hints, KeyHint{Key: "B", Description: "Batch Operation"})
+		}
 	}
 	hints = append(hints,
 		KeyHint{Key: "L", Description: "Load Filter"},
+		KeyHint{Key: "J", Description: "Batch Jobs"},
+		KeyHint{Key: "A", Description: "Columns"},
 		KeyHint{Key: "d", Description: "Diff"},
 		KeyHint{Key: "o", Description: "Overview"},
+		KeyHint{Key: "O", Description: "Sort"},
+		KeyHint{Key: "*", Description: "Pin Type"},
 		KeyHint{Key: "v", Description: "Select Mode"},
-		KeyHint{Key: "N", Description: "Start"},
-		KeyHint{Key: "W", Description: "Signal+Start"},
-		KeyHint{Key: "y", Description: "Copy ID"},
+	)
+	if !wl.app.readOnlyNamespace() {
+		hints = append(hints,
+			KeyHint{Key: "N", Description: "Start"},
+			KeyHint{Key: "W", Description: "Signal+Start"},
+		)
+	}
+	hints = append(hints,
+		KeyHint{Key: "y", Description: "Yank"},
+		KeyHint{Key: "Y", Description: "Copy Table"},
 		KeyHint{Key: "r", Description: "Refresh"},
 		KeyHint{Key: "p", Description: "Preview"},
 		KeyHint{Key: "a", Description: "Auto-refresh"},
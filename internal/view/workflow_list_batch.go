@@ -2,12 +2,15 @@ package view
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/rivo/tview"
 )
@@ -18,17 +21,16 @@ func (wl *WorkflowList) toggleSelectionMode() {
 	wl.selectionMode = !wl.selectionMode
 	if wl.selectionMode {
 		wl.table.SetMultiSelect(true)
-		wl.SetMasterTitle(fmt.Sprintf("%s Workflows (Select Mode)", theme.IconWorkflow))
 	} else {
 		wl.table.SetMultiSelect(false)
-		wl.table.ClearSelection()
-		wl.SetMasterTitle(fmt.Sprintf("%s Workflows", theme.IconWorkflow))
+		wl.clearSelection()
 	}
-	wl.app.JigApp().Menu().SetHints(wl.Hints())
+	wl.updatePanelTitle()
+	wl.app.setFooterHints(wl.Hints())
 }
 
 func (wl *WorkflowList) updateSelectionPreview() {
-	count := len(wl.table.GetSelectedRows())
+	count := len(wl.selected)
 	if count == 0 {
 		row := wl.table.SelectedRow()
 		if row >= 0 && row < len(wl.workflows) {
@@ -36,17 +38,14 @@ func (wl *WorkflowList) updateSelectionPreview() {
 		}
 	} else {
 		var running, completed, failed int
-		selected := wl.table.GetSelectedRows()
-		for _, idx := range selected {
-			if idx < len(wl.workflows) {
-				switch wl.workflows[idx].Status {
-				case "Running":
-					running++
-				case "Completed":
-					completed++
-				case "Failed":
-					failed++
-				}
+		for _, w := range wl.selected {
+			switch w.Status {
+			case "Running":
+				running++
+			case "Completed", "ContinuedAsNew":
+				completed++
+			case "Failed":
+				failed++
 			}
 		}
 
@@ -58,7 +57,7 @@ func (wl *WorkflowList) updateSelectionPreview() {
 [%s]%s Completed: %d[-]
 [%s]%s Failed: %d[-]
 
-[%s]Press 'c' to cancel or 'X' to terminate selected workflows[-]`,
+[%s]Press 'c' to cancel, 's' to signal, or 'X' to terminate selected workflows[-]`,
 			theme.TagPanelTitle(),
 			theme.TagAccent(), count,
 			theme.TagFgDim(),
@@ -68,21 +67,25 @@ func (wl *WorkflowList) updateSelectionPreview() {
 			theme.TagFgDim())
 		wl.preview.SetText(text)
 	}
-	wl.app.JigApp().Menu().SetHints(wl.Hints())
+	wl.app.setFooterHints(wl.Hints())
 }
 
 // Batch operation methods
 
 func (wl *WorkflowList) showBatchCancelConfirm() {
-	selected := wl.table.GetSelectedRows()
+	if wl.app.blockReadOnly() {
+		return
+	}
+
+	selected := wl.selectedWorkflows()
 	if len(selected) == 0 {
 		return
 	}
 
 	// Count running workflows
 	var runningCount int
-	for _, idx := range selected {
-		if idx < len(wl.workflows) && wl.workflows[idx].Status == "Running" {
+	for _, w := range selected {
+		if w.Status == "Running" {
 			runningCount++
 		}
 	}
@@ -116,7 +119,7 @@ func (wl *WorkflowList) showBatchCancelConfirm() {
 	content.SetBackgroundColor(theme.Bg())
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Cancel %d Workflow(s)", theme.IconWarning, len(selected)),
+		Title:    fmt.Sprintf("%s Cancel %d Workflow(s)", icons.Warning, len(selected)),
 		Width:    60,
 		Height:   14,
 		Backdrop: true,
@@ -131,7 +134,7 @@ func (wl *WorkflowList) showBatchCancelConfirm() {
 	wl.app.JigApp().SetFocus(form)
 }
 
-func (wl *WorkflowList) executeBatchCancel(indices []int, reason string) {
+func (wl *WorkflowList) executeBatchCancel(workflows []temporal.Workflow, reason string) {
 	provider := wl.app.Provider()
 	if provider == nil {
 		return
@@ -142,11 +145,7 @@ func (wl *WorkflowList) executeBatchCancel(indices []int, reason string) {
 		defer cancel()
 
 		var succeeded, failed int
-		for _, idx := range indices {
-			if idx >= len(wl.workflows) {
-				continue
-			}
-			wf := wl.workflows[idx]
+		for _, wf := range workflows {
 			if wf.Status != "Running" {
 				continue
 			}
@@ -156,6 +155,7 @@ func (wl *WorkflowList) executeBatchCancel(indices []int, reason string) {
 				failed++
 			} else {
 				succeeded++
+				wl.app.RecordSentOp(sentCancel, wf.ID, wf.RunID, "")
 			}
 		}
 
@@ -174,25 +174,41 @@ func (wl *WorkflowList) executeBatchCancel(indices []int, reason string) {
 }
 
 func (wl *WorkflowList) showBatchTerminateConfirm() {
-	selected := wl.table.GetSelectedRows()
+	if wl.app.blockReadOnly() {
+		return
+	}
+
+	selected := wl.selectedWorkflows()
 	if len(selected) == 0 {
 		return
 	}
 
 	// Count running workflows
 	var runningCount int
-	for _, idx := range selected {
-		if idx < len(wl.workflows) && wl.workflows[idx].Status == "Running" {
+	for _, w := range selected {
+		if w.Status == "Running" {
 			runningCount++
 		}
 	}
 
-	form := components.NewFormBuilder().
+	confirm := wl.app.confirmationFor("terminate", wl.namespace, "")
+
+	builder := components.NewFormBuilder().
 		Text("reason", "Reason (required)").
 			Placeholder("Enter reason for termination").
 			Validate(validators.Required()).
-			Done().
+			Done()
+	modalHeight := 16
+	if confirm.required() {
+		builder = confirm.addField(builder, "")
+		modalHeight += 4
+	}
+
+	form := builder.
 		OnSubmit(func(values map[string]any) {
+			if confirm.required() && !confirm.matches(values["confirm"].(string)) {
+				return
+			}
 			reason := values["reason"].(string)
 			wl.closeModal()
 			wl.executeBatchTerminate(selected, reason)
@@ -208,21 +224,26 @@ func (wl *WorkflowList) showBatchTerminateConfirm() {
 
 [%s]Selected:[-] %d workflow(s)
 [%s]Running:[-] %d (will be terminated)
-[%s]Other:[-] %d (will be skipped)`,
+[%s]Other:[-] %d (will be skipped)%s`,
 		theme.TagError(),
 		theme.TagFgDim(), len(selected),
 		theme.TagAccent(), runningCount,
-		theme.TagFgDim(), len(selected)-runningCount))
+		theme.TagFgDim(), len(selected)-runningCount,
+		confirm.warning(wl.namespace)))
 
+	warningHeight := 5
+	if confirm.danger {
+		warningHeight++
+	}
 	content := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(warningText, 5, 0, false).
+		AddItem(warningText, warningHeight, 0, false).
 		AddItem(form, 0, 1, true)
 	content.SetBackgroundColor(theme.Bg())
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Terminate %d Workflow(s)", theme.IconError, len(selected)),
+		Title:    fmt.Sprintf("%s Terminate %d Workflow(s)", icons.Error, len(selected)),
 		Width:    65,
-		Height:   16,
+		Height:   modalHeight,
 		Backdrop: true,
 	})
 	modal.SetContent(content)
@@ -235,7 +256,7 @@ func (wl *WorkflowList) showBatchTerminateConfirm() {
 	wl.app.JigApp().SetFocus(form)
 }
 
-func (wl *WorkflowList) executeBatchTerminate(indices []int, reason string) {
+func (wl *WorkflowList) executeBatchTerminate(workflows []temporal.Workflow, reason string) {
 	provider := wl.app.Provider()
 	if provider == nil {
 		return
@@ -246,11 +267,7 @@ func (wl *WorkflowList) executeBatchTerminate(indices []int, reason string) {
 		defer cancel()
 
 		var succeeded, failed int
-		for _, idx := range indices {
-			if idx >= len(wl.workflows) {
-				continue
-			}
-			wf := wl.workflows[idx]
+		for _, wf := range workflows {
 			if wf.Status != "Running" {
 				continue
 			}
@@ -277,6 +294,133 @@ func (wl *WorkflowList) executeBatchTerminate(indices []int, reason string) {
 	}()
 }
 
+func (wl *WorkflowList) showBatchSignalConfirm() {
+	if wl.app.blockReadOnly() {
+		return
+	}
+
+	selected := wl.selectedWorkflows()
+	if len(selected) == 0 {
+		return
+	}
+
+	var running []temporal.WorkflowIdentifier
+	for _, w := range selected {
+		if w.Status == "Running" {
+			running = append(running, temporal.WorkflowIdentifier{WorkflowID: w.ID, RunID: w.RunID})
+		}
+	}
+
+	form := components.NewFormBuilder().
+		Text("signalName", "Signal Name").
+			Placeholder("Enter signal name").
+			Validate(validators.Required()).
+			Done().
+		Text("input", "Input (JSON, optional)").
+			Placeholder("{}").
+			Validate(validators.Custom(func(value any) error {
+				input := strings.TrimSpace(value.(string))
+				if input != "" && !json.Valid([]byte(input)) {
+					return fmt.Errorf("input must be valid JSON")
+				}
+				return nil
+			})).
+			Done().
+		OnSubmit(func(values map[string]any) {
+			signalName := values["signalName"].(string)
+			input := strings.TrimSpace(values["input"].(string))
+			wl.closeModal()
+			wl.executeBatchSignal(running, signalName, input)
+		}).
+		OnCancel(func() {
+			wl.closeModal()
+		}).
+		Build()
+
+	infoText := tview.NewTextView().SetDynamicColors(true)
+	infoText.SetBackgroundColor(theme.Bg())
+	infoText.SetText(fmt.Sprintf(`[%s]Selected:[-] %d workflow(s)
+[%s]Running:[-] %d (will be signaled)
+[%s]Other:[-] %d (will be skipped)`,
+		theme.TagFgDim(), len(selected),
+		theme.TagAccent(), len(running),
+		theme.TagFgDim(), len(selected)-len(running)))
+
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(infoText, 4, 0, false).
+		AddItem(form, 0, 1, true)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Signal %d Workflow(s)", icons.Signal, len(selected)),
+		Width:    70,
+		Height:   18,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+S", Description: "Send signal"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wl.app.JigApp().Pages().Push(modal)
+	wl.app.JigApp().SetFocus(form)
+}
+
+func (wl *WorkflowList) executeBatchSignal(workflows []temporal.WorkflowIdentifier, signalName, input string) {
+	provider := wl.app.Provider()
+	if provider == nil || len(workflows) == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var inputBytes []byte
+		if input != "" {
+			inputBytes = []byte(input)
+		}
+
+		results, err := provider.SignalWorkflows(ctx, wl.namespace, workflows, signalName, inputBytes)
+
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wl.app.ToastError(fmt.Sprintf("Batch signal failed: %v", err))
+				return
+			}
+
+			var succeeded int
+			var failures []string
+			for _, r := range results {
+				if r.Success {
+					succeeded++
+					wl.app.RecordSentOp(sentSignal, r.WorkflowID, r.RunID, signalName)
+					continue
+				}
+				failures = append(failures, fmt.Sprintf("[%s]%s:[-] %s", theme.TagFgDim(), tview.Escape(r.WorkflowID), tview.Escape(r.Error)))
+			}
+
+			wl.toggleSelectionMode()
+			wl.loadData()
+			text := fmt.Sprintf(`[%s::b]Batch Signal Complete[-:-:-]
+
+[%s]Signal:[-] %s
+[%s]Signaled:[-] %d workflow(s)
+[%s]Failed:[-] %d workflow(s)`,
+				theme.TagPanelTitle(),
+				theme.TagFgDim(), tview.Escape(signalName),
+				theme.TagSuccess(), succeeded,
+				theme.TagError(), len(failures))
+			if len(failures) > 0 {
+				text += "\n\n" + strings.Join(failures, "\n")
+			}
+			wl.preview.SetText(text)
+		})
+	}()
+}
+
 func (wl *WorkflowList) closeModal() {
 	wl.app.JigApp().Pages().DismissModal()
 }
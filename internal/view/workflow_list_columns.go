@@ -0,0 +1,146 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// searchAttributeColumnWidth caps the width of a search attribute column,
+// leaving room for a Datetime value.
+const searchAttributeColumnWidth = 23
+
+// searchAttributeColumns returns the search attributes configured as extra
+// workflow list columns.
+func (wl *WorkflowList) searchAttributeColumns() []string {
+	cfg := wl.app.Config()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.SearchAttributeColumns
+}
+
+// listHeaders returns the workflow list headers, followed by one per search
+// attribute column.
+func (wl *WorkflowList) listHeaders() []string {
+	headers := []string{"WORKFLOW ID", "STATUS", "TYPE", "START TIME"}
+	return append(headers, wl.searchAttributeColumns()...)
+}
+
+// searchAttributeCells returns a workflow's values for the search attribute
+// columns, "-" where it has none.
+func (wl *WorkflowList) searchAttributeCells(attrs map[string]string) []string {
+	columns := wl.searchAttributeColumns()
+	cells := make([]string, len(columns))
+	for i, name := range columns {
+		value, ok := attrs[name]
+		if !ok || value == "" {
+			value = "-"
+		}
+		cells[i] = tview.Escape(truncate(value, searchAttributeColumnWidth))
+	}
+	return cells
+}
+
+// showColumnChooser lists the search attributes seen on the loaded
+// workflows, plus the configured columns, and toggles them as columns.
+func (wl *WorkflowList) showColumnChooser() {
+	cfg := wl.app.Config()
+	if cfg == nil {
+		wl.app.ToastWarning("Search attribute columns need a config file")
+		return
+	}
+
+	examples := make(map[string]string)
+	for _, w := range wl.allWorkflows {
+		for name, value := range w.SearchAttributes {
+			if _, ok := examples[name]; !ok || examples[name] == "" {
+				examples[name] = value
+			}
+		}
+	}
+	for _, name := range cfg.SearchAttributeColumns {
+		if _, ok := examples[name]; !ok {
+			examples[name] = ""
+		}
+	}
+	if len(examples) == 0 {
+		wl.app.ToastWarning("No search attributes on the loaded workflows")
+		return
+	}
+
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := components.NewTable()
+	table.SetBorder(false)
+	render := func() {
+		row := table.SelectedRow()
+		table.ClearRows()
+		table.SetHeaders("SHOWN", "ATTRIBUTE", "EXAMPLE")
+		for _, name := range names {
+			shown := ""
+			color := theme.FgDim()
+			for _, col := range cfg.SearchAttributeColumns {
+				if col == name {
+					shown, color = icons.Check, theme.Fg()
+				}
+			}
+			table.AddRowWithColor(color, shown, name, tview.Escape(truncate(examples[name], 30)))
+		}
+		if row < 0 {
+			row = 0
+		}
+		table.SelectRow(row)
+	}
+	render()
+
+	toggle := func() {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(names) {
+			return
+		}
+		cfg.ToggleSearchAttributeColumn(names[row])
+		if err := cfg.Save(); err != nil {
+			wl.app.ToastError(fmt.Sprintf("Failed to save columns: %v", err))
+		}
+		render()
+		wl.populateTable()
+	}
+	table.SetOnSelect(func(row int) {
+		toggle()
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyRune && event.Rune() == ' ' {
+			toggle()
+			return nil
+		}
+		return event
+	})
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Search Attribute Columns", icons.Grid),
+		Width:    70,
+		Height:   min(len(names), 15) + 6,
+		Backdrop: true,
+	})
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter/space", Description: "Show/Hide"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		wl.closeModal()
+	})
+
+	wl.app.JigApp().Pages().Push(modal)
+	wl.app.JigApp().SetFocus(table)
+}
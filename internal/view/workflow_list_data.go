@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 )
 
@@ -32,8 +34,19 @@ func (wl *WorkflowList) loadData() {
 	}
 
 	wl.setLoading(true)
+	wl.loadCounts()
+	ws := wl.currentSort()
+	tryServerSort := !wl.orderByUnsupported
+	announce := wl.sortAnnounce
+	pageSize := wl.app.PageSize()
+	wl.sortAnnounce = false
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		// Loading every page to sort locally takes longer than one page
+		timeout := 10 * time.Second
+		if ws.orderBy != "" {
+			timeout = 30 * time.Second
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		// Resolve time placeholders in the query
@@ -45,23 +58,39 @@ func (wl *WorkflowList) loadData() {
 			})
 			return
 		}
-		opts := temporal.ListOptions{
-			PageSize: 100,
-			Query:    resolvedQuery,
+		progress := func(loaded int) {
+			wl.app.JigApp().QueueUpdateDraw(func() {
+				wl.sortProgress = loaded
+				wl.updatePanelTitle()
+			})
 		}
-		workflows, _, err := provider.ListWorkflows(ctx, wl.namespace, opts)
+		result, err := fetchSortedWorkflows(ctx, provider, wl.namespace, resolvedQuery, pageSize, ws, tryServerSort, progress)
 
 		wl.app.JigApp().QueueUpdateDraw(func() {
 			wl.setLoading(false)
+			wl.sortProgress = 0
+			if result.orderByRejected {
+				wl.orderByUnsupported = true
+			}
+			wl.nextPageToken = result.nextPage
+			wl.pageQuery = result.pageQuery
 			if err != nil {
+				wl.updatePanelTitle()
 				wl.showError(err)
 				return
 			}
-			// Sort by most recent first
-			sort.Slice(workflows, func(i, j int) bool {
-				return workflows[i].StartTime.After(workflows[j].StartTime)
-			})
+			workflows := result.workflows
+			if result.path == sortNone && !hasOrderBy(resolvedQuery) {
+				sortByRecentStart(workflows)
+			}
+			wl.sortPath = result.path
 			wl.allWorkflows = workflows
+			wl.markLoaded()
+			wl.updatePanelTitle()
+			if announce {
+				wl.sortPathToast()
+			}
+			wl.app.RecordWorkflowTypes(wl.namespace, workflows)
 			wl.applyFilter()
 			// Set focus to table after data loads
 			if len(wl.workflows) > 0 {
@@ -71,6 +100,60 @@ func (wl *WorkflowList) loadData() {
 	}()
 }
 
+// sortByRecentStart orders workflows most recently started first.
+func sortByRecentStart(workflows []temporal.Workflow) {
+	sort.SliceStable(workflows, func(i, j int) bool {
+		return workflows[i].StartTime.After(workflows[j].StartTime)
+	})
+}
+
+// loadMore fetches the next page of the current query and appends it to the
+// list, keeping the selection. Lists sorted locally have no next page, since
+// every row they could sort is already loaded.
+func (wl *WorkflowList) loadMore() {
+	provider := wl.app.Provider()
+	if provider == nil || wl.nextPageToken == "" || wl.loadingMore || wl.loading {
+		return
+	}
+
+	wl.loadingMore = true
+	wl.updatePanelTitle()
+	namespace := wl.namespace
+	query := wl.pageQuery
+	token := wl.nextPageToken
+	pageSize := wl.app.PageSize()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		page, next, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: pageSize, PageToken: token, Query: query})
+
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			wl.loadingMore = false
+			// A reload since the request started replaced the pages
+			if token != wl.nextPageToken || query != wl.pageQuery {
+				wl.updatePanelTitle()
+				return
+			}
+			if err != nil {
+				wl.updatePanelTitle()
+				wl.app.ToastError(fmt.Sprintf("Failed to load more workflows: %v", err))
+				return
+			}
+			wl.nextPageToken = next
+			// Sort only the new page, so rows already shown keep their place
+			if wl.sortPath == sortNone && !hasOrderBy(query) {
+				sortByRecentStart(page)
+			}
+			wl.allWorkflows = append(wl.allWorkflows, page...)
+			wl.updatePanelTitle()
+			wl.app.RecordWorkflowTypes(wl.namespace, page)
+			wl.applyFilter()
+		})
+	}()
+}
+
 func (wl *WorkflowList) loadMockData() {
 	now := time.Now()
 	wl.allWorkflows = []temporal.Workflow{
@@ -100,6 +183,15 @@ func (wl *WorkflowList) loadMockData() {
 			StartTime: now.Add(-2 * time.Hour), EndTime: ptr(now.Add(-1*time.Hour - 45*time.Minute)),
 		},
 	}
+	wl.sortPath = sortNone
+	if ws := wl.currentSort(); ws.less != nil {
+		sort.SliceStable(wl.allWorkflows, func(i, j int) bool {
+			return ws.less(wl.allWorkflows[i], wl.allWorkflows[j])
+		})
+		wl.sortPath = sortAll
+	}
+	wl.app.RecordWorkflowTypes(wl.namespace, wl.allWorkflows)
+	wl.updatePanelTitle()
 	wl.applyFilter()
 }
 
@@ -107,7 +199,8 @@ func (wl *WorkflowList) populateTable() {
 	currentRow := wl.table.SelectedRow()
 
 	wl.table.ClearRows()
-	wl.table.SetHeaders("WORKFLOW ID", "STATUS", "TYPE", "START TIME")
+	wl.table.SetHeaders(wl.listHeaders()...)
+	wl.workflows = wl.pinFavorites(wl.workflows)
 
 	if len(wl.workflows) == 0 {
 		if len(wl.allWorkflows) == 0 {
@@ -125,15 +218,18 @@ func (wl *WorkflowList) populateTable() {
 	idWidth, typeWidth := wl.calculateColumnWidths()
 
 	now := time.Now()
-	for _, w := range wl.workflows {
+	for i, w := range wl.workflows {
 		statusHandle := temporal.GetWorkflowStatus(w.Status)
-		wl.table.AddRowWithStatus(statusHandle, 1, // status column is index 1
+		cells := append([]string{
 			truncateIfNeeded(w.ID, idWidth),
 			w.Status,
-			truncateIfNeeded(w.Type, typeWidth),
+			truncateIfNeeded(wl.favoriteTypeLabel(w.Type), typeWidth),
 			formatRelativeTime(now, w.StartTime),
-		)
+		}, wl.searchAttributeCells(w.SearchAttributes)...)
+		wl.table.AddRowWithStatus(statusHandle, 1, cells...) // status column is index 1
+		wl.table.SetRowKey(i, keyOf(w).rowKey())
 	}
+	wl.restoreTableSelection()
 
 	if wl.table.RowCount() > 0 {
 		if currentRow >= 0 && currentRow < len(wl.workflows) {
@@ -163,6 +259,8 @@ func (wl *WorkflowList) updatePreview(w temporal.Workflow) {
 		durationStr = time.Since(w.StartTime).Round(time.Second).String()
 	}
 
+	link := wl.app.WorkflowWebURL(wl.namespace, w.ID, w.RunID)
+
 	text := fmt.Sprintf(`[%s::b]Workflow[-:-:-]
 [%s]%s[-]
 
@@ -187,7 +285,7 @@ func (wl *WorkflowList) updatePreview(w temporal.Workflow) {
 [%s]Run ID[-]
 [%s]%s[-]`,
 		theme.TagPanelTitle(),
-		theme.TagFg(), truncate(w.ID, 35),
+		theme.TagFg(), hyperlink(truncate(w.ID, 35), link),
 		theme.TagFgDim(),
 		statusColor, statusIcon, w.Status,
 		theme.TagFgDim(),
@@ -201,18 +299,73 @@ func (wl *WorkflowList) updatePreview(w temporal.Workflow) {
 		theme.TagFgDim(),
 		theme.TagFg(), w.TaskQueue,
 		theme.TagFgDim(),
-		theme.TagFgDim(), truncate(w.RunID, 30),
+		theme.TagFgDim(), hyperlink(truncate(w.RunID, 30), link),
 	)
+	if wl.compact() {
+		text = strings.ReplaceAll(text, "\n\n", "\n")
+	}
 	wl.preview.SetText(text)
 }
 
+// loadCounts counts the workflows matching the visibility query on the
+// server, alongside the list load, and updates the footer stats when done.
+func (wl *WorkflowList) loadCounts() {
+	provider := wl.app.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := wl.namespace
+	query := wl.visibilityQuery
+
+	go func() {
+		resolved, err := resolveTimePlaceholders(query)
+		if err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		counts, err := provider.CountWorkflows(ctx, namespace, resolved)
+		if err != nil {
+			// The footer falls back to counting the loaded rows
+			return
+		}
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			if query != wl.visibilityQuery {
+				return
+			}
+			wl.counts = counts
+			wl.countsQuery = query
+			wl.updateStats()
+		})
+	}()
+}
+
+// updateStats shows the workflow counts in the footer. The server's counts
+// for the visibility query are used when loaded, since the list holds only
+// one page; with a local filter, or before they arrive, the loaded rows are
+// counted instead.
 func (wl *WorkflowList) updateStats() {
+	if wl.counts != nil && wl.countsQuery == wl.visibilityQuery && wl.filterText == "" {
+		by := wl.counts.ByStatus
+		wl.app.SetWorkflowStats(WorkflowStats{
+			Running:    int(by["Running"]),
+			Completed:  int(by["Completed"] + by["ContinuedAsNew"]),
+			Failed:     int(by["Failed"]),
+			Canceled:   int(by["Canceled"]),
+			Terminated: int(by["Terminated"]),
+			TimedOut:   int(by["TimedOut"]),
+			Total:      int(wl.counts.Total),
+		})
+		return
+	}
+
 	var running, completed, failed int
 	for _, w := range wl.workflows {
 		switch w.Status {
 		case "Running":
 			running++
-		case "Completed":
+		case "Completed", "ContinuedAsNew":
 			completed++
 		case "Failed":
 			failed++
@@ -227,9 +380,9 @@ func (wl *WorkflowList) updateStats() {
 
 func (wl *WorkflowList) showError(err error) {
 	wl.table.ClearRows()
-	wl.table.SetHeaders("WORKFLOW ID", "STATUS", "TYPE", "START TIME")
+	wl.table.SetHeaders(wl.listHeaders()...)
 	wl.table.AddRowWithColor(theme.Error(),
-		theme.IconError+" Error loading workflows",
+		icons.Error+" Error loading workflows",
 		err.Error(),
 		"",
 		"",
@@ -274,6 +427,7 @@ func (wl *WorkflowList) calculateColumnWidths() (int, int) {
 	)
 
 	fixedWidth := statusWidth + startTimeWidth + separators
+	fixedWidth += len(wl.searchAttributeColumns()) * (searchAttributeColumnWidth + 2)
 	availableForVariable := width - fixedWidth
 
 	if availableForVariable <= 0 {
@@ -0,0 +1,82 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// isFavoriteType reports whether a workflow type is starred in config.
+func (wl *WorkflowList) isFavoriteType(workflowType string) bool {
+	cfg := wl.app.Config()
+	return cfg != nil && cfg.IsFavoriteType(workflowType)
+}
+
+// pinFavorites moves workflows of starred types to the top, keeping the
+// current sort order within both groups. The input slice is not modified.
+func (wl *WorkflowList) pinFavorites(workflows []temporal.Workflow) []temporal.Workflow {
+	cfg := wl.app.Config()
+	if cfg == nil || len(cfg.FavoriteTypes) == 0 {
+		return workflows
+	}
+	pinned := make([]temporal.Workflow, 0, len(workflows))
+	var rest []temporal.Workflow
+	for _, w := range workflows {
+		if cfg.IsFavoriteType(w.Type) {
+			pinned = append(pinned, w)
+		} else {
+			rest = append(rest, w)
+		}
+	}
+	return append(pinned, rest...)
+}
+
+// favoriteTypeLabel prefixes a starred workflow type with a star.
+func (wl *WorkflowList) favoriteTypeLabel(workflowType string) string {
+	if wl.isFavoriteType(workflowType) {
+		return icons.Star + " " + workflowType
+	}
+	return workflowType
+}
+
+// toggleFavoriteType stars or unstars the selected workflow's type, saves the
+// config, and re-pins the list.
+func (wl *WorkflowList) toggleFavoriteType() {
+	cfg := wl.app.Config()
+	if cfg == nil {
+		wl.app.ToastWarning("Favorites need a config file")
+		return
+	}
+	_, _, workflowType := wl.CommandContext()
+	if workflowType == "" {
+		return
+	}
+
+	starred := cfg.ToggleFavoriteType(workflowType)
+	if err := cfg.Save(); err != nil {
+		cfg.ToggleFavoriteType(workflowType) // Undo, so the list matches the file
+		wl.app.ToastError(fmt.Sprintf("Failed to save favorites: %v", err))
+		return
+	}
+	if starred {
+		wl.app.ToastSuccess(fmt.Sprintf("Pinned %s to the top", workflowType))
+	} else {
+		wl.app.ToastSuccess(fmt.Sprintf("Unpinned %s", workflowType))
+	}
+
+	// Keep the cursor on the same workflow as it moves
+	row := wl.table.SelectedRow()
+	var key string
+	if row >= 0 && row < len(wl.workflows) {
+		key = keyOf(wl.workflows[row]).rowKey()
+	}
+	wl.populateTable()
+	for i, w := range wl.workflows {
+		if keyOf(w).rowKey() == key {
+			wl.table.SelectRow(i)
+			wl.updatePreview(w)
+			break
+		}
+	}
+}
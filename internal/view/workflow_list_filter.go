@@ -6,7 +6,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
 )
 
@@ -174,13 +174,13 @@ func (wl *WorkflowList) searchServer(searchTerm string) {
 // updateFilterTitle updates the panel title with filter info and hint.
 func (wl *WorkflowList) updateFilterTitle(filter, hint string) {
 	if filter == "" {
-		wl.SetMasterTitle(fmt.Sprintf("%s Workflows", theme.IconWorkflow))
+		wl.SetMasterTitle(fmt.Sprintf("%s Workflows", icons.Workflow))
 		wl.app.SetFilterSuggestion("")
 		return
 	}
 
 	// Show only what the user typed in the title (no autocomplete suffix)
-	title := fmt.Sprintf("%s Workflows (/%s)", theme.IconWorkflow, filter)
+	title := fmt.Sprintf("%s Workflows (/%s)", icons.Workflow, filter)
 	wl.SetMasterTitle(title)
 
 	// Set ghost text suggestion in command bar if we have a matching hint
@@ -0,0 +1,99 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/atterpac/jig/components"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// pivotField is a workflow field the list can be filtered to the selected
+// workflow's value of.
+type pivotField struct {
+	label     string
+	attribute string // Visibility search attribute
+	value     func(w temporal.Workflow) string
+}
+
+// pivotFields lists the fields offered by the "filter by value" menu.
+var pivotFields = []pivotField{
+	{"Task Queue", "TaskQueue", func(w temporal.Workflow) string { return w.TaskQueue }},
+	{"Workflow Type", "WorkflowType", func(w temporal.Workflow) string { return w.Type }},
+	{"Status", "ExecutionStatus", func(w temporal.Workflow) string { return w.Status }},
+	{"Workflow ID", "WorkflowId", func(w temporal.Workflow) string { return w.ID }},
+}
+
+// selectedWorkflow returns the workflow under the cursor.
+func (wl *WorkflowList) selectedWorkflow() (temporal.Workflow, bool) {
+	row := wl.table.SelectedRow()
+	if row < 0 || row >= len(wl.workflows) {
+		return temporal.Workflow{}, false
+	}
+	return wl.workflows[row], true
+}
+
+// pivotTo replaces the visibility query with one matching the selected
+// workflow's value of field.
+func (wl *WorkflowList) pivotTo(field pivotField) {
+	w, ok := wl.selectedWorkflow()
+	if !ok {
+		return
+	}
+	value := field.value(w)
+	if value == "" {
+		wl.app.ToastWarning(fmt.Sprintf("%s is not known for this workflow", field.label))
+		return
+	}
+	wl.applyVisibilityQuery(fmt.Sprintf("%s = '%s'", field.attribute, value))
+	wl.app.setFooterHints(wl.Hints())
+}
+
+// filterBySameTaskQueue shows every workflow on the selected workflow's task
+// queue, e.g. to see how widespread a stuck queue is.
+func (wl *WorkflowList) filterBySameTaskQueue() {
+	wl.pivotTo(pivotFields[0])
+}
+
+// showPivotMenu lists the selected workflow's field values and filters the
+// list to the chosen one.
+func (wl *WorkflowList) showPivotMenu() {
+	w, ok := wl.selectedWorkflow()
+	if !ok {
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Filter by Value", icons.Search),
+		Width:    70,
+		Height:   10,
+		Backdrop: true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("FIELD", "VALUE")
+	table.SetBorder(false)
+	for _, field := range pivotFields {
+		table.AddRow(field.label, truncate(field.value(w), 45))
+	}
+	table.SelectRow(0)
+
+	table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(pivotFields) {
+			wl.closeModal()
+			wl.pivotTo(pivotFields[row])
+		}
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Filter"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		wl.closeModal()
+	})
+
+	wl.app.JigApp().Pages().Push(modal)
+	wl.app.JigApp().SetFocus(table)
+}
@@ -1,6 +1,8 @@
 package view
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,47 +10,67 @@ import (
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
 func (wl *WorkflowList) showVisibilityQuery() {
+	examples := fmt.Sprintf(`[%s]Examples:[-]
+  WorkflowType = 'OrderWorkflow'
+  ExecutionStatus = 'Running'
+  StartTime > '2024-01-01T00:00:00Z'
+  WorkflowId STARTS_WITH 'order-'`,
+		theme.TagFgDim())
+	helpText := tview.NewTextView().SetDynamicColors(true)
+	helpText.SetBackgroundColor(theme.Bg())
+
 	form := components.NewFormBuilder().
 		Text("query", "Query").
 			Value(wl.visibilityQuery).
 			Done().
 		OnSubmit(func(values map[string]any) {
 			query := values["query"].(string)
-			wl.closeModal()
-			wl.applyVisibilityQuery(query)
+			helpText.SetText(fmt.Sprintf("[%s]Checking query...[-]", theme.TagFgDim()))
+			wl.checkVisibilityQuery(query, func(err error) {
+				if err != nil {
+					helpText.SetText(formatQueryError(err))
+					return
+				}
+				wl.closeModal()
+				wl.setVisibilityQuery(query)
+			})
 		}).
 		OnCancel(func() {
 			wl.closeModal()
 		}).
 		Build()
+	helpText.SetText(examples)
 
-	helpText := tview.NewTextView().SetDynamicColors(true)
-	helpText.SetBackgroundColor(theme.Bg())
-	helpText.SetText(fmt.Sprintf(`[%s]Examples:[-]
-  WorkflowType = 'OrderWorkflow'
-  ExecutionStatus = 'Running'
-  StartTime > '2024-01-01T00:00:00Z'
-  WorkflowId STARTS_WITH 'order-'`,
-		theme.TagFgDim()))
-
+	completer := attachCompleter(form, "query",
+		completionSource{items: wl.app.KnownWorkflowTypes(wl.namespace), split: queryValueType, noun: "workflow type"},
+		completionSource{items: searchAttributeNames(temporal.BuiltinSearchAttributes), split: queryFieldName, noun: "search attribute"},
+	)
+	wl.loadQueryFields(completer, 1)
 	content := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(form, 3, 0, true).
+		AddItem(completer.list, 1, 0, false).
 		AddItem(helpText, 0, 1, false)
 	content.SetBackgroundColor(theme.Bg())
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Visibility Query", theme.IconSearch),
+		Title:    fmt.Sprintf("%s Visibility Query", icons.Search),
 		Width:    70,
-		Height:   16,
+		Height:   17,
 		Backdrop: true,
 	})
 	modal.SetContent(content)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Ctrl+S", Description: "Apply"},
+		{Key: "Ctrl+N/P", Description: "Complete"},
 		{Key: "Esc", Description: "Cancel"},
 	})
 
@@ -56,7 +78,53 @@ func (wl *WorkflowList) showVisibilityQuery() {
 	wl.app.JigApp().SetFocus(form)
 }
 
+// loadQueryFields replaces the built-in search attribute completions with
+// the namespace's registered attributes, including its custom ones.
+func (wl *WorkflowList) loadQueryFields(completer *typeCompleter, source int) {
+	provider := wl.app.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := wl.namespace
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		attrs, err := provider.ListSearchAttributes(ctx, namespace)
+		if err != nil || len(attrs) == 0 {
+			// Keep the built-in names; the query itself still works
+			return
+		}
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			completer.setItems(source, searchAttributeNames(attrs))
+		})
+	}()
+}
+
+// searchAttributeNames returns the names of the given search attributes.
+func searchAttributeNames(attrs []temporal.SearchAttributeDefinition) []string {
+	names := make([]string, len(attrs))
+	for i, attr := range attrs {
+		names[i] = attr.Name
+	}
+	return names
+}
+
+// applyVisibilityQuery checks a query and, if the server accepts it, shows
+// the workflows it matches. A rejected query leaves the list as it was.
 func (wl *WorkflowList) applyVisibilityQuery(query string) {
+	wl.checkVisibilityQuery(query, func(err error) {
+		if err != nil {
+			wl.app.ToastError(fmt.Sprintf("Invalid query: %v", err))
+			return
+		}
+		wl.setVisibilityQuery(query)
+	})
+}
+
+// setVisibilityQuery makes query the active visibility query and reloads.
+func (wl *WorkflowList) setVisibilityQuery(query string) {
 	if query != "" && query != wl.visibilityQuery {
 		wl.addToHistory(query)
 	}
@@ -64,6 +132,60 @@ func (wl *WorkflowList) applyVisibilityQuery(query string) {
 	wl.filterText = ""
 	wl.updatePanelTitle()
 	wl.loadData()
+	wl.app.setFooterHints(wl.Hints())
+}
+
+// checkVisibilityQuery lints a query, then dry-runs it with a one-row list,
+// and calls done on the UI goroutine with the problem found, if any. Failures
+// unrelated to the query, such as a timeout, are left for the real list to
+// report.
+func (wl *WorkflowList) checkVisibilityQuery(query string, done func(err error)) {
+	if strings.TrimSpace(query) == "" {
+		done(nil)
+		return
+	}
+	if err := temporal.LintQuery(query); err != nil {
+		done(err)
+		return
+	}
+	resolved, err := resolveTimePlaceholders(query)
+	if err != nil {
+		done(err)
+		return
+	}
+	provider := wl.app.Provider()
+	if provider == nil {
+		done(nil)
+		return
+	}
+	namespace := wl.namespace
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := provider.ValidateQuery(ctx, namespace, resolved)
+		if !temporal.IsQueryError(err) {
+			err = nil
+		}
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			done(err)
+		})
+	}()
+}
+
+// formatQueryError renders a query problem for the query form, with the
+// offending clause on its own line.
+func formatQueryError(err error) string {
+	var qe *temporal.QueryError
+	if !errors.As(err, &qe) {
+		return fmt.Sprintf("[%s]%s %s[-]", theme.TagError(), icons.Error, tview.Escape(err.Error()))
+	}
+	text := fmt.Sprintf("[%s]%s %s[-]", theme.TagError(), icons.Error, tview.Escape(qe.Reason))
+	if qe.Clause != "" {
+		text += fmt.Sprintf("\n[%s]In:[-] [%s]%s[-]", theme.TagFgDim(), theme.TagAccent(), tview.Escape(qe.Clause))
+	}
+	return text
 }
 
 func (wl *WorkflowList) addToHistory(query string) {
@@ -103,7 +225,7 @@ func (wl *WorkflowList) showQueryTemplates() {
 	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query Templates", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Query Templates", icons.Info),
 		Width:    70,
 		Height:   24,
 		Backdrop: true,
@@ -162,7 +284,7 @@ func (wl *WorkflowList) showDateRangePicker() {
 		Build()
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Date Range Filter", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Date Range Filter", icons.Info),
 		Width:    55,
 		Height:   14,
 		Backdrop: true,
@@ -203,45 +325,100 @@ func (wl *WorkflowList) applyDatePreset(preset string) {
 	wl.applyVisibilityQuery(query)
 }
 
+// savedFilters returns the named filters saved in the config.
+func (wl *WorkflowList) savedFilters() []config.SavedFilter {
+	cfg := wl.app.Config()
+	if cfg == nil {
+		return nil
+	}
+	return cfg.GetSavedFilters()
+}
+
+// showSavedFilters lists the saved filters, then this session's query
+// history, and applies the one chosen.
 func (wl *WorkflowList) showSavedFilters() {
-	// For now, show history as "saved" filters
-	if len(wl.searchHistory) == 0 {
+	if len(wl.savedFilters()) == 0 && len(wl.searchHistory) == 0 {
 		wl.showNoSavedFilters()
 		return
 	}
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query History", theme.IconInfo),
-		Width:    70,
+		Title:    fmt.Sprintf("%s Saved Filters", icons.Info),
+		Width:    80,
 		Height:   18,
 		Backdrop: true,
 	})
 
 	table := components.NewTable()
-	table.SetHeaders("#", "QUERY")
 	table.SetBorder(false)
 
-	// Show most recent first
-	for i := len(wl.searchHistory) - 1; i >= 0; i-- {
-		table.AddRow(
-			fmt.Sprintf("%d", len(wl.searchHistory)-i),
-			truncate(wl.searchHistory[i], 55),
-		)
+	// Rows are the saved filters followed by the history, most recent first
+	var queries []string
+	var saved int
+	render := func() {
+		row := table.SelectedRow()
+		table.ClearRows()
+		table.SetHeaders("NAME", "QUERY")
+		queries = queries[:0]
+
+		filters := wl.savedFilters()
+		saved = len(filters)
+		for _, f := range filters {
+			table.AddRow(truncate(f.Name, 20), truncate(f.Query, 55))
+			queries = append(queries, f.Query)
+		}
+		for i := len(wl.searchHistory) - 1; i >= 0; i-- {
+			table.AddRowWithColor(theme.FgDim(),
+				fmt.Sprintf("recent #%d", len(wl.searchHistory)-i),
+				truncate(wl.searchHistory[i], 55),
+			)
+			queries = append(queries, wl.searchHistory[i])
+		}
+
+		if row < 0 || row >= len(queries) {
+			row = 0
+		}
+		table.SelectRow(row)
 	}
-	table.SelectRow(0)
+	render()
 
 	table.SetOnSelect(func(row int) {
-		// Convert display row to history index (most recent first)
-		historyIdx := len(wl.searchHistory) - 1 - row
-		if historyIdx >= 0 && historyIdx < len(wl.searchHistory) {
+		if row >= 0 && row < len(queries) {
 			wl.closeModal()
-			wl.applyVisibilityQuery(wl.searchHistory[historyIdx])
+			wl.applyVisibilityQuery(queries[row])
 		}
 	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() != tcell.KeyRune || event.Rune() != 'd' {
+			return event
+		}
+		row := table.SelectedRow()
+		if row < 0 || row >= saved {
+			return nil
+		}
+		cfg := wl.app.Config()
+		name := cfg.GetSavedFilters()[row].Name
+		if err := cfg.DeleteFilter(name); err != nil {
+			wl.app.ToastError(err.Error())
+			return nil
+		}
+		if err := cfg.Save(); err != nil {
+			wl.app.ToastError(fmt.Sprintf("Failed to save config: %v", err))
+			return nil
+		}
+		wl.app.ToastSuccess(fmt.Sprintf("Deleted filter %q", name))
+		if len(queries) == 1 {
+			wl.closeModal()
+			return nil
+		}
+		render()
+		return nil
+	})
 
 	modal.SetContent(table)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Enter", Description: "Apply"},
+		{Key: "d", Description: "Delete saved"},
 		{Key: "Esc", Description: "Cancel"},
 	})
 	modal.SetOnCancel(func() {
@@ -254,7 +431,7 @@ func (wl *WorkflowList) showSavedFilters() {
 
 func (wl *WorkflowList) showNoSavedFilters() {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query History", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Saved Filters", icons.Info),
 		Width:    50,
 		Height:   10,
 		Backdrop: true,
@@ -263,10 +440,10 @@ func (wl *WorkflowList) showNoSavedFilters() {
 	text := tview.NewTextView().SetDynamicColors(true)
 	text.SetBackgroundColor(theme.Bg())
 	text.SetTextAlign(tview.AlignCenter)
-	text.SetText(fmt.Sprintf(`[%s]No query history yet.[-]
+	text.SetText(fmt.Sprintf(`[%s]No saved filters or query history yet.[-]
 
-[%s]Use 'F' to enter a visibility query.
-Your queries will be saved here.[-]`,
+[%s]Use 'F' to enter a visibility query,
+then 'S' to save it with a name.[-]`,
 		theme.TagFgDim(),
 		theme.TagFg()))
 
@@ -282,20 +459,38 @@ Your queries will be saved here.[-]`,
 	wl.app.JigApp().SetFocus(modal)
 }
 
+// showSaveFilter saves the current visibility query as a named filter in the
+// config. Saving under an existing name replaces that filter's query.
 func (wl *WorkflowList) showSaveFilter() {
 	if wl.visibilityQuery == "" {
 		return
 	}
+	cfg := wl.app.Config()
+	if cfg == nil {
+		wl.app.ToastWarning("Saved filters need a config file")
+		return
+	}
 
 	currentQuery := wl.visibilityQuery
 	form := components.NewFormBuilder().
 		Text("name", "Filter Name").
 			Placeholder("Enter a name for this filter").
+			Validate(validators.Required()).
 			Done().
 		OnSubmit(func(values map[string]any) {
-			// For now, just add to history (persistent save would require config storage)
-			wl.addToHistory(currentQuery)
+			name := strings.TrimSpace(values["name"].(string))
+			existing, replaced := cfg.GetSavedFilter(name)
+			cfg.SaveFilter(config.SavedFilter{Name: name, Query: currentQuery, IsDefault: existing.IsDefault})
 			wl.closeModal()
+			if err := cfg.Save(); err != nil {
+				wl.app.ToastError(fmt.Sprintf("Failed to save filter: %v", err))
+				return
+			}
+			if replaced {
+				wl.app.ToastSuccess(fmt.Sprintf("Updated filter %q", name))
+			} else {
+				wl.app.ToastSuccess(fmt.Sprintf("Saved filter %q", name))
+			}
 		}).
 		OnCancel(func() {
 			wl.closeModal()
@@ -304,7 +499,7 @@ func (wl *WorkflowList) showSaveFilter() {
 
 	queryText := tview.NewTextView().SetDynamicColors(true)
 	queryText.SetBackgroundColor(theme.Bg())
-	queryText.SetText(fmt.Sprintf("[%s]Query:[-] %s", theme.TagFgDim(), currentQuery))
+	queryText.SetText(fmt.Sprintf("[%s]Query:[-] %s", theme.TagFgDim(), tview.Escape(currentQuery)))
 
 	content := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(queryText, 2, 0, false).
@@ -312,7 +507,7 @@ func (wl *WorkflowList) showSaveFilter() {
 	content.SetBackgroundColor(theme.Bg())
 
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Save Filter", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Save Filter", icons.Info),
 		Width:    60,
 		Height:   12,
 		Backdrop: true,
@@ -331,58 +526,90 @@ func (wl *WorkflowList) clearVisibilityQuery() {
 	wl.visibilityQuery = ""
 	wl.updatePanelTitle()
 	wl.loadData()
-	wl.app.JigApp().Menu().SetHints(wl.Hints())
+	wl.app.setFooterHints(wl.Hints())
 }
 
 func (wl *WorkflowList) updatePanelTitle() {
-	title := fmt.Sprintf("%s Workflows", theme.IconWorkflow)
-	if wl.visibilityQuery != "" {
+	base := fmt.Sprintf("%s Workflows", icons.Workflow)
+	if wl.app.readOnlyNamespace() {
+		base += " [system · read-only]"
+	}
+	tabs, tabActive := wl.pinnedTabsLabel()
+	if tabs != "" {
+		base = fmt.Sprintf("%s │ %s", base, tabs)
+	}
+
+	title := base
+	if wl.visibilityQuery != "" && !tabActive {
 		q := wl.visibilityQuery
 		if len(q) > 40 {
 			q = q[:37] + "..."
 		}
 		// Panel doesn't parse tview color codes, use plain text
-		title = fmt.Sprintf("%s Workflows (%s)", theme.IconWorkflow, q)
+		title = fmt.Sprintf("%s (%s)", base, q)
 	} else if wl.filterText != "" {
-		title = fmt.Sprintf("%s Workflows (/%s)", theme.IconWorkflow, wl.filterText)
+		title = fmt.Sprintf("%s (/%s)", base, wl.filterText)
+	}
+	if label := wl.sortLabel(); label != "" {
+		title = fmt.Sprintf("%s · %s", title, label)
+	}
+	if wl.loadingMore {
+		title = fmt.Sprintf("%s · loading more…", title)
+	}
+	if wl.selectionMode {
+		title = fmt.Sprintf("%s [Select Mode · %d selected]", title, len(wl.selected))
 	}
 	wl.SetMasterTitle(title)
 }
 
-// resolveTimePlaceholders resolves time-based placeholders in Temporal visibility queries.
-// Supported placeholders (using local timezone):
+// resolveTimePlaceholders resolves time-based placeholders in Temporal visibility queries
+// to quoted RFC3339 UTC timestamps. Day boundaries follow the local timezone.
+// Supported placeholders:
+//   - $NOW: The current time
 //   - $TODAY: Start of today (00:00:00)
 //   - $YESTERDAY: Start of yesterday (00:00:00)
 //   - $THIS_WEEK: Start of current week (Monday 00:00:00)
+//   - $WEEK_AGO: 7 days ago
 //   - $HOUR_AGO: 1 hour ago
 //   - $HOURS_AGO_N: N hours ago (e.g., $HOURS_AGO_6)
 //   - $MINUTES_AGO_N: N minutes ago (e.g., $MINUTES_AGO_30)
-//   - $DAYS_AGO_N: N days ago at 00:00:00 (e.g., $DAYS_AGO_7)
+//   - $DAYS_AGO_N or $DAYS_AGO(N): N days ago at 00:00:00 (e.g., $DAYS_AGO(7))
 func resolveTimePlaceholders(query string) (string, error) {
-	now := time.Now()
+	return resolveTimePlaceholdersAt(query, time.Now())
+}
+
+// resolveTimePlaceholdersAt resolves placeholders relative to now.
+func resolveTimePlaceholdersAt(query string, now time.Time) (string, error) {
+	quote := func(t time.Time) string {
+		return "'" + t.UTC().Format(time.RFC3339) + "'"
+	}
 
 	// Simple placeholders
 	replacements := map[string]string{
-		"$TODAY":     startOfDay(now).Format(time.RFC3339),
-		"$YESTERDAY": startOfDay(now.AddDate(0, 0, -1)).Format(time.RFC3339),
-		"$THIS_WEEK": startOfWeek(now).Format(time.RFC3339),
-		"$HOUR_AGO":  now.Add(-1 * time.Hour).Format(time.RFC3339),
+		"$NOW":       quote(now),
+		"$TODAY":     quote(startOfDay(now)),
+		"$YESTERDAY": quote(startOfDay(now.AddDate(0, 0, -1))),
+		"$THIS_WEEK": quote(startOfWeek(now)),
+		"$WEEK_AGO":  quote(now.AddDate(0, 0, -7)),
+		"$HOUR_AGO":  quote(now.Add(-1 * time.Hour)),
 	}
 
 	result := query
 	for placeholder, value := range replacements {
-		result = strings.ReplaceAll(result, placeholder, "'"+value+"'")
+		result = strings.ReplaceAll(result, placeholder, value)
 	}
 
-	// Pattern-based placeholders: $HOURS_AGO_N, $MINUTES_AGO_N, $DAYS_AGO_N
+	// Pattern-based placeholders: $HOURS_AGO_N, $MINUTES_AGO_N, $DAYS_AGO_N, $DAYS_AGO(N)
 	patterns := []struct {
 		prefix string
+		suffix string // Closes the number, e.g. ")" for $DAYS_AGO(N)
 		unit   time.Duration
 		isDate bool // If true, use start of day
 	}{
-		{"$HOURS_AGO_", time.Hour, false},
-		{"$MINUTES_AGO_", time.Minute, false},
-		{"$DAYS_AGO_", 24 * time.Hour, true},
+		{"$HOURS_AGO_", "", time.Hour, false},
+		{"$MINUTES_AGO_", "", time.Minute, false},
+		{"$DAYS_AGO_", "", 24 * time.Hour, true},
+		{"$DAYS_AGO(", ")", 24 * time.Hour, true},
 	}
 
 	for _, p := range patterns {
@@ -393,16 +620,20 @@ func resolveTimePlaceholders(query string) (string, error) {
 			}
 
 			// Find the end of the number
-			endIdx := idx + len(p.prefix)
+			numStart := idx + len(p.prefix)
+			endIdx := numStart
 			for endIdx < len(result) && result[endIdx] >= '0' && result[endIdx] <= '9' {
 				endIdx++
 			}
 
-			if endIdx == idx+len(p.prefix) {
+			if endIdx == numStart {
 				return "", fmt.Errorf("invalid placeholder: %s (missing number)", p.prefix)
 			}
+			if !strings.HasPrefix(result[endIdx:], p.suffix) {
+				return "", fmt.Errorf("invalid placeholder: %s%s (missing %q)", p.prefix, result[numStart:endIdx], p.suffix)
+			}
 
-			numStr := result[idx+len(p.prefix) : endIdx]
+			numStr := result[numStart:endIdx]
 			num, err := strconv.Atoi(numStr)
 			if err != nil {
 				return "", fmt.Errorf("invalid number in placeholder %s%s: %w", p.prefix, numStr, err)
@@ -410,13 +641,13 @@ func resolveTimePlaceholders(query string) (string, error) {
 
 			var t time.Time
 			if p.isDate {
-				t = startOfDay(now.Add(-time.Duration(num) * p.unit))
+				t = startOfDay(now.AddDate(0, 0, -num))
 			} else {
 				t = now.Add(-time.Duration(num) * p.unit)
 			}
 
-			placeholder := p.prefix + numStr
-			result = strings.Replace(result, placeholder, "'"+t.Format(time.RFC3339)+"'", 1)
+			placeholder := p.prefix + numStr + p.suffix
+			result = strings.Replace(result, placeholder, quote(t), 1)
 		}
 	}
 
@@ -0,0 +1,107 @@
+package view
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveTimePlaceholdersAt(t *testing.T) {
+	// Friday 10:30 two hours east of UTC, so day boundaries differ from UTC
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "now",
+			query: "StartTime < $NOW",
+			want:  "StartTime < '2024-03-15T08:30:00Z'",
+		},
+		{
+			name:  "today",
+			query: "StartTime > $TODAY",
+			want:  "StartTime > '2024-03-14T22:00:00Z'",
+		},
+		{
+			name:  "yesterday",
+			query: "StartTime > $YESTERDAY",
+			want:  "StartTime > '2024-03-13T22:00:00Z'",
+		},
+		{
+			name:  "this week",
+			query: "StartTime > $THIS_WEEK",
+			want:  "StartTime > '2024-03-10T22:00:00Z'",
+		},
+		{
+			name:  "week ago",
+			query: "StartTime > $WEEK_AGO",
+			want:  "StartTime > '2024-03-08T08:30:00Z'",
+		},
+		{
+			name:  "hour ago",
+			query: "StartTime > $HOUR_AGO",
+			want:  "StartTime > '2024-03-15T07:30:00Z'",
+		},
+		{
+			name:  "hours ago",
+			query: "StartTime > $HOURS_AGO_6",
+			want:  "StartTime > '2024-03-15T02:30:00Z'",
+		},
+		{
+			name:  "minutes ago",
+			query: "StartTime > $MINUTES_AGO_30",
+			want:  "StartTime > '2024-03-15T08:00:00Z'",
+		},
+		{
+			name:  "days ago underscore",
+			query: "StartTime > $DAYS_AGO_2",
+			want:  "StartTime > '2024-03-12T22:00:00Z'",
+		},
+		{
+			name:  "days ago call",
+			query: "StartTime > $DAYS_AGO(7)",
+			want:  "StartTime > '2024-03-07T22:00:00Z'",
+		},
+		{
+			name:  "several placeholders",
+			query: "StartTime > $DAYS_AGO(1) AND StartTime < $NOW AND CloseTime > $DAYS_AGO(1)",
+			want:  "StartTime > '2024-03-13T22:00:00Z' AND StartTime < '2024-03-15T08:30:00Z' AND CloseTime > '2024-03-13T22:00:00Z'",
+		},
+		{
+			name:  "no placeholders",
+			query: "WorkflowType = 'Order'",
+			want:  "WorkflowType = 'Order'",
+		},
+		{
+			name:    "missing number",
+			query:   "StartTime > $HOURS_AGO_",
+			wantErr: true,
+		},
+		{
+			name:    "missing closing paren",
+			query:   "StartTime > $DAYS_AGO(3",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveTimePlaceholdersAt(tt.query, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("resolveTimePlaceholdersAt(%q) = %q, want error", tt.query, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveTimePlaceholdersAt(%q) error: %v", tt.query, err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveTimePlaceholdersAt(%q)\n got  %q\n want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
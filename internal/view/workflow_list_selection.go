@@ -0,0 +1,86 @@
+package view
+
+import (
+	"sort"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// workflowKey identifies a workflow execution independently of its row position.
+type workflowKey struct {
+	WorkflowID string
+	RunID      string
+}
+
+func keyOf(w temporal.Workflow) workflowKey {
+	return workflowKey{WorkflowID: w.ID, RunID: w.RunID}
+}
+
+// rowKey is the table row key for a workflow execution.
+func (k workflowKey) rowKey() string {
+	return k.WorkflowID + "\x00" + k.RunID
+}
+
+// The selection set is kept separately from the table so that selections
+// survive reloads, filter changes, and page loads. The table's own
+// multi-select state only mirrors the set for the rows currently visible.
+
+// toggleRowSelection toggles the workflow under the cursor in the selection set.
+func (wl *WorkflowList) toggleRowSelection() {
+	row := wl.table.SelectedRow()
+	if row < 0 || row >= len(wl.workflows) {
+		return
+	}
+	w := wl.workflows[row]
+	key := keyOf(w)
+	if _, ok := wl.selected[key]; ok {
+		delete(wl.selected, key)
+	} else {
+		wl.selected[key] = w
+	}
+	wl.table.ToggleSelectionByKey(key.rowKey())
+	wl.updatePanelTitle()
+}
+
+// selectAllVisible adds every visible workflow to the selection set.
+func (wl *WorkflowList) selectAllVisible() {
+	for _, w := range wl.workflows {
+		wl.selected[keyOf(w)] = w
+	}
+	wl.table.SelectAll()
+	wl.updatePanelTitle()
+}
+
+// clearSelection empties the selection set.
+func (wl *WorkflowList) clearSelection() {
+	wl.selected = make(map[workflowKey]temporal.Workflow)
+	wl.table.ClearSelection()
+}
+
+// selectedWorkflows returns the selected workflows, most recently started first.
+func (wl *WorkflowList) selectedWorkflows() []temporal.Workflow {
+	workflows := make([]temporal.Workflow, 0, len(wl.selected))
+	for _, w := range wl.selected {
+		workflows = append(workflows, w)
+	}
+	sort.Slice(workflows, func(i, j int) bool {
+		return workflows[i].StartTime.After(workflows[j].StartTime)
+	})
+	return workflows
+}
+
+// restoreTableSelection marks visible rows that are in the selection set and
+// refreshes the stored workflows with the latest loaded data.
+// Called after the table has been repopulated.
+func (wl *WorkflowList) restoreTableSelection() {
+	if len(wl.selected) == 0 {
+		return
+	}
+	for _, w := range wl.workflows {
+		key := keyOf(w)
+		if _, ok := wl.selected[key]; ok {
+			wl.selected[key] = w
+			wl.table.SelectByKey(key.rowKey())
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// batchJobPollInterval is how often a started batch job's progress is fetched.
+const batchJobPollInterval = 2 * time.Second
+
+// batchJobMaxPollFailures is how many fetches in a row may fail before a
+// batch job stops being followed.
+const batchJobMaxPollFailures = 5
+
+// serverBatchOps are the operations the server can apply to every workflow
+// matching the visibility query.
+var serverBatchOps = []struct {
+	op          temporal.BatchOperationType
+	description string
+}{
+	{temporal.BatchCancel, "Request cancellation of every matching workflow"},
+	{temporal.BatchSignal, "Send a signal to every matching workflow"},
+	{temporal.BatchTerminate, "Terminate every matching workflow"},
+	{temporal.BatchReset, "Reset every matching workflow to a workflow task"},
+}
+
+// showServerBatchMenu offers the server-side batch operations for the
+// current visibility query. Unlike selection mode, the server finds and
+// updates the workflows itself, so the query may match any number of them.
+func (wl *WorkflowList) showServerBatchMenu() {
+	if wl.app.blockReadOnly() {
+		return
+	}
+	if wl.visibilityQuery == "" {
+		wl.app.ToastWarning("Set a visibility query with F to run a batch operation")
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Batch Operation", icons.Warning),
+		Width:    75,
+		Height:   len(serverBatchOps) + 6,
+		Backdrop: true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("OPERATION", "DESCRIPTION")
+	table.SetBorder(false)
+	for _, op := range serverBatchOps {
+		table.AddRow(string(op.op), op.description)
+	}
+	table.SelectRow(0)
+
+	table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(serverBatchOps) {
+			wl.closeModal()
+			wl.showServerBatchForm(serverBatchOps[row].op)
+		}
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Choose"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		wl.closeModal()
+	})
+
+	wl.app.JigApp().Pages().Push(modal)
+	wl.app.JigApp().SetFocus(table)
+}
+
+// showServerBatchForm collects the options for a batch operation and starts
+// it against the current visibility query.
+func (wl *WorkflowList) showServerBatchForm(op temporal.BatchOperationType) {
+	// The server does not know tempo's time placeholders like $TODAY
+	query, err := resolveTimePlaceholders(wl.visibilityQuery)
+	if err != nil {
+		wl.app.ToastError(fmt.Sprintf("Invalid query: %v", err))
+		return
+	}
+	builder := components.NewFormBuilder()
+	modalHeight := 14
+
+	switch op {
+	case temporal.BatchSignal:
+		builder = builder.
+			Text("signalName", "Signal Name").
+				Placeholder("Enter signal name").
+				Validate(validators.Required()).
+				Done().
+			Text("input", "Input (JSON, optional)").
+				Placeholder("{}").
+				Validate(validators.Custom(func(value any) error {
+					input := strings.TrimSpace(value.(string))
+					if input != "" && !json.Valid([]byte(input)) {
+						return fmt.Errorf("input must be valid JSON")
+					}
+					return nil
+				})).
+				Done()
+		modalHeight += 6
+	case temporal.BatchReset:
+		builder = builder.
+			Select("resetTarget", "Reset To", []string{"Last workflow task", "First workflow task"}).
+				Done()
+		modalHeight += 3
+	}
+
+	reason := builder.Text("reason", "Reason (required)").
+		Placeholder("Enter reason for the batch operation").
+		Validate(validators.Required())
+	if op == temporal.BatchCancel {
+		reason = reason.Value("Batch cancelled via tempo")
+	}
+	builder = reason.Done()
+
+	// Terminate and reset cannot be undone and may reach many workflows, so
+	// they always need a typed confirmation
+	action := strings.ToLower(string(op))
+	fallback := ""
+	if op == temporal.BatchTerminate || op == temporal.BatchReset {
+		fallback = action
+	}
+	confirm := wl.app.confirmationFor(action, wl.namespace, fallback)
+	if confirm.required() {
+		builder = confirm.addField(builder, fmt.Sprintf("%q", confirm.phrase))
+		modalHeight += 4
+	}
+
+	form := builder.
+		OnSubmit(func(values map[string]any) {
+			if confirm.required() && !confirm.matches(values["confirm"].(string)) {
+				return
+			}
+			req := temporal.BatchOperationRequest{
+				Operation: op,
+				Query:     query,
+				Reason:    values["reason"].(string),
+			}
+			switch op {
+			case temporal.BatchSignal:
+				req.SignalName = values["signalName"].(string)
+				if input := strings.TrimSpace(values["input"].(string)); input != "" {
+					req.SignalInput = []byte(input)
+				}
+			case temporal.BatchReset:
+				req.ResetTarget = temporal.BatchResetLastWorkflowTask
+				if values["resetTarget"].(string) == "First workflow task" {
+					req.ResetTarget = temporal.BatchResetFirstWorkflowTask
+				}
+			}
+			wl.closeModal()
+			wl.startServerBatch(req)
+		}).
+		OnCancel(func() {
+			wl.closeModal()
+		}).
+		Build()
+
+	warningText := tview.NewTextView().SetDynamicColors(true)
+	warningText.SetBackgroundColor(theme.Bg())
+	warningText.SetText(fmt.Sprintf(`[%s]The server applies this to every workflow matching:[-]
+[%s]%s[-]%s`,
+		theme.TagFgDim(),
+		theme.TagAccent(), tview.Escape(truncate(query, 70)),
+		confirm.warning(wl.namespace)))
+
+	warningHeight := 3
+	if confirm.danger {
+		warningHeight++
+	}
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(warningText, warningHeight, 0, false).
+		AddItem(form, 0, 1, true)
+	content.SetBackgroundColor(theme.Bg())
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Batch %s", icons.Warning, op),
+		Width:    75,
+		Height:   modalHeight,
+		Backdrop: true,
+	})
+	modal.SetContent(content)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+S", Description: "Start"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+
+	wl.app.JigApp().Pages().Push(modal)
+	wl.app.JigApp().SetFocus(form)
+}
+
+// startServerBatch starts a batch job and follows its progress in the
+// preview panel until it finishes or the view is left. The preview goes back
+// to the selected workflow once the selection moves.
+func (wl *WorkflowList) startServerBatch(req temporal.BatchOperationRequest) {
+	provider := wl.app.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := wl.namespace
+
+	wl.stopFollowingBatchJob()
+	stop := make(chan struct{})
+	wl.batchJobStop = stop
+	wl.batchJobPreview = true
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		jobID, err := provider.StartBatchOperation(ctx, namespace, req)
+		cancel()
+
+		if err != nil {
+			wl.app.JigApp().QueueUpdateDraw(func() {
+				wl.app.ToastError(fmt.Sprintf("Batch %s failed to start: %v", strings.ToLower(string(req.Operation)), err))
+			})
+			return
+		}
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			wl.app.ToastSuccess(fmt.Sprintf("Started batch job %s", jobID))
+		})
+
+		ticker := time.NewTicker(batchJobPollInterval)
+		defer ticker.Stop()
+		failures := 0
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			job, err := provider.DescribeBatchOperation(ctx, namespace, jobID)
+			cancel()
+
+			// Ride out transient errors, the job keeps running on the server
+			if err != nil {
+				failures++
+				if failures < batchJobMaxPollFailures {
+					continue
+				}
+				wl.app.JigApp().QueueUpdateDraw(func() {
+					wl.app.ToastError(fmt.Sprintf("Stopped following batch job %s: %v", jobID, err))
+				})
+				return
+			}
+			failures = 0
+
+			wl.app.JigApp().QueueUpdateDraw(func() {
+				select {
+				case <-stop:
+					return // Left the view since the fetch
+				default:
+				}
+				if wl.batchJobPreview {
+					wl.preview.SetText(formatBatchJobProgress(job))
+				}
+				if job.Done() {
+					wl.app.ToastSuccess(fmt.Sprintf("Batch %s %s: %d of %d workflow(s), %d failed",
+						strings.ToLower(job.Operation), strings.ToLower(job.State),
+						job.CompletedCount, job.TotalCount, job.FailedCount))
+					wl.loadData()
+				}
+			})
+			if job.Done() {
+				return
+			}
+		}
+	}()
+}
+
+// stopFollowingBatchJob stops the progress updates of a started batch job.
+func (wl *WorkflowList) stopFollowingBatchJob() {
+	if wl.batchJobStop != nil {
+		close(wl.batchJobStop)
+		wl.batchJobStop = nil
+	}
+	wl.batchJobPreview = false
+}
+
+// formatBatchJobProgress renders a batch job's state for the preview panel.
+func formatBatchJobProgress(job *temporal.BatchOperation) string {
+	stateColor := theme.TagAccent()
+	switch job.State {
+	case "Completed":
+		stateColor = theme.TagSuccess()
+	case "Failed":
+		stateColor = theme.TagError()
+	}
+
+	text := fmt.Sprintf(`[%s::b]Batch %s[-:-:-]
+
+[%s]Job ID:[-] %s
+[%s]State:[-] [%s]%s[-]
+[%s]Completed:[-] %d of %d workflow(s)
+[%s]Failed:[-] %d workflow(s)
+[%s]Started:[-] %s`,
+		theme.TagPanelTitle(), job.Operation,
+		theme.TagFgDim(), tview.Escape(job.JobID),
+		theme.TagFgDim(), stateColor, job.State,
+		theme.TagFgDim(), job.CompletedCount, job.TotalCount,
+		theme.TagFgDim(), job.FailedCount,
+		theme.TagFgDim(), formatRelativeTime(time.Now(), job.StartTime))
+	if job.CloseTime != nil {
+		text += fmt.Sprintf("\n[%s]Took:[-] %s", theme.TagFgDim(), temporal.FormatDuration(job.CloseTime.Sub(job.StartTime)))
+	}
+	if job.Reason != "" {
+		text += fmt.Sprintf("\n[%s]Reason:[-] %s", theme.TagFgDim(), tview.Escape(job.Reason))
+	}
+	return text
+}
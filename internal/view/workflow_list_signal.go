@@ -8,11 +8,17 @@ import (
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
 )
 
 // showSignalWithStart displays a modal for SignalWithStart operation.
 func (wl *WorkflowList) showSignalWithStart() {
+	if wl.app.blockReadOnly() {
+		return
+	}
+
 	form := components.NewFormBuilder().
 		Text("workflowId", "Workflow ID").
 			Placeholder("Enter workflow ID").
@@ -52,15 +58,22 @@ func (wl *WorkflowList) showSignalWithStart() {
 		}).
 		Build()
 
+	suggestions := attachTypeCompleter(form, "workflowType", wl.app.KnownWorkflowTypes(wl.namespace), wholeValueType)
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(suggestions, 1, 0, false)
+	content.SetBackgroundColor(theme.Bg())
+
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Signal With Start (%s)", theme.IconInfo, wl.namespace),
+		Title:    fmt.Sprintf("%s Signal With Start (%s)", icons.Info, wl.namespace),
 		Width:    70,
-		Height:   20,
+		Height:   21,
 		Backdrop: true,
 	})
-	modal.SetContent(form)
+	modal.SetContent(content)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+N/P", Description: "Complete type"},
 		{Key: "Ctrl+S", Description: "Execute"},
 		{Key: "Esc", Description: "Cancel"},
 	})
@@ -112,14 +125,10 @@ func (wl *WorkflowList) executeSignalWithStart(workflowID, workflowType, taskQue
 // startDiff initiates workflow diff view.
 func (wl *WorkflowList) startDiff() {
 	// Check if we have 2 selected workflows in selection mode
-	selected := wl.table.GetSelectedRows()
-	if len(selected) == 2 {
-		if selected[0] < len(wl.workflows) && selected[1] < len(wl.workflows) {
-			wfA := wl.workflows[selected[0]]
-			wfB := wl.workflows[selected[1]]
-			wl.app.NavigateToWorkflowDiff(&wfA, &wfB)
-			return
-		}
+	if selected := wl.selectedWorkflows(); len(selected) == 2 {
+		wfA, wfB := selected[0], selected[1]
+		wl.app.NavigateToWorkflowDiff(&wfA, &wfB)
+		return
 	}
 
 	// Fall back to single workflow (left side only)
@@ -0,0 +1,173 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// sortFetchLimit caps how many workflows are fetched to sort client-side when
+// the server cannot sort.
+const sortFetchLimit = 1000
+
+// workflowSort is a sort order for the workflow list. orderBy is the
+// equivalent visibility ORDER BY clause.
+type workflowSort struct {
+	label   string
+	orderBy string
+	less    func(a, b temporal.Workflow) bool
+}
+
+// workflowSorts lists the orders cycled with O. The first entry is the
+// server's default order and needs no sorting.
+var workflowSorts = []workflowSort{
+	{label: "Newest"},
+	{
+		label:   "Oldest",
+		orderBy: "StartTime ASC",
+		less:    func(a, b temporal.Workflow) bool { return a.StartTime.Before(b.StartTime) },
+	},
+	{
+		label:   "Recently Closed",
+		orderBy: "CloseTime DESC",
+		less: func(a, b temporal.Workflow) bool {
+			if a.EndTime == nil || b.EndTime == nil {
+				return a.EndTime != nil
+			}
+			return a.EndTime.After(*b.EndTime)
+		},
+	},
+	{
+		label:   "Type",
+		orderBy: "WorkflowType ASC",
+		less:    func(a, b temporal.Workflow) bool { return a.Type < b.Type },
+	},
+	{
+		label:   "Status",
+		orderBy: "ExecutionStatus ASC",
+		less:    func(a, b temporal.Workflow) bool { return a.Status < b.Status },
+	},
+	{
+		label:   "Workflow ID",
+		orderBy: "WorkflowId ASC",
+		less:    func(a, b temporal.Workflow) bool { return a.ID < b.ID },
+	},
+}
+
+// sortPath records how the current sort was applied.
+type sortPath int
+
+const (
+	sortNone   sortPath = iota // default server order
+	sortServer                 // ORDER BY on the server
+	sortAll                    // every matching row loaded and sorted locally
+	sortLoaded                 // only the first rows loaded and sorted locally
+)
+
+// sortResult is the outcome of a sorted load.
+type sortResult struct {
+	workflows       []temporal.Workflow
+	path            sortPath
+	orderByRejected bool   // the server refused ORDER BY
+	pageQuery       string // query the next page must be fetched with
+	nextPage        string // token for the next page, "" if none or sorted locally
+}
+
+// currentSort returns the active sort order.
+func (wl *WorkflowList) currentSort() workflowSort {
+	return workflowSorts[wl.sortIndex]
+}
+
+// cycleSort switches to the next sort order and reloads the list.
+func (wl *WorkflowList) cycleSort() {
+	wl.sortIndex = (wl.sortIndex + 1) % len(workflowSorts)
+	wl.sortAnnounce = true
+	wl.loadData()
+}
+
+// hasOrderBy reports whether a visibility query already sets its own order.
+func hasOrderBy(query string) bool {
+	return strings.Contains(strings.ToUpper(query), "ORDER BY")
+}
+
+// fetchSortedWorkflows loads the workflows for query in the order ws.
+// When tryServer is set it asks the server to sort with ORDER BY first;
+// clusters without advanced visibility reject that as an invalid argument,
+// so it falls back to loading up to sortFetchLimit rows and sorting them
+// locally. Any other error is returned as is. progress is called with the
+// number of rows loaded so far while falling back.
+func fetchSortedWorkflows(ctx context.Context, provider temporal.Provider, namespace, query string, pageSize int, ws workflowSort, tryServer bool, progress func(int)) (sortResult, error) {
+	if ws.orderBy == "" || hasOrderBy(query) {
+		workflows, next, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: pageSize, Query: query})
+		return sortResult{workflows: workflows, path: sortNone, pageQuery: query, nextPage: next}, err
+	}
+
+	var result sortResult
+	if tryServer {
+		ordered := strings.TrimSpace(query + " ORDER BY " + ws.orderBy)
+		workflows, next, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: pageSize, Query: ordered})
+		if err == nil {
+			return sortResult{workflows: workflows, path: sortServer, pageQuery: ordered, nextPage: next}, nil
+		}
+		if !temporal.IsInvalidArgument(err) {
+			return result, err
+		}
+		result.orderByRejected = true
+	}
+
+	token := ""
+	for {
+		page, next, err := provider.ListWorkflows(ctx, namespace, temporal.ListOptions{PageSize: pageSize, PageToken: token, Query: query})
+		if err != nil {
+			return result, err
+		}
+		result.workflows = append(result.workflows, page...)
+		token = next
+		if token == "" || len(result.workflows) >= sortFetchLimit {
+			break
+		}
+		progress(len(result.workflows))
+	}
+
+	result.path = sortAll
+	if token != "" {
+		result.path = sortLoaded
+	}
+	sort.SliceStable(result.workflows, func(i, j int) bool {
+		return ws.less(result.workflows[i], result.workflows[j])
+	})
+	return result, nil
+}
+
+// sortLabel describes the active sort and how it was applied, for the panel title.
+func (wl *WorkflowList) sortLabel() string {
+	if wl.sortProgress > 0 {
+		return fmt.Sprintf("loading %d rows to sort…", wl.sortProgress)
+	}
+	label := wl.currentSort().label
+	switch wl.sortPath {
+	case sortServer:
+		return fmt.Sprintf("sort: %s, sorted server-side", label)
+	case sortAll:
+		return fmt.Sprintf("sort: %s, sorted all %d rows", label, len(wl.allWorkflows))
+	case sortLoaded:
+		return fmt.Sprintf("sort: %s, sorted first %d loaded rows", label, len(wl.allWorkflows))
+	}
+	return ""
+}
+
+// sortPathToast tells the user how a newly applied sort was carried out.
+func (wl *WorkflowList) sortPathToast() {
+	label := wl.currentSort().label
+	switch wl.sortPath {
+	case sortServer:
+		wl.app.ToastSuccess(fmt.Sprintf("Sorted by %s server-side", label))
+	case sortAll:
+		wl.app.ToastSuccess(fmt.Sprintf("Sorted all %d workflows by %s", len(wl.allWorkflows), label))
+	case sortLoaded:
+		wl.app.ToastWarning(fmt.Sprintf("Server cannot sort: sorted only the first %d workflows by %s", len(wl.allWorkflows), label))
+	}
+}
@@ -3,12 +3,16 @@ package view
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/validators"
+	"github.com/galaxy-io/tempo/internal/icons"
 	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
 )
 
 // startWorkflowPrefill holds the pre-fill values for the start workflow modal.
@@ -41,29 +45,63 @@ func showStartWorkflowModal(app *App, prefill startWorkflowPrefill) {
 			Placeholder("{}").
 			Value(prefill.Input).
 			Done().
+		Text("cron", "Cron Schedule (optional)").
+			Placeholder("e.g. 0 * * * *").
+			Done().
+		Text("executionTimeout", "Execution Timeout (optional)").
+			Placeholder("e.g. 24h, across all runs").
+			Validate(validators.Custom(validateOptionalDuration)).
+			Done().
+		Text("runTimeout", "Run Timeout (optional)").
+			Placeholder("e.g. 1h").
+			Validate(validators.Custom(validateOptionalDuration)).
+			Done().
+		Text("maxAttempts", "Max Attempts (optional)").
+			Placeholder("retry the workflow, e.g. 3").
+			Validate(validators.Custom(func(v any) error {
+				_, err := parseMaxAttempts(v.(string))
+				return err
+			})).
+			Done().
 		OnSubmit(func(values map[string]any) {
-			workflowID := values["workflowId"].(string)
-			workflowType := values["workflowType"].(string)
-			taskQueue := values["taskQueue"].(string)
-			input := values["input"].(string)
+			req := temporal.StartWorkflowRequest{
+				WorkflowID:   values["workflowId"].(string),
+				WorkflowType: values["workflowType"].(string),
+				TaskQueue:    values["taskQueue"].(string),
+				CronSchedule: strings.TrimSpace(values["cron"].(string)),
+			}
+			if input := values["input"].(string); input != "" {
+				req.Input = []byte(input)
+			}
+			// Validated above, so parse errors cannot occur here
+			req.ExecutionTimeout, _ = parseOptionalDuration(values["executionTimeout"].(string))
+			req.RunTimeout, _ = parseOptionalDuration(values["runTimeout"].(string))
+			req.MaxAttempts, _ = parseMaxAttempts(values["maxAttempts"].(string))
 
 			app.JigApp().Pages().DismissModal()
-			executeStartWorkflow(app, workflowID, workflowType, taskQueue, input)
+			executeStartWorkflow(app, req)
 		}).
 		OnCancel(func() {
 			app.JigApp().Pages().DismissModal()
 		}).
 		Build()
 
+	suggestions := attachTypeCompleter(form, "workflowType", app.KnownWorkflowTypes(app.CurrentNamespace()), wholeValueType)
+	content := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(suggestions, 1, 0, false)
+	content.SetBackgroundColor(theme.Bg())
+
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Start Workflow", theme.IconInfo),
+		Title:    fmt.Sprintf("%s Start Workflow", icons.Info),
 		Width:    70,
-		Height:   18,
+		Height:   27,
 		Backdrop: true,
 	})
-	modal.SetContent(form)
+	modal.SetContent(content)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Tab", Description: "Next field"},
+		{Key: "Ctrl+N/P", Description: "Complete type"},
 		{Key: "Ctrl+S", Description: "Execute"},
 		{Key: "Esc", Description: "Cancel"},
 	})
@@ -72,27 +110,51 @@ func showStartWorkflowModal(app *App, prefill startWorkflowPrefill) {
 	app.JigApp().SetFocus(form)
 }
 
+// parseOptionalDuration parses a Go duration such as "90s" or "1h30m"; empty
+// means no timeout.
+func parseOptionalDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("enter a positive duration like 30s, 15m, or 2h")
+	}
+	return d, nil
+}
+
+func validateOptionalDuration(v any) error {
+	_, err := parseOptionalDuration(v.(string))
+	return err
+}
+
+// parseMaxAttempts parses a workflow retry attempt limit; empty means the
+// workflow is not retried.
+func parseMaxAttempts(s string) (int32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("enter a whole number of attempts, 1 or more")
+	}
+	return int32(n), nil
+}
+
 // executeStartWorkflow performs the StartWorkflow operation asynchronously.
-func executeStartWorkflow(app *App, workflowID, workflowType, taskQueue, input string) {
+func executeStartWorkflow(app *App, req temporal.StartWorkflowRequest) {
 	provider := app.Provider()
 	if provider == nil {
 		return
 	}
+	workflowID := req.WorkflowID
 
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		req := temporal.StartWorkflowRequest{
-			WorkflowID:   workflowID,
-			WorkflowType: workflowType,
-			TaskQueue:    taskQueue,
-		}
-
-		if input != "" {
-			req.Input = []byte(input)
-		}
-
 		runID, err := provider.StartWorkflow(ctx, app.CurrentNamespace(), req)
 
 		app.JigApp().QueueUpdateDraw(func() {
@@ -109,6 +171,10 @@ func executeStartWorkflow(app *App, workflowID, workflowType, taskQueue, input s
 
 // showStartWorkflow displays the start workflow modal pre-filled from the selected workflow.
 func (wl *WorkflowList) showStartWorkflow() {
+	if wl.app.blockReadOnly() {
+		return
+	}
+
 	row := wl.table.SelectedRow()
 
 	var prefill startWorkflowPrefill
@@ -0,0 +1,73 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// maxPinnedTabs is the number of pinned queries reachable via the 1-9 keys.
+const maxPinnedTabs = 9
+
+// pinnedQueries returns the pinned queries configured for the workflow list.
+func (wl *WorkflowList) pinnedQueries() []config.PinnedQuery {
+	cfg := wl.app.Config()
+	if cfg == nil {
+		return nil
+	}
+	pinned := cfg.GetPinnedQueries()
+	if len(pinned) > maxPinnedTabs {
+		pinned = pinned[:maxPinnedTabs]
+	}
+	return pinned
+}
+
+// selectPinnedTab applies the pinned query at the given zero-based index.
+// Index -1 selects the implicit "All" tab and clears the visibility query.
+func (wl *WorkflowList) selectPinnedTab(index int) bool {
+	if index < 0 {
+		if wl.visibilityQuery == "" {
+			return true
+		}
+		wl.clearVisibilityQuery()
+		return true
+	}
+
+	pinned := wl.pinnedQueries()
+	if index >= len(pinned) {
+		return false
+	}
+	wl.applyVisibilityQuery(pinned[index].Query)
+	wl.app.setFooterHints(wl.Hints())
+	return true
+}
+
+// pinnedTabsLabel renders the pinned query tabs for the panel title.
+// The active tab is wrapped in brackets since panel titles are plain text.
+func (wl *WorkflowList) pinnedTabsLabel() (string, bool) {
+	pinned := wl.pinnedQueries()
+	if len(pinned) == 0 {
+		return "", false
+	}
+
+	active := false
+	tabs := make([]string, 0, len(pinned)+1)
+	for i, p := range pinned {
+		label := fmt.Sprintf("%d:%s", i+1, p.Name)
+		if p.Query == wl.visibilityQuery {
+			label = "[" + label + "]"
+			active = true
+		}
+		tabs = append(tabs, label)
+	}
+
+	all := "0:All"
+	if wl.visibilityQuery == "" {
+		all = "[" + all + "]"
+		active = true
+	}
+	tabs = append([]string{all}, tabs...)
+
+	return strings.Join(tabs, " "), active
+}
@@ -5,8 +5,6 @@ import (
 	"os/exec"
 	"runtime"
 	"time"
-
-	"github.com/atterpac/jig/theme"
 )
 
 // ptr returns a pointer to the given value.
@@ -14,22 +12,35 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
-// formatRelativeTime formats a time as a human-readable relative string.
+// formatRelativeTime formats a time as a human-readable relative string,
+// such as "5m ago", or "in 5m" for a time still to come.
 func formatRelativeTime(now time.Time, t time.Time) string {
 	d := now.Sub(t)
+	if d < 0 {
+		d = -d
+		if d < time.Minute {
+			return fmt.Sprintf("in %ds", int(d.Round(time.Second).Seconds()))
+		}
+		return "in " + formatSpan(d)
+	}
 	if d < time.Minute {
 		return "just now"
 	}
+	return formatSpan(d) + " ago"
+}
+
+// formatSpan formats a duration of a minute or more in its largest unit.
+func formatSpan(d time.Duration) string {
 	if d < time.Hour {
 		mins := int(d.Minutes())
-		return fmt.Sprintf("%dm ago", mins)
+		return fmt.Sprintf("%dm", mins)
 	}
 	if d < 24*time.Hour {
 		hours := int(d.Hours())
-		return fmt.Sprintf("%dh ago", hours)
+		return fmt.Sprintf("%dh", hours)
 	}
 	days := int(d.Hours() / 24)
-	return fmt.Sprintf("%dd ago", days)
+	return fmt.Sprintf("%dd", days)
 }
 
 // truncate truncates a string to maxLen, adding ellipsis if needed.
@@ -93,35 +104,13 @@ func copyToClipboard(text string) error {
 	return cmd.Wait()
 }
 
-// copyWorkflowID copies the selected workflow ID to clipboard.
-func (wl *WorkflowList) copyWorkflowID() {
+// showYankMenu offers the selected workflow's IDs, type, CLI command, and
+// link for copying.
+func (wl *WorkflowList) showYankMenu() {
 	row := wl.table.SelectedRow()
 	if row < 0 || row >= len(wl.workflows) {
 		return
 	}
-
 	wf := wl.workflows[row]
-	if err := copyToClipboard(wf.ID); err != nil {
-		wl.preview.SetText(fmt.Sprintf("[%s]%s Failed to copy: %s[-]",
-			theme.TagError(), theme.IconError, err.Error()))
-		return
-	}
-
-	wl.preview.SetText(fmt.Sprintf(`[%s::b]Copied to clipboard[-:-:-]
-
-[%s]%s[-]
-
-[%s]Workflow ID copied![-]`,
-		theme.TagPanelTitle(),
-		theme.TagAccent(), wf.ID,
-		theme.TagSuccess()))
-
-	go func() {
-		time.Sleep(1500 * time.Millisecond)
-		wl.app.JigApp().QueueUpdateDraw(func() {
-			if row < len(wl.workflows) {
-				wl.updatePreview(wl.workflows[row])
-			}
-		})
-	}()
+	wl.app.showYankMenu(wl.app.workflowYankTargets(wf.ID, wf.RunID, &wf))
 }
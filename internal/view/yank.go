@@ -0,0 +1,215 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/galaxy-io/tempo/internal/icons"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// yankTarget is one entry in the yank menu.
+type yankTarget struct {
+	Key   rune
+	Label string
+	Value string
+}
+
+// workflowYankTargets returns the copy targets for a workflow run. wf may be
+// nil while the workflow is loading, leaving only the IDs.
+func (a *App) workflowYankTargets(workflowID, runID string, wf *temporal.Workflow) []yankTarget {
+	namespace := a.CurrentNamespace()
+	workflowType := ""
+	if wf != nil {
+		workflowType = wf.Type
+	}
+
+	command := fmt.Sprintf("temporal workflow describe --namespace %s --workflow-id %s", cliArg(namespace), cliArg(workflowID))
+	if runID != "" {
+		command += " --run-id " + cliArg(runID)
+	}
+	if a.config != nil {
+		if profile, ok := a.config.GetProfile(a.activeProfile); ok && profile.Address != "" {
+			command += " --address " + cliArg(profile.Address)
+		}
+	}
+
+	return []yankTarget{
+		{Key: 'i', Label: "Workflow ID", Value: workflowID},
+		{Key: 'r', Label: "Run ID", Value: runID},
+		{Key: 't', Label: "Type", Value: workflowType},
+		{Key: 'c', Label: "CLI Command", Value: command},
+		{Key: 'u', Label: "Web UI Link", Value: a.WorkflowWebURL(namespace, workflowID, runID)},
+	}
+}
+
+// eventYankTargets returns the copy targets for a history event.
+func eventYankTargets(ev *temporal.EnhancedHistoryEvent) []yankTarget {
+	if ev == nil {
+		return nil
+	}
+	payload := ev.Input
+	if payload == "" {
+		payload = ev.Result
+	}
+	return []yankTarget{
+		{Key: 'e', Label: "Event JSON", Value: eventJSON(ev)},
+		{Key: 'p', Label: "Payload", Value: prettyPrintJSONDetail(payload)},
+	}
+}
+
+// eventJSON renders an event's fields as indented JSON, leaving out the
+// ones that are empty.
+func eventJSON(ev *temporal.EnhancedHistoryEvent) string {
+	out := struct {
+		EventID           int64      `json:"eventId"`
+		EventType         string     `json:"eventType"`
+		EventTime         time.Time  `json:"eventTime"`
+		EndTime           *time.Time `json:"endTime,omitempty"`
+		ScheduledEventID  int64      `json:"scheduledEventId,omitempty"`
+		StartedEventID    int64      `json:"startedEventId,omitempty"`
+		InitiatedEventID  int64      `json:"initiatedEventId,omitempty"`
+		ActivityID        string     `json:"activityId,omitempty"`
+		ActivityType      string     `json:"activityType,omitempty"`
+		TimerID           string     `json:"timerId,omitempty"`
+		SignalName        string     `json:"signalName,omitempty"`
+		ChildWorkflowID   string     `json:"childWorkflowId,omitempty"`
+		ChildRunID        string     `json:"childRunId,omitempty"`
+		ChildWorkflowType string     `json:"childWorkflowType,omitempty"`
+		NewExecutionRunID string     `json:"newExecutionRunId,omitempty"`
+		Attempt           int32      `json:"attempt,omitempty"`
+		TaskQueue         string     `json:"taskQueue,omitempty"`
+		Identity          string     `json:"identity,omitempty"`
+		Input             any        `json:"input,omitempty"`
+		Result            any        `json:"result,omitempty"`
+		Failure           string     `json:"failure,omitempty"`
+		FailureSource     string     `json:"failureSource,omitempty"`
+		FailureStackTrace string     `json:"failureStackTrace,omitempty"`
+		FailureCause      string     `json:"failureCause,omitempty"`
+		Details           string     `json:"details,omitempty"`
+	}{
+		EventID:           ev.ID,
+		EventType:         ev.Type,
+		EventTime:         ev.Time,
+		EndTime:           ev.EndTime,
+		ScheduledEventID:  ev.ScheduledEventID,
+		StartedEventID:    ev.StartedEventID,
+		InitiatedEventID:  ev.InitiatedEventID,
+		ActivityID:        ev.ActivityID,
+		ActivityType:      ev.ActivityType,
+		TimerID:           ev.TimerID,
+		SignalName:        ev.SignalName,
+		ChildWorkflowID:   ev.ChildWorkflowID,
+		ChildRunID:        ev.ChildRunID,
+		ChildWorkflowType: ev.ChildWorkflowType,
+		NewExecutionRunID: ev.NewExecutionRunID,
+		Attempt:           ev.Attempt,
+		TaskQueue:         ev.TaskQueue,
+		Identity:          ev.Identity,
+		Input:             jsonOrString(ev.Input),
+		Result:            jsonOrString(ev.Result),
+		Failure:           ev.Failure,
+		FailureSource:     ev.FailureSource,
+		FailureStackTrace: ev.FailureStackTrace,
+		FailureCause:      ev.FailureCause,
+		Details:           ev.Details,
+	}
+	b, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// jsonOrString embeds s as JSON if it parses, so payloads are not
+// double-encoded, and as a string otherwise.
+func jsonOrString(s string) any {
+	if s == "" {
+		return nil
+	}
+	if json.Valid([]byte(s)) {
+		return json.RawMessage(s)
+	}
+	return s
+}
+
+// cliArg leaves s bare if it is safe as a shell word and quotes it
+// otherwise, so copied commands stay readable.
+func cliArg(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:@=", r))
+	}) < 0 {
+		return s
+	}
+	return shellQuote(s)
+}
+
+// showYankMenu lists the copy targets that have a value. Enter or a
+// target's key copies it to the clipboard.
+func (a *App) showYankMenu(targets []yankTarget) {
+	var available []yankTarget
+	for _, t := range targets {
+		if t.Value != "" {
+			available = append(available, t)
+		}
+	}
+	if len(available) == 0 {
+		a.ToastWarning("Nothing to copy")
+		return
+	}
+
+	table := components.NewTable()
+	table.SetHeaders("KEY", "COPY", "VALUE")
+	table.SetBorder(false)
+	for _, t := range available {
+		preview := strings.Join(strings.Fields(t.Value), " ")
+		table.AddRow(string(t.Key), t.Label, truncate(preview, 50))
+	}
+	table.SelectRow(0)
+
+	yank := func(t yankTarget) {
+		a.app.Pages().DismissModal()
+		if err := copyToClipboard(t.Value); err != nil {
+			a.ToastError(fmt.Sprintf("Failed to copy: %v", err))
+			return
+		}
+		a.ToastSuccess(fmt.Sprintf("Copied %s", t.Label))
+	}
+
+	table.SetOnSelect(func(row int) {
+		if row >= 0 && row < len(available) {
+			yank(available[row])
+		}
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		for _, t := range available {
+			if event.Key() == tcell.KeyRune && event.Rune() == t.Key {
+				yank(t)
+				return nil
+			}
+		}
+		return event
+	})
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Copy", icons.Info),
+		Width:    80,
+		Height:   len(available) + 6,
+		Backdrop: true,
+	})
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Copy"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnCancel(func() {
+		a.app.Pages().DismissModal()
+	})
+
+	a.app.Pages().Push(modal)
+	a.app.SetFocus(table)
+}